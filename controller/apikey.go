@@ -0,0 +1,164 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/audit"
+	"github.com/tarkov-database/rest-api/model/apikey"
+	"github.com/tarkov-database/rest-api/model/user"
+	"github.com/tarkov-database/rest-api/view"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// APIKeysGET handles a GET request on the key root endpoint of a user
+func APIKeysGET(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+
+	if _, err := user.GetByID(r.Context(), id); err != nil {
+		handleError(err, w)
+		return
+	}
+
+	opts := &apikey.Options{Sort: getSort("-_modified", r)}
+	opts.Limit, opts.Offset = getLimitOffset(r)
+
+	result, err := apikey.GetByUser(r.Context(), id, opts)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	view.RenderJSON(result, http.StatusOK, w)
+}
+
+// APIKeyGET handles a GET request on a key entity endpoint of a user
+func APIKeyGET(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	key, err := apikey.GetByID(r.Context(), ps.ByName("key"), ps.ByName("id"))
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	view.RenderJSON(key, http.StatusOK, w)
+}
+
+// APIKeyPOST handles a POST request on the key root endpoint of a user
+func APIKeyPOST(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	if !isSupportedMediaType(r) {
+		StatusUnsupportedMediaType("Wrong content type").Render(w)
+		return
+	}
+
+	usr, err := user.GetByID(r.Context(), ps.ByName("id"))
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	rb := &apikey.Request{}
+
+	if err := parseJSONBody(r.Body, rb); err != nil {
+		StatusBadRequest(fmt.Sprintf("JSON parsing error: %s", err)).Render(w)
+		return
+	}
+
+	key := &apikey.Key{User: usr.ID}
+
+	if err := rb.Apply(key); err != nil {
+		StatusBadRequest(fmt.Sprintf("Parsing error: %s", err)).Render(w)
+		return
+	}
+
+	if err := key.Validate(); err != nil {
+		StatusUnprocessableEntity(fmt.Sprintf("Validation error: %s", err)).Render(w)
+		return
+	}
+
+	if !isScopeOfUser(r.Context(), usr, key.Scope, w) {
+		return
+	}
+
+	secret, err := key.Generate()
+	if err != nil {
+		StatusInternalServerError(fmt.Sprintf("Key generation error: %s", err)).Render(w)
+		return
+	}
+
+	if err := apikey.Create(r.Context(), key); err != nil {
+		handleError(err, w)
+		return
+	}
+
+	audit.SetResource(r, key.ID.Hex())
+
+	logger.Infof("API key %s of user %s created", key.ID.Hex(), usr.ID.Hex())
+
+	view.RenderJSON(&apikey.Created{Key: key, Secret: secret}, http.StatusCreated, w)
+}
+
+// APIKeyPUT handles a PUT request on a key entity endpoint of a user
+func APIKeyPUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	if !isSupportedMediaType(r) {
+		StatusUnsupportedMediaType("Wrong content type").Render(w)
+		return
+	}
+
+	key, err := apikey.GetByID(r.Context(), ps.ByName("key"), ps.ByName("id"))
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	rb := &apikey.Request{}
+
+	if err := parseJSONBody(r.Body, rb); err != nil {
+		StatusBadRequest(fmt.Sprintf("JSON parsing error: %s", err)).Render(w)
+		return
+	}
+
+	if err := rb.Apply(key); err != nil {
+		StatusBadRequest(fmt.Sprintf("Parsing error: %s", err)).Render(w)
+		return
+	}
+
+	if err := key.Validate(); err != nil {
+		StatusUnprocessableEntity(fmt.Sprintf("Validation error: %s", err)).Render(w)
+		return
+	}
+
+	usr, err := user.GetByID(r.Context(), ps.ByName("id"))
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	if !isScopeOfUser(r.Context(), usr, key.Scope, w) {
+		return
+	}
+
+	if err := apikey.Replace(r.Context(), key); err != nil {
+		handleError(err, w)
+		return
+	}
+
+	logger.Infof("API key %s updated", key.ID.Hex())
+
+	view.RenderJSON(key, http.StatusOK, w)
+}
+
+// APIKeyDELETE handles a DELETE request on a key entity endpoint of a user
+func APIKeyDELETE(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("key")
+
+	if err := apikey.Remove(r.Context(), id, ps.ByName("id")); err != nil {
+		handleError(err, w)
+		return
+	}
+
+	logger.Infof("API key %s removed", id)
+
+	w.WriteHeader(http.StatusNoContent)
+}
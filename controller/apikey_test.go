@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model/apikey"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+type apiKeyResult struct {
+	Count int64        `json:"total"`
+	Items []apikey.Key `json:"items"`
+}
+
+func TestAPIKey(t *testing.T) {
+	userID := userIDs[0]
+
+	buf := new(bytes.Buffer)
+
+	input := &apikey.Request{
+		Label:     "Test site",
+		Scope:     []string{jwt.ScopeItemRead},
+		ExpiresIn: "24h",
+	}
+
+	if err := json.NewEncoder(buf).Encode(input); err != nil {
+		t.Fatalf("Creating API key failed: %s", err)
+	}
+
+	req := httptest.NewRequest("POST", "http://example.com", buf)
+	req.Header.Set("Content-Type", contentTypeJSON)
+
+	params := httprouter.Params{httprouter.Param{Key: "id", Value: userID.Hex()}}
+
+	w := httptest.NewRecorder()
+
+	APIKeyPOST(w, req, params)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Creating API key failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	created := &apikey.Created{}
+
+	if err := json.NewDecoder(resp.Body).Decode(created); err != nil {
+		t.Fatalf("Creating API key failed: %s", err)
+	}
+
+	if created.Secret == "" || created.Label != input.Label || created.User != userID {
+		t.Fatalf("Creating API key failed: unexpected key %+v", created)
+	}
+
+	clm, err := jwt.AuthenticateAPIKey(context.Background(), created.Secret)
+	if err != nil {
+		t.Fatalf("Authenticating API key failed: %s", err)
+	}
+
+	if clm.Subject != userID.Hex() || !clm.HasScope(jwt.ScopeItemRead) || clm.HasScope(jwt.ScopeItemWrite) {
+		t.Errorf("Authenticating API key failed: unexpected claims %+v", clm)
+	}
+
+	if _, err := jwt.AuthenticateAPIKey(context.Background(), created.Secret+"x"); !errors.Is(err, jwt.ErrInvalidAPIKey) {
+		t.Errorf("Authenticating API key failed: invalid key accepted: %v", err)
+	}
+
+	handle := jwt.AuhtorizationHandler(jwt.ScopeItemRead, func(w http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req = httptest.NewRequest("GET", "http://example.com", nil)
+	req.Header.Set(jwt.HeaderAPIKey, created.Secret)
+
+	w = httptest.NewRecorder()
+
+	handle(w, req, httprouter.Params{})
+
+	if code := w.Result().StatusCode; code != http.StatusOK {
+		t.Errorf("Authorizing API key failed: unexpcted response code %v", code)
+	}
+
+	w = httptest.NewRecorder()
+
+	APIKeysGET(w, httptest.NewRequest("GET", "http://example.com", nil), params)
+
+	resp = w.Result()
+	defer resp.Body.Close()
+
+	res := &apiKeyResult{}
+
+	if err := json.NewDecoder(resp.Body).Decode(res); err != nil {
+		t.Fatalf("Getting API keys failed: %s", err)
+	}
+
+	if res.Count != 1 || res.Items[0].ID != created.ID || res.Items[0].LastUsed == nil {
+		t.Errorf("Getting API keys failed: unexpected result %+v", res)
+	}
+
+	keyParams := append(params, httprouter.Param{Key: "key", Value: created.ID.Hex()})
+
+	w = httptest.NewRecorder()
+
+	APIKeyDELETE(w, httptest.NewRequest("DELETE", "http://example.com", nil), keyParams)
+
+	if code := w.Result().StatusCode; code != http.StatusNoContent {
+		t.Fatalf("Removing API key failed: unexpcted response code %v", code)
+	}
+
+	if _, err := jwt.AuthenticateAPIKey(context.Background(), created.Secret); !errors.Is(err, jwt.ErrInvalidAPIKey) {
+		t.Errorf("Removing API key failed: key still accepted: %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/logger"
+	auditlog "github.com/tarkov-database/rest-api/model/audit"
+	"github.com/tarkov-database/rest-api/view"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	contentTypeNDJSON = "application/x-ndjson"
+	contentTypeCSV    = "text/csv"
+)
+
+// AuditGET handles a GET request on the audit log endpoint
+func AuditGET(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	f, err := getAuditFilter(r)
+	if err != nil {
+		StatusBadRequest(err.Error()).Render(w)
+		return
+	}
+
+	opts := &auditlog.Options{Sort: getSort("-time", r)}
+	opts.Limit, opts.Offset = getLimitOffset(r)
+
+	result, err := auditlog.Get(r.Context(), f, opts)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	view.RenderJSON(result, http.StatusOK, w)
+}
+
+// AuditExportGET handles a GET request on the audit log export endpoint.
+// All matching entries are streamed as NDJSON or CSV.
+func AuditExportGET(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	f, err := getAuditFilter(r)
+	if err != nil {
+		StatusBadRequest(err.Error()).Render(w)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+
+	var write func(*auditlog.Entry) error
+	var flush func() error
+
+	switch format {
+	case "", "ndjson":
+		format = "ndjson"
+		w.Header().Set("Content-Type", contentTypeNDJSON)
+
+		enc := json.NewEncoder(w)
+		write = func(e *auditlog.Entry) error { return enc.Encode(e) }
+		flush = func() error { return nil }
+	case "csv":
+		w.Header().Set("Content-Type", contentTypeCSV)
+
+		cw := csv.NewWriter(w)
+		if err := cw.Write(auditCSVHeader); err != nil {
+			return
+		}
+
+		write = func(e *auditlog.Entry) error { return cw.Write(auditCSVRecord(e)) }
+		flush = func() error { cw.Flush(); return cw.Error() }
+	default:
+		StatusBadRequest("Export format is not valid").Render(w)
+		return
+	}
+
+	name := fmt.Sprintf("audit-%s.%s", time.Now().UTC().Format("20060102T150405Z"), format)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+
+	// The status is already sent, so errors can only cut the export short
+	if err := auditlog.Export(r.Context(), f, write); err != nil {
+		logger.Errorf("Error while exporting audit log: %s", err)
+	}
+
+	if err := flush(); err != nil {
+		logger.Errorf("Error while exporting audit log: %s", err)
+	}
+}
+
+var auditCSVHeader = []string{"time", "requestId", "subject", "scope", "address", "method", "route", "resource", "status"}
+
+func auditCSVRecord(e *auditlog.Entry) []string {
+	return []string{
+		e.Time.UTC().Format(time.RFC3339Nano),
+		e.RequestID,
+		e.Subject,
+		strings.Join(e.Scope, " "),
+		e.Address,
+		e.Method,
+		e.Route,
+		e.Resource,
+		strconv.Itoa(e.Status),
+	}
+}
+
+// getAuditFilter returns the audit log filter of the query string
+func getAuditFilter(r *http.Request) (*auditlog.Filter, error) {
+	q := r.URL.Query()
+
+	f := &auditlog.Filter{
+		Subject:   q.Get("subject"),
+		Method:    strings.ToUpper(q.Get("method")),
+		Route:     q.Get("route"),
+		Resource:  q.Get("resource"),
+		RequestID: q.Get("requestId"),
+	}
+
+	for _, s := range []string{f.Subject, f.Route, f.Resource, f.RequestID} {
+		if len(s) > 200 || !isAlnumBlankPunct(s) {
+			return nil, errors.New("Query string contains invalid characters")
+		}
+	}
+
+	if s := q.Get("status"); s != "" {
+		i, err := strconv.Atoi(s)
+		if err != nil || i < 100 || i > 599 {
+			return nil, errors.New("Status is not valid")
+		}
+		f.Status = i
+	}
+
+	var err error
+
+	if f.From, err = parseTime(q.Get("from")); err != nil {
+		return nil, fmt.Errorf("Start time is not valid: %s", err)
+	}
+
+	if f.To, err = parseTime(q.Get("to")); err != nil {
+		return nil, fmt.Errorf("End time is not valid: %s", err)
+	}
+
+	return f, nil
+}
+
+// parseTime parses a Unix timestamp or a RFC 3339 time
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(i, 0), nil
+	}
+
+	return time.Parse(time.RFC3339, s)
+}
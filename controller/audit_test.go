@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/database"
+	auditlog "github.com/tarkov-database/rest-api/model/audit"
+
+	"github.com/julienschmidt/httprouter"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type auditResult struct {
+	Count int64            `json:"total"`
+	Items []auditlog.Entry `json:"items"`
+}
+
+func TestAudit(t *testing.T) {
+	subject := userIDs[0].Hex()
+	now := time.Now()
+
+	entries := []*auditlog.Entry{
+		{Subject: subject, Method: "POST", Route: "/v2/item/:kind", Status: http.StatusCreated, RequestID: "audit-1", Time: now.Add(-2 * time.Hour)},
+		{Subject: subject, Method: "DELETE", Route: "/v2/item/:id", Status: http.StatusNoContent, RequestID: "audit-2", Time: now.Add(-time.Hour)},
+		{Subject: subject, Method: "DELETE", Route: "/v2/item/:id", Status: http.StatusForbidden, RequestID: "audit-3", Time: now},
+	}
+
+	for _, e := range entries {
+		if err := auditlog.Create(context.Background(), e); err != nil {
+			t.Fatalf("Creating audit entry failed: %s", err)
+		}
+	}
+
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 12*time.Second)
+		defer cancel()
+
+		if _, err := database.GetDB().Collection(auditlog.Collection).DeleteMany(ctx, bson.M{"subject": subject}); err != nil {
+			t.Errorf("Removing audit entries failed: %s", err)
+		}
+	}()
+
+	req := httptest.NewRequest("GET", "http://example.com/v2/audit?subject="+subject+"&method=delete", nil)
+	w := httptest.NewRecorder()
+
+	AuditGET(w, req, httprouter.Params{})
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Getting audit log failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	output := &auditResult{}
+
+	if err := json.NewDecoder(resp.Body).Decode(output); err != nil {
+		t.Fatalf("Getting audit log failed: %s", err)
+	}
+
+	if output.Count != 2 || output.Items[0].RequestID != "audit-3" {
+		t.Errorf("Getting audit log failed: unexpected result %+v", output)
+	}
+
+	req = httptest.NewRequest("GET", "http://example.com/v2/audit?status=abc", nil)
+	w = httptest.NewRecorder()
+
+	AuditGET(w, req, httprouter.Params{})
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Getting audit log failed: unexpcted response code %v", w.Code)
+	}
+
+	from := now.Add(-90 * time.Minute).UTC().Format(time.RFC3339)
+
+	req = httptest.NewRequest("GET", "http://example.com/v2/audit/export?subject="+subject+"&from="+from, nil)
+	w = httptest.NewRecorder()
+
+	AuditExportGET(w, req, httprouter.Params{})
+
+	resp = w.Result()
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Exporting audit log failed: unexpcted response code %v", resp.StatusCode)
+	}
+	if resp.Header.Get("Content-Type") != contentTypeNDJSON {
+		t.Error("Exporting audit log failed: content type is invalid")
+	}
+
+	var ids []string
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		e := &auditlog.Entry{}
+
+		if err := json.Unmarshal(scanner.Bytes(), e); err != nil {
+			t.Fatalf("Exporting audit log failed: %s", err)
+		}
+
+		ids = append(ids, e.RequestID)
+	}
+
+	if len(ids) != 2 || ids[0] != "audit-2" || ids[1] != "audit-3" {
+		t.Errorf("Exporting audit log failed: unexpected entries %v", ids)
+	}
+
+	req = httptest.NewRequest("GET", "http://example.com/v2/audit/export?subject="+subject+"&format=csv", nil)
+	w = httptest.NewRecorder()
+
+	AuditExportGET(w, req, httprouter.Params{})
+
+	records, err := csv.NewReader(w.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("Exporting audit log failed: %s", err)
+	}
+
+	if len(records) != 4 || records[0][0] != "time" {
+		t.Errorf("Exporting audit log failed: unexpected number of records %v", len(records))
+	}
+}
@@ -0,0 +1,363 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/core/mail"
+	"github.com/tarkov-database/rest-api/core/oidc"
+	"github.com/tarkov-database/rest-api/middleware/audit"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/model/role"
+	"github.com/tarkov-database/rest-api/model/token"
+	"github.com/tarkov-database/rest-api/model/user"
+	"github.com/tarkov-database/rest-api/view"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// linkMessages holds the subject and body of the mail of each link purpose
+var linkMessages = map[token.Purpose][2]string{
+	token.PurposeLogin: {
+		"Your login link",
+		"Use the following link to log in:\n\n%s\n\nThe link expires in %s. If you didn't request it, you can ignore this message.",
+	},
+	token.PurposeReset: {
+		"Reset your password",
+		"Use the following link to set a new password:\n\n%s\n\nThe link expires in %s. If you didn't request it, you can ignore this message.",
+	},
+	token.PurposeVerify: {
+		"Verify your e-mail address",
+		"Use the following link to verify your e-mail address:\n\n%s\n\nThe link expires in %s.",
+	},
+}
+
+// sendLink creates a one-time token and mails the link to the user
+func sendLink(ctx context.Context, usr *user.User, p token.Purpose) error {
+	lt := mail.LinkExpirationTime()
+
+	s, err := token.NewOneTimeToken(ctx, p, usr.ID, usr.Email, lt)
+	if err != nil {
+		return err
+	}
+
+	msg := linkMessages[p]
+
+	return mail.Send(&mail.Message{
+		To:      usr.Email,
+		Subject: msg[0],
+		Body:    fmt.Sprintf(msg[1], mail.Link(string(p), s), lt),
+	})
+}
+
+// AuthLoginPOST handles a POST request on the login endpoint
+func AuthLoginPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	if !isSupportedMediaType(r) {
+		StatusUnsupportedMediaType("Wrong content type").Render(w)
+		return
+	}
+
+	rb := &token.LoginRequest{}
+
+	if err := parseJSONBody(r.Body, rb); err != nil {
+		StatusBadRequest(fmt.Sprintf("JSON parsing error: %s", err)).Render(w)
+		return
+	}
+
+	var usr *user.User
+	var err error
+
+	method := "password"
+
+	switch {
+	case rb.Token != "":
+		method = "login link"
+		usr, err = useLink(r.Context(), rb.Token, token.PurposeLogin)
+		if err != nil {
+			handleAuthError(err, w)
+			return
+		}
+	case rb.Email != "" && rb.Password != "":
+		usr, err = user.Authenticate(r.Context(), rb.Email, rb.Password)
+		if err != nil {
+			handleAuthError(err, w)
+			return
+		}
+
+		if !usr.Verified {
+			StatusForbidden("E-mail address is not verified").Render(w)
+			return
+		}
+	default:
+		StatusBadRequest("Credentials are missing").Render(w)
+		return
+	}
+
+	login(w, r, usr, method)
+}
+
+// login issues a token with the scopes of the roles of the user
+func login(w http.ResponseWriter, r *http.Request, usr *user.User, method string) {
+	if usr.Locked {
+		StatusForbidden("User is locked").Render(w)
+		return
+	}
+
+	scope, err := role.Scope(r.Context(), usr.Roles)
+	if err != nil {
+		handleRoleError(err, w)
+		return
+	}
+
+	clm := &jwt.Claims{Scope: scope}
+	clm.Subject = usr.ID.Hex()
+
+	res, err := issueToken(r.Context(), clm, nil, true, model.ObjectID{})
+	if err != nil {
+		StatusInternalServerError(fmt.Sprintf("Creation error: %s", err)).Render(w)
+		return
+	}
+
+	audit.SetActor(r, clm)
+	audit.SetResource(r, clm.ID)
+
+	logger.Infof("User %s logged in via %s", usr.ID.Hex(), method)
+
+	view.RenderJSON(res, http.StatusCreated, w)
+}
+
+// AuthOIDCPOST handles a POST request on the identity provider login endpoint.
+// The ID token of the provider is exchanged for a token of the API.
+func AuthOIDCPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	p := oidc.Default()
+	if p == nil {
+		StatusNotFound("Identity provider login is not enabled").Render(w)
+		return
+	}
+
+	if !isSupportedMediaType(r) {
+		StatusUnsupportedMediaType("Wrong content type").Render(w)
+		return
+	}
+
+	rb := &token.OIDCRequest{}
+
+	if err := parseJSONBody(r.Body, rb); err != nil {
+		StatusBadRequest(fmt.Sprintf("JSON parsing error: %s", err)).Render(w)
+		return
+	}
+
+	if rb.IDToken == "" {
+		StatusBadRequest("ID token is missing").Render(w)
+		return
+	}
+
+	clm, err := p.Verify(rb.IDToken)
+	if err != nil {
+		if errors.Is(err, oidc.ErrDiscovery) {
+			logger.Errorf("Error while verifying ID token: %s", err)
+			StatusInternalServerError("Identity provider is not available").Render(w)
+			return
+		}
+		StatusUnauthorized(fmt.Sprintf("Token error: %s", err)).Render(w)
+		return
+	}
+
+	// Only an address confirmed by the provider identifies a user
+	if clm.Email == "" || !clm.EmailVerified {
+		StatusForbidden("E-mail address is not verified by the identity provider").Render(w)
+		return
+	}
+
+	usr, err := user.GetOneByEmail(r.Context(), clm.Email)
+	switch {
+	case err == model.ErrNoResult && p.AutoProvision:
+		usr = &user.User{Email: clm.Email, Verified: true, Roles: p.Roles}
+
+		if err := usr.Validate(); err != nil {
+			StatusUnprocessableEntity(fmt.Sprintf("Validation error: %s", err)).Render(w)
+			return
+		}
+
+		if err := user.Create(r.Context(), usr); err != nil {
+			handleError(err, w)
+			return
+		}
+
+		logger.Infof("User %s provisioned by identity provider", usr.ID.Hex())
+	case err == model.ErrNoResult:
+		StatusForbidden("No user with this e-mail address").Render(w)
+		return
+	case err != nil:
+		handleError(err, w)
+		return
+	case !usr.Verified:
+		if err := user.SetVerified(r.Context(), usr.ID.Hex(), usr.Email); err != nil {
+			handleError(err, w)
+			return
+		}
+		usr.Verified = true
+	}
+
+	login(w, r, usr, "identity provider")
+}
+
+// useLink consumes the token of a one-time link and returns its user.
+// As the link was received by mail, the e-mail address is verified as well.
+func useLink(ctx context.Context, s string, p token.Purpose) (*user.User, error) {
+	t, err := token.UseOneTimeToken(ctx, s, p)
+	if err != nil {
+		return nil, err
+	}
+
+	usr, err := user.GetByID(ctx, t.User.Hex())
+	if err != nil {
+		if err == model.ErrNoResult {
+			return nil, token.ErrInvalidOneTimeToken
+		}
+		return nil, err
+	}
+
+	// Links sent to a former address of the user are void
+	if usr.Email != t.Email {
+		return nil, token.ErrInvalidOneTimeToken
+	}
+
+	if !usr.Verified {
+		if err := user.SetVerified(ctx, usr.ID.Hex(), usr.Email); err != nil {
+			return nil, err
+		}
+		usr.Verified = true
+	}
+
+	return usr, nil
+}
+
+// requestLink handles a request for a one-time link. The response doesn't
+// reveal whether the e-mail address belongs to a user.
+func requestLink(w http.ResponseWriter, r *http.Request, p token.Purpose, send func(*user.User) bool) {
+	if !isSupportedMediaType(r) {
+		StatusUnsupportedMediaType("Wrong content type").Render(w)
+		return
+	}
+
+	rb := &token.LinkRequest{}
+
+	if err := parseJSONBody(r.Body, rb); err != nil {
+		StatusBadRequest(fmt.Sprintf("JSON parsing error: %s", err)).Render(w)
+		return
+	}
+
+	if rb.Email == "" {
+		StatusBadRequest("E-mail address is missing").Render(w)
+		return
+	}
+
+	usr, err := user.GetOneByEmail(r.Context(), rb.Email)
+	switch {
+	case err == model.ErrNoResult:
+	case err != nil:
+		logger.Errorf("Error while getting user for %s link: %s", p, err)
+	case usr.Locked || !send(usr):
+	default:
+		if err := sendLink(r.Context(), usr, p); err != nil {
+			logger.Errorf("Error while sending %s link to user %s: %s", p, usr.ID.Hex(), err)
+		}
+	}
+
+	StatusAccepted("A link will be sent if the e-mail address is registered").Render(w)
+}
+
+// AuthLinkPOST handles a POST request on the login link endpoint
+func AuthLinkPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	requestLink(w, r, token.PurposeLogin, func(*user.User) bool { return true })
+}
+
+// AuthPasswordForgotPOST handles a POST request on the password reset link endpoint
+func AuthPasswordForgotPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	requestLink(w, r, token.PurposeReset, func(*user.User) bool { return true })
+}
+
+// AuthVerifyRequestPOST handles a POST request on the verification link endpoint
+func AuthVerifyRequestPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	requestLink(w, r, token.PurposeVerify, func(u *user.User) bool { return !u.Verified })
+}
+
+// AuthPasswordResetPOST handles a POST request on the password reset endpoint
+func AuthPasswordResetPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	if !isSupportedMediaType(r) {
+		StatusUnsupportedMediaType("Wrong content type").Render(w)
+		return
+	}
+
+	rb := &token.PasswordRequest{}
+
+	if err := parseJSONBody(r.Body, rb); err != nil {
+		StatusBadRequest(fmt.Sprintf("JSON parsing error: %s", err)).Render(w)
+		return
+	}
+
+	if err := user.ValidatePassword(rb.Password); err != nil {
+		StatusUnprocessableEntity(fmt.Sprintf("Validation error: %s", err)).Render(w)
+		return
+	}
+
+	usr, err := useLink(r.Context(), rb.Token, token.PurposeReset)
+	if err != nil {
+		handleAuthError(err, w)
+		return
+	}
+
+	if err := user.SetPassword(r.Context(), usr.ID.Hex(), rb.Password); err != nil {
+		handleError(err, w)
+		return
+	}
+
+	// Sessions started with the former password are ended
+	if err := token.RevokeAll(r.Context(), usr.ID.Hex()); err != nil {
+		handleError(err, w)
+		return
+	}
+
+	logger.Infof("Password of user %s reset", usr.ID.Hex())
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AuthVerifyPOST handles a POST request on the e-mail verification endpoint
+func AuthVerifyPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	if !isSupportedMediaType(r) {
+		StatusUnsupportedMediaType("Wrong content type").Render(w)
+		return
+	}
+
+	rb := &token.VerifyRequest{}
+
+	if err := parseJSONBody(r.Body, rb); err != nil {
+		StatusBadRequest(fmt.Sprintf("JSON parsing error: %s", err)).Render(w)
+		return
+	}
+
+	usr, err := useLink(r.Context(), rb.Token, token.PurposeVerify)
+	if err != nil {
+		handleAuthError(err, w)
+		return
+	}
+
+	logger.Infof("E-mail address of user %s verified", usr.ID.Hex())
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func handleAuthError(err error, w http.ResponseWriter) {
+	switch err {
+	case user.ErrInvalidCredentials, token.ErrInvalidOneTimeToken:
+		StatusUnauthorized(err.Error()).Render(w)
+	default:
+		handleError(err, w)
+	}
+}
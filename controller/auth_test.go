@@ -0,0 +1,254 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/oidc"
+	"github.com/tarkov-database/rest-api/core/oidc/oidctest"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model/role"
+	"github.com/tarkov-database/rest-api/model/token"
+	"github.com/tarkov-database/rest-api/model/user"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var linkPattern = regexp.MustCompile(`https?://\S+`)
+
+// linkToken returns the token of the last link mailed to the address
+func linkToken(t *testing.T, to, purpose string) string {
+	t.Helper()
+
+	msg := mailbox.last(to)
+	if msg == nil {
+		t.Fatalf("Getting link failed: no mail sent to %s", to)
+	}
+
+	u, err := url.Parse(linkPattern.FindString(msg.Body))
+	if err != nil {
+		t.Fatalf("Getting link failed: %s", err)
+	}
+
+	if p := u.Query().Get("purpose"); p != purpose {
+		t.Fatalf("Getting link failed: unexpected purpose %s", p)
+	}
+
+	return u.Query().Get("token")
+}
+
+func TestAuth(t *testing.T) {
+	email := "login@testing.dev"
+	usr := &user.User{ID: createUserID(), Email: email, Roles: []string{role.Viewer}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 12*time.Second)
+	defer cancel()
+
+	if _, err := database.GetDB().Collection(user.Collection).InsertOne(ctx, usr); err != nil {
+		t.Fatalf("Creating user failed: %s", err)
+	}
+
+	resp := postToken(t, AuthPasswordForgotPOST, "", &token.LinkRequest{Email: "unknown@testing.dev"})
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("Requesting reset link failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	resp = postToken(t, AuthPasswordForgotPOST, "", &token.LinkRequest{Email: email})
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("Requesting reset link failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	reset := linkToken(t, email, string(token.PurposeReset))
+	password := "correct horse battery"
+
+	resp = postToken(t, AuthPasswordResetPOST, "", &token.PasswordRequest{Token: reset, Password: "short"})
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("Resetting password failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	resp = postToken(t, AuthPasswordResetPOST, "", &token.PasswordRequest{Token: reset, Password: password})
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("Resetting password failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	resp = postToken(t, AuthPasswordResetPOST, "", &token.PasswordRequest{Token: reset, Password: password})
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("Reusing reset link failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	// Tokens issued in the second of the reset are revoked as well
+	time.Sleep(time.Second)
+
+	resp = postToken(t, AuthLoginPOST, "", &token.LoginRequest{Email: email, Password: "wrong password"})
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("Logging in failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	resp = postToken(t, AuthLoginPOST, "", &token.LoginRequest{Email: email, Password: password})
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Logging in failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	output := &token.Response{}
+
+	if err := json.NewDecoder(resp.Body).Decode(output); err != nil {
+		t.Fatalf("Logging in failed: %s", err)
+	}
+
+	clm, err := jwt.Authenticate(context.Background(), output.Token)
+	if err != nil {
+		t.Fatalf("Logging in failed: %s", err)
+	}
+
+	if clm.Subject != usr.ID.Hex() || !clm.HasScope(jwt.ScopeItemRead) || clm.HasScope(jwt.ScopeItemWrite) {
+		t.Errorf("Logging in failed: unexpected claims %+v", clm)
+	}
+
+	if output.RefreshToken == "" {
+		t.Error("Logging in failed: refresh token missing")
+	}
+
+	resp = postToken(t, AuthLinkPOST, "", &token.LinkRequest{Email: email})
+	resp.Body.Close()
+
+	login := linkToken(t, email, string(token.PurposeLogin))
+
+	resp = postToken(t, AuthLoginPOST, "", &token.LoginRequest{Token: login})
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Logging in with link failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	out, err := user.GetByID(context.Background(), usr.ID.Hex())
+	if err != nil {
+		t.Fatalf("Getting user failed: %s", err)
+	}
+
+	if !out.Verified || out.Password == "" {
+		t.Errorf("Resetting password failed: user not verified or password not set")
+	}
+}
+
+func TestAuthOIDC(t *testing.T) {
+	req := &token.OIDCRequest{}
+
+	resp := postToken(t, AuthOIDCPOST, "", req)
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("Logging in without provider failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	iss := oidctest.NewIssuer()
+	defer iss.Close()
+
+	p := &oidc.Provider{Issuer: iss.URL, ClientID: "client", Roles: []string{role.Viewer}, Client: iss.Client()}
+
+	oidc.SetProvider(p)
+	defer oidc.SetProvider(nil)
+
+	email := "oidc@testing.dev"
+
+	req.IDToken = iss.Token("other", email, true)
+
+	resp = postToken(t, AuthOIDCPOST, "", req)
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("Logging in with invalid ID token failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	req.IDToken = iss.Token("client", email, false)
+
+	resp = postToken(t, AuthOIDCPOST, "", req)
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("Logging in with unverified address failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	req.IDToken = iss.Token("client", email, true)
+
+	resp = postToken(t, AuthOIDCPOST, "", req)
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("Logging in with unknown address failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	p.AutoProvision = true
+
+	resp = postToken(t, AuthOIDCPOST, "", req)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Logging in with provisioning failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	output := &token.Response{}
+
+	if err := json.NewDecoder(resp.Body).Decode(output); err != nil {
+		t.Fatalf("Logging in with provisioning failed: %s", err)
+	}
+
+	clm, err := jwt.Authenticate(context.Background(), output.Token)
+	if err != nil {
+		t.Fatalf("Logging in with provisioning failed: %s", err)
+	}
+
+	id, err := primitive.ObjectIDFromHex(clm.Subject)
+	if err != nil {
+		t.Fatalf("Logging in with provisioning failed: %s", err)
+	}
+	userIDs = append(userIDs, id)
+
+	usr, err := user.GetByID(context.Background(), clm.Subject)
+	if err != nil {
+		t.Fatalf("Getting user failed: %s", err)
+	}
+
+	if usr.Email != email || !usr.Verified || len(usr.Roles) != 1 || usr.Roles[0] != role.Viewer {
+		t.Errorf("Logging in with provisioning failed: unexpected user %+v", usr)
+	}
+
+	if !clm.HasScope(jwt.ScopeItemRead) || clm.HasScope(jwt.ScopeItemWrite) {
+		t.Errorf("Logging in with provisioning failed: unexpected scope %v", clm.Scope)
+	}
+
+	// Known users are mapped by their address
+	resp = postToken(t, AuthOIDCPOST, "", req)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Logging in failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(output); err != nil {
+		t.Fatalf("Logging in failed: %s", err)
+	}
+
+	if clm, err := jwt.Authenticate(context.Background(), output.Token); err != nil || clm.Subject != id.Hex() {
+		t.Errorf("Logging in failed: user provisioned again")
+	}
+}
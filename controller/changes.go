@@ -0,0 +1,145 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/event"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+const contentTypeEventStream = "text/event-stream"
+
+var changesKeepAlive = 30 * time.Second
+
+var resourceReadScopes = map[event.Resource]string{
+	event.ResourceItem:      jwt.ScopeItemRead,
+	event.ResourceHideout:   jwt.ScopeHideoutRead,
+	event.ResourceLocation:  jwt.ScopeLocationRead,
+	event.ResourceStatistic: jwt.ScopeStatisticRead,
+}
+
+// eventScope returns the scope required to receive an event, which is
+// qualified by the kind of an item or the ID of a location
+func eventScope(e *event.Event) string {
+	scope := resourceReadScopes[e.Resource]
+
+	switch {
+	case e.Resource == event.ResourceItem:
+		return jwt.QualifyScopeValue(scope, e.Kind)
+	case e.Resource == event.ResourceLocation && e.Kind == "location":
+		return jwt.QualifyScopeValue(scope, e.Document)
+	}
+
+	return scope
+}
+
+// ChangesGET handles a GET request on the change feed endpoint
+func ChangesGET(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	clm, ok := jwt.FromContext(r.Context())
+	if !ok {
+		StatusUnauthorized("Missing token claims").Render(w)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		StatusInternalServerError("Streaming not supported").Render(w)
+		return
+	}
+
+	filter := &event.Filter{}
+
+	if v := r.URL.Query().Get("resource"); v != "" {
+		for _, s := range strings.Split(v, ",") {
+			res := event.Resource(s)
+			if !res.IsValid() {
+				StatusBadRequest(fmt.Sprintf("Resource \"%s\" is not valid", s)).Render(w)
+				return
+			}
+			if !clm.HasScope(jwt.QualifyScope(resourceReadScopes[res], jwt.ScopeWildcard)) {
+				jwt.AddAuthenticateHeader(w, jwt.ErrInvalidScope, resourceReadScopes[res])
+				StatusForbidden("Insufficient permissions").Render(w)
+				return
+			}
+			filter.Resources = append(filter.Resources, res)
+		}
+	} else {
+		for _, res := range event.ResourceList {
+			if clm.HasScope(jwt.QualifyScope(resourceReadScopes[res], jwt.ScopeWildcard)) {
+				filter.Resources = append(filter.Resources, res)
+			}
+		}
+	}
+
+	if len(filter.Resources) == 0 {
+		jwt.AddAuthenticateHeader(w, jwt.ErrInvalidScope)
+		StatusForbidden("Insufficient permissions").Render(w)
+		return
+	}
+
+	if v := r.URL.Query().Get("kind"); v != "" {
+		if !isAllowedQueryChars(v) {
+			StatusBadRequest("Query string contains invalid characters").Render(w)
+			return
+		}
+		filter.Kinds = strings.Split(v, ",")
+	}
+
+	events, err := event.Subscribe(r.Context(), filter, r.Header.Get("Last-Event-ID"))
+	if err != nil {
+		if err == event.ErrInvalidEventID {
+			StatusBadRequest("Last event ID is not valid").Render(w)
+			return
+		}
+		handleError(err, w)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentTypeEventStream)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ticker := time.NewTicker(changesKeepAlive)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				return
+			}
+
+			// Scopes qualified by a kind or ID only receive the events of it
+			if !clm.HasScope(eventScope(e)) {
+				continue
+			}
+
+			data, err := json.Marshal(e)
+			if err != nil {
+				logger.Error(err)
+				continue
+			}
+
+			if _, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", e.ID, e.Operation, data); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case <-r.Context().Done():
+			return
+		}
+
+		flusher.Flush()
+	}
+}
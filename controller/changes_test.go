@@ -0,0 +1,154 @@
+package controller
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/event"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+
+	"github.com/julienschmidt/httprouter"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestChangesGET(t *testing.T) {
+	clm := &jwt.Claims{Scope: []string{jwt.ScopeItemRead}}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ChangesGET(w, r.WithContext(jwt.NewContext(r.Context(), clm)), httprouter.Params{})
+	}))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/v2/changes?resource=location")
+	if err != nil {
+		t.Fatalf("Getting changes failed: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("Getting changes failed: unexpected response code %v", resp.StatusCode)
+	}
+
+	resp, err = http.Get(srv.URL + "/v2/changes?resource=item&kind=common")
+	if err != nil {
+		t.Fatalf("Getting changes failed: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Getting changes failed: unexpected response code %v", resp.StatusCode)
+	}
+	if resp.Header.Get("Content-Type") != contentTypeEventStream {
+		t.Error("Getting changes failed: content type is invalid")
+	}
+
+	id := primitive.NewObjectID()
+
+	event.Publish(event.OperationUpdate, event.ResourceLocation, "location", primitive.NewObjectID())
+	event.Publish(event.OperationUpdate, event.ResourceItem, "armor", primitive.NewObjectID())
+	event.Publish(event.OperationCreate, event.ResourceItem, "common", id)
+
+	lines := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		close(lines)
+	}()
+
+	var data string
+
+Loop:
+	for {
+		select {
+		case l, ok := <-lines:
+			if !ok {
+				t.Fatal("Getting changes failed: stream closed")
+			}
+			if strings.HasPrefix(l, "data: ") {
+				data = strings.TrimPrefix(l, "data: ")
+				break Loop
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Getting changes failed: timeout")
+		}
+	}
+
+	output := &event.Event{}
+
+	if err := json.Unmarshal([]byte(data), output); err != nil {
+		t.Fatalf("Getting changes failed: %s", err)
+	}
+
+	if output.Document != id.Hex() || output.Operation != event.OperationCreate {
+		t.Errorf("Getting changes failed: unexpected event %v", output)
+	}
+}
+
+func TestChangesGETQualified(t *testing.T) {
+	clm := &jwt.Claims{Scope: []string{jwt.QualifyScope(jwt.ScopeItemRead, "common")}}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ChangesGET(w, r.WithContext(jwt.NewContext(r.Context(), clm)), httprouter.Params{})
+	}))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/v2/changes?resource=item")
+	if err != nil {
+		t.Fatalf("Getting changes failed: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Getting changes failed: unexpected response code %v", resp.StatusCode)
+	}
+
+	id := primitive.NewObjectID()
+
+	// The event of another kind is left out
+	event.Publish(event.OperationUpdate, event.ResourceItem, "armor", primitive.NewObjectID())
+	event.Publish(event.OperationCreate, event.ResourceItem, "common", id)
+
+	lines := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		close(lines)
+	}()
+
+	var data string
+
+Loop:
+	for {
+		select {
+		case l, ok := <-lines:
+			if !ok {
+				t.Fatal("Getting changes failed: stream closed")
+			}
+			if strings.HasPrefix(l, "data: ") {
+				data = strings.TrimPrefix(l, "data: ")
+				break Loop
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Getting changes failed: timeout")
+		}
+	}
+
+	output := &event.Event{}
+
+	if err := json.Unmarshal([]byte(data), output); err != nil {
+		t.Fatalf("Getting changes failed: %s", err)
+	}
+
+	if output.Document != id.Hex() || output.Kind != "common" {
+		t.Errorf("Getting changes failed: unexpected event %v", output)
+	}
+}
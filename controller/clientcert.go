@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/audit"
+	"github.com/tarkov-database/rest-api/model/clientcert"
+	"github.com/tarkov-database/rest-api/model/user"
+	"github.com/tarkov-database/rest-api/view"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// ClientCertsGET handles a GET request on the certificate mapping root endpoint of a user
+func ClientCertsGET(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+
+	if _, err := user.GetByID(r.Context(), id); err != nil {
+		handleError(err, w)
+		return
+	}
+
+	opts := &clientcert.Options{Sort: getSort("-_modified", r)}
+	opts.Limit, opts.Offset = getLimitOffset(r)
+
+	result, err := clientcert.GetByUser(r.Context(), id, opts)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	view.RenderJSON(result, http.StatusOK, w)
+}
+
+// ClientCertGET handles a GET request on a certificate mapping entity endpoint of a user
+func ClientCertGET(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	m, err := clientcert.GetByID(r.Context(), ps.ByName("cert"), ps.ByName("id"))
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	view.RenderJSON(m, http.StatusOK, w)
+}
+
+// ClientCertPOST handles a POST request on the certificate mapping root endpoint of a user
+func ClientCertPOST(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	if !isSupportedMediaType(r) {
+		StatusUnsupportedMediaType("Wrong content type").Render(w)
+		return
+	}
+
+	usr, err := user.GetByID(r.Context(), ps.ByName("id"))
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	rb := &clientcert.Request{}
+
+	if err := parseJSONBody(r.Body, rb); err != nil {
+		StatusBadRequest(fmt.Sprintf("JSON parsing error: %s", err)).Render(w)
+		return
+	}
+
+	m := &clientcert.Mapping{User: usr.ID}
+	rb.Apply(m)
+
+	if err := m.Validate(); err != nil {
+		StatusUnprocessableEntity(fmt.Sprintf("Validation error: %s", err)).Render(w)
+		return
+	}
+
+	if !isScopeOfUser(r.Context(), usr, m.Scope, w) {
+		return
+	}
+
+	if err := clientcert.Create(r.Context(), m); err != nil {
+		handleError(err, w)
+		return
+	}
+
+	audit.SetResource(r, m.ID.Hex())
+
+	logger.Infof("Client certificate mapping %s of user %s created", m.ID.Hex(), usr.ID.Hex())
+
+	view.RenderJSON(m, http.StatusCreated, w)
+}
+
+// ClientCertPUT handles a PUT request on a certificate mapping entity endpoint of a user
+func ClientCertPUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	if !isSupportedMediaType(r) {
+		StatusUnsupportedMediaType("Wrong content type").Render(w)
+		return
+	}
+
+	m, err := clientcert.GetByID(r.Context(), ps.ByName("cert"), ps.ByName("id"))
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	rb := &clientcert.Request{}
+
+	if err := parseJSONBody(r.Body, rb); err != nil {
+		StatusBadRequest(fmt.Sprintf("JSON parsing error: %s", err)).Render(w)
+		return
+	}
+
+	rb.Apply(m)
+
+	if err := m.Validate(); err != nil {
+		StatusUnprocessableEntity(fmt.Sprintf("Validation error: %s", err)).Render(w)
+		return
+	}
+
+	usr, err := user.GetByID(r.Context(), ps.ByName("id"))
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	if !isScopeOfUser(r.Context(), usr, m.Scope, w) {
+		return
+	}
+
+	if err := clientcert.Replace(r.Context(), m); err != nil {
+		handleError(err, w)
+		return
+	}
+
+	logger.Infof("Client certificate mapping %s updated", m.ID.Hex())
+
+	view.RenderJSON(m, http.StatusOK, w)
+}
+
+// ClientCertDELETE handles a DELETE request on a certificate mapping entity endpoint of a user
+func ClientCertDELETE(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("cert")
+
+	if err := clientcert.Remove(r.Context(), id, ps.ByName("id")); err != nil {
+		handleError(err, w)
+		return
+	}
+
+	logger.Infof("Client certificate mapping %s removed", id)
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,127 @@
+package controller
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model/clientcert"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func postClientCert(t *testing.T, userID string, input *clientcert.Request) *clientcert.Mapping {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+
+	if err := json.NewEncoder(buf).Encode(input); err != nil {
+		t.Fatalf("Creating client certificate mapping failed: %s", err)
+	}
+
+	req := httptest.NewRequest("POST", "http://example.com", buf)
+	req.Header.Set("Content-Type", contentTypeJSON)
+
+	w := httptest.NewRecorder()
+
+	ClientCertPOST(w, req, httprouter.Params{httprouter.Param{Key: "id", Value: userID}})
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Creating client certificate mapping failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	output := &clientcert.Mapping{}
+
+	if err := json.NewDecoder(resp.Body).Decode(output); err != nil {
+		t.Fatalf("Creating client certificate mapping failed: %s", err)
+	}
+
+	return output
+}
+
+func TestClientCert(t *testing.T) {
+	userID := userIDs[0].Hex()
+
+	m := postClientCert(t, userID, &clientcert.Request{
+		Label:    "Ingestion",
+		Identity: "dns:ingest.testing.dev",
+		Scope:    []string{jwt.ScopeItemWrite},
+	})
+
+	cert := &x509.Certificate{
+		Subject:  pkix.Name{CommonName: "ingest", Organization: []string{"Tarkov Database"}},
+		DNSNames: []string{"ingest.testing.dev"},
+	}
+
+	req := httptest.NewRequest("GET", "https://example.com", nil)
+	req.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+
+	clm, err := jwt.AuthenticateRequest(req)
+	if err != nil {
+		t.Fatalf("Authenticating client certificate failed: %s", err)
+	}
+
+	if clm.Subject != userID || !clm.HasScope(jwt.ScopeItemWrite) || clm.HasScope(jwt.ScopeUserWrite) {
+		t.Errorf("Authenticating client certificate failed: unexpected claims %+v", clm)
+	}
+
+	// A certificate matching several mappings is ambiguous
+	postClientCert(t, userID, &clientcert.Request{
+		Label:    "Ingestion subject",
+		Identity: "subject:" + cert.Subject.String(),
+		Scope:    []string{jwt.ScopeItemRead},
+	})
+
+	if _, err := jwt.AuthenticateRequest(req); !errors.Is(err, jwt.ErrInvalidClientCert) {
+		t.Errorf("Authenticating client certificate failed: ambiguous certificate accepted: %v", err)
+	}
+
+	w := httptest.NewRecorder()
+
+	params := httprouter.Params{
+		httprouter.Param{Key: "id", Value: userID},
+		httprouter.Param{Key: "cert", Value: m.ID.Hex()},
+	}
+
+	ClientCertDELETE(w, httptest.NewRequest("DELETE", "http://example.com", nil), params)
+
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("Removing client certificate mapping failed: unexpcted response code %v", w.Code)
+	}
+
+	clm, err = jwt.AuthenticateRequest(req)
+	if err != nil {
+		t.Fatalf("Authenticating client certificate failed: %s", err)
+	}
+
+	if clm.HasScope(jwt.ScopeItemWrite) || !clm.HasScope(jwt.ScopeItemRead) {
+		t.Errorf("Authenticating client certificate failed: unexpected claims %+v", clm)
+	}
+
+	buf := new(bytes.Buffer)
+
+	if err := json.NewEncoder(buf).Encode(&clientcert.Request{Label: "Invalid", Identity: "ip:192.0.2.1", Scope: []string{jwt.ScopeItemRead}}); err != nil {
+		t.Fatal(err)
+	}
+
+	req = httptest.NewRequest("POST", "http://example.com", buf)
+	req.Header.Set("Content-Type", contentTypeJSON)
+
+	w = httptest.NewRecorder()
+
+	ClientCertPOST(w, req, httprouter.Params{httprouter.Param{Key: "id", Value: userID}})
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Creating client certificate mapping failed: invalid identity accepted with %v", w.Code)
+	}
+}
@@ -5,21 +5,31 @@ import (
 	"io"
 	"log"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/tarkov-database/rest-api/core/config"
 	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/graph"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/core/mail"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
 	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/model/apikey"
+	"github.com/tarkov-database/rest-api/model/clientcert"
 	"github.com/tarkov-database/rest-api/model/hideout/module"
 	"github.com/tarkov-database/rest-api/model/hideout/production"
 	"github.com/tarkov-database/rest-api/model/item"
 	"github.com/tarkov-database/rest-api/model/location"
 	"github.com/tarkov-database/rest-api/model/location/feature"
 	"github.com/tarkov-database/rest-api/model/location/featuregroup"
+	"github.com/tarkov-database/rest-api/model/role"
 	"github.com/tarkov-database/rest-api/model/statistic/ammunition/armor"
+	"github.com/tarkov-database/rest-api/model/token"
 	"github.com/tarkov-database/rest-api/model/user"
+	"github.com/tarkov-database/rest-api/model/webhook"
 
-	"github.com/google/logger"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -35,17 +45,70 @@ var (
 	featureIDs        []primitive.ObjectID
 	featureGroupIDs   []primitive.ObjectID
 	ammoArmorStatsIDs []primitive.ObjectID
+	webhookIDs        []primitive.ObjectID
 )
 
 func init() {
-	logger.Init("default", false, false, io.Discard)
+	logger.SetOutput(io.Discard)
 }
 
+// mailRecorder keeps sent messages for inspection
+type mailRecorder struct {
+	mu   sync.Mutex
+	msgs []*mail.Message
+}
+
+func (m *mailRecorder) Send(msg *mail.Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.msgs = append(m.msgs, msg)
+
+	return nil
+}
+
+// last returns the last message sent to the address
+func (m *mailRecorder) last(to string) *mail.Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := len(m.msgs) - 1; i >= 0; i-- {
+		if m.msgs[i].To == to {
+			return m.msgs[i]
+		}
+	}
+
+	return nil
+}
+
+var mailbox = &mailRecorder{}
+
 func mongoStartup() {
+	c, err := config.Load("")
+	if err != nil {
+		log.Fatalf("Configuration error: %s", err)
+	}
+
+	database.Configure(c.Database)
+
+	if err := jwt.Configure(c.JWT); err != nil {
+		log.Fatalf("JWT configuration error: %s", err)
+	}
+
 	if err := database.Init(); err != nil {
 		log.Fatalf("Database startup error: %s", err)
 	}
 
+	if err := graph.Init(); err != nil {
+		log.Fatalf("GraphQL schema error: %s", err)
+	}
+
+	jwt.SetRevocationFunc(token.IsRevoked)
+	jwt.SetAPIKeyFunc(apikey.Authenticate)
+	jwt.SetClientCertFunc(clientcert.Authenticate)
+
+	mail.SetMailer(mailbox)
+
 	createUsers()
 	createItems()
 	createModules()
@@ -54,6 +117,7 @@ func mongoStartup() {
 	createFeatureGroups()
 	createStatisticAmmoArmor()
 	createFeatures()
+	createWebhooks()
 }
 
 func mongoCleanup() {
@@ -65,6 +129,7 @@ func mongoCleanup() {
 	removeFeatureGroups()
 	removeStatisticAmmoArmor()
 	removeUsers()
+	removeWebhooks()
 
 	if err := database.Shutdown(); err != nil {
 		log.Fatalf("Database shutdown error: %s", err)
@@ -215,6 +280,24 @@ func removeUserID(id primitive.ObjectID) {
 	userIDs = ids
 }
 
+func createWebhookID() primitive.ObjectID {
+	id := primitive.NewObjectID()
+	webhookIDs = append(webhookIDs, id)
+
+	return id
+}
+
+func removeWebhookID(id primitive.ObjectID) {
+	ids := make([]primitive.ObjectID, 0, len(webhookIDs)-1)
+	for _, k := range webhookIDs {
+		if k != id {
+			ids = append(ids, k)
+		}
+	}
+
+	webhookIDs = ids
+}
+
 func createItems() {
 	c := database.GetDB().Collection(item.Collection)
 
@@ -496,8 +579,8 @@ func createUsers() {
 	ctx, cancel := context.WithTimeout(context.Background(), 12*time.Second)
 	defer cancel()
 
-	userA := user.User{ID: createUserID()}
-	userB := user.User{ID: createUserID()}
+	userA := user.User{ID: createUserID(), Roles: []string{role.Admin}}
+	userB := user.User{ID: createUserID(), Roles: []string{role.Viewer}}
 
 	if _, err := c.InsertMany(ctx, bson.A{userA, userB}); err != nil {
 		log.Fatalf("Database startup error: %s", err)
@@ -513,6 +596,70 @@ func removeUsers() {
 	if _, err := c.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": userIDs}}); err != nil {
 		log.Fatalf("Database cleanup error: %s", err)
 	}
+
+	if _, err := database.GetDB().Collection(apikey.Collection).DeleteMany(ctx, bson.M{"user": bson.M{"$in": userIDs}}); err != nil {
+		log.Fatalf("Database cleanup error: %s", err)
+	}
+
+	if _, err := database.GetDB().Collection(clientcert.Collection).DeleteMany(ctx, bson.M{"user": bson.M{"$in": userIDs}}); err != nil {
+		log.Fatalf("Database cleanup error: %s", err)
+	}
+
+	subjects := make([]string, len(userIDs))
+	for i, id := range userIDs {
+		subjects[i] = id.Hex()
+	}
+
+	if _, err := database.GetDB().Collection(role.Collection).DeleteMany(ctx, bson.M{}); err != nil {
+		log.Fatalf("Database cleanup error: %s", err)
+	}
+
+	if _, err := database.GetDB().Collection(token.OneTimeCollection).DeleteMany(ctx, bson.M{"user": bson.M{"$in": userIDs}}); err != nil {
+		log.Fatalf("Database cleanup error: %s", err)
+	}
+
+	for _, col := range []string{token.RevocationCollection, token.RefreshCollection} {
+		c := database.GetDB().Collection(col)
+		if _, err := c.DeleteMany(ctx, bson.M{"sub": bson.M{"$in": subjects}}); err != nil {
+			log.Fatalf("Database cleanup error: %s", err)
+		}
+	}
+}
+
+func createWebhooks() {
+	c := database.GetDB().Collection(webhook.Collection)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 12*time.Second)
+	defer cancel()
+
+	hookA := webhook.Webhook{
+		ID:       createWebhookID(),
+		URL:      "https://example.com/hook/a",
+		Secret:   "secret a",
+		Modified: model.Timestamp{Time: time.Now()},
+	}
+	hookB := webhook.Webhook{
+		ID:       createWebhookID(),
+		URL:      "https://example.com/hook/b",
+		Secret:   "secret b",
+		Disabled: true,
+		Modified: model.Timestamp{Time: time.Now()},
+	}
+
+	if _, err := c.InsertMany(ctx, bson.A{hookA, hookB}); err != nil {
+		log.Fatalf("Database startup error: %s", err)
+	}
+}
+
+func removeWebhooks() {
+	c := database.GetDB().Collection(webhook.Collection)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 12*time.Second)
+	defer cancel()
+
+	if _, err := c.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": webhookIDs}}); err != nil {
+		log.Fatalf("Database cleanup error: %s", err)
+	}
 }
 
 func TestMain(m *testing.M) {
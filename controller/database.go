@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/view"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// DatabaseIndexesGET handles a GET request on the database index endpoint.
+// It lists the declared indexes with their state and the undeclared ones.
+func DatabaseIndexesGET(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	status, err := database.CheckIndexes(r.Context())
+	if err != nil {
+		logger.Error(err)
+		handleError(model.ErrInternalError, w)
+		return
+	}
+
+	result := &model.Result{Count: int64(len(status)), Items: make([]interface{}, len(status))}
+	for i := range status {
+		result.Items[i] = &status[i]
+	}
+
+	view.RenderJSON(result, http.StatusOK, w)
+}
@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tarkov-database/rest-api/core/database"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestDatabaseIndexesGET(t *testing.T) {
+	w := httptest.NewRecorder()
+	DatabaseIndexesGET(w, httptest.NewRequest("GET", "http://example.com/v2/database/index", nil), httprouter.Params{})
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Getting indexes failed: unexpected response code %v", w.Code)
+	}
+
+	output := &struct {
+		Count int64                  `json:"total"`
+		Items []database.IndexStatus `json:"items"`
+	}{}
+	if err := json.NewDecoder(w.Body).Decode(output); err != nil {
+		t.Fatalf("Decoding indexes failed: %s", err)
+	}
+
+	if output.Count < int64(len(database.DeclaredIndexes())) {
+		t.Errorf("Getting indexes failed: %v indexes, expected at least %v declared", output.Count, len(database.DeclaredIndexes()))
+	}
+}
@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/tarkov-database/rest-api/core/graph"
+	"github.com/tarkov-database/rest-api/view"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// GraphQLGET handles a GET request on the GraphQL endpoint
+func GraphQLGET(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	q := r.URL.Query()
+
+	req := &graph.Request{
+		Query:         q.Get("query"),
+		OperationName: q.Get("operationName"),
+	}
+
+	if v := q.Get("variables"); v != "" {
+		if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+			StatusBadRequest(fmt.Sprintf("Variables parsing error: %s", err)).Render(w)
+			return
+		}
+	}
+
+	executeGraphQL(req, w, r)
+}
+
+// GraphQLPOST handles a POST request on the GraphQL endpoint
+func GraphQLPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	if !isSupportedMediaType(r) {
+		StatusUnsupportedMediaType("Wrong content type").Render(w)
+		return
+	}
+
+	req := &graph.Request{}
+
+	if err := parseJSONBody(r.Body, req); err != nil {
+		StatusBadRequest(fmt.Sprintf("JSON parsing error: %s", err)).Render(w)
+		return
+	}
+
+	executeGraphQL(req, w, r)
+}
+
+func executeGraphQL(req *graph.Request, w http.ResponseWriter, r *http.Request) {
+	if req.Query == "" {
+		StatusBadRequest("Query is missing").Render(w)
+		return
+	}
+
+	res := graph.Do(r.Context(), req)
+
+	// Requests which could not be executed at all are rejected
+	if res.Data == nil && res.HasErrors() {
+		view.RenderJSON(res, http.StatusBadRequest, w)
+		return
+	}
+
+	view.RenderJSON(res, http.StatusOK, w)
+}
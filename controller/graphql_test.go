@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+type graphqlResult struct {
+	Data struct {
+		HideoutModules struct {
+			Total int64 `json:"total"`
+			Items []struct {
+				ID   string `json:"_id"`
+				Name string `json:"name"`
+			} `json:"items"`
+		} `json:"hideoutModules"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func TestGraphQLPOST(t *testing.T) {
+	body, err := json.Marshal(map[string]string{
+		"query": `{ hideoutModules(limit: 10) { total items { _id name } } }`,
+	})
+	if err != nil {
+		t.Fatalf("Executing query failed: %s", err)
+	}
+
+	clm := &jwt.Claims{Scope: []string{jwt.ScopeHideoutRead}}
+
+	req := httptest.NewRequest("POST", "http://example.com/v2/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", contentTypeJSON)
+	req = req.WithContext(jwt.NewContext(req.Context(), clm))
+
+	w := httptest.NewRecorder()
+
+	GraphQLPOST(w, req, httprouter.Params{})
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Executing query failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	output := &graphqlResult{}
+
+	if err := json.NewDecoder(resp.Body).Decode(output); err != nil {
+		t.Fatalf("Executing query failed: %s", err)
+	}
+
+	if len(output.Errors) > 0 {
+		t.Fatalf("Executing query failed: %s", output.Errors[0].Message)
+	}
+
+	if output.Data.HideoutModules.Total != int64(len(moduleIDs)) {
+		t.Errorf("Executing query failed: unexpected total %v", output.Data.HideoutModules.Total)
+	}
+	if len(output.Data.HideoutModules.Items) != len(moduleIDs) {
+		t.Errorf("Executing query failed: unexpected item count %v", len(output.Data.HideoutModules.Items))
+	}
+}
+
+func TestGraphQLGETScope(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/v2/graphql?query={hideoutModules{total}}", nil)
+	req = req.WithContext(jwt.NewContext(req.Context(), &jwt.Claims{Scope: []string{jwt.ScopeItemRead}}))
+
+	w := httptest.NewRecorder()
+
+	GraphQLGET(w, req, httprouter.Params{})
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	output := &graphqlResult{}
+
+	if err := json.NewDecoder(resp.Body).Decode(output); err != nil {
+		t.Fatalf("Executing query failed: %s", err)
+	}
+
+	if len(output.Errors) != 1 {
+		t.Errorf("Executing query failed: expected scope error, got %v", output.Errors)
+	}
+}
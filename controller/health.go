@@ -3,6 +3,7 @@ package controller
 import (
 	"net/http"
 
+	"github.com/tarkov-database/rest-api/core/health"
 	"github.com/tarkov-database/rest-api/model/api"
 	"github.com/tarkov-database/rest-api/view"
 
@@ -10,8 +11,8 @@ import (
 )
 
 // HealthGET handles a GET request on the health endpoint
-func HealthGET(w http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
-	h := api.GetHealth()
+func HealthGET(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	h := api.GetHealth(r.Context())
 
 	if !h.OK {
 		view.RenderJSON(h, http.StatusInternalServerError, w)
@@ -19,3 +20,18 @@ func HealthGET(w http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
 		view.RenderJSON(h, http.StatusOK, w)
 	}
 }
+
+// LivezGET handles a GET request on the liveness endpoint
+func LivezGET(w http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
+	StatusOK("Service is alive").Render(w)
+}
+
+// ReadyzGET handles a GET request on the readiness endpoint
+func ReadyzGET(w http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
+	if !health.Ready() {
+		StatusServiceUnavailable("Service is not ready").Render(w)
+		return
+	}
+
+	StatusOK("Service is ready").Render(w)
+}
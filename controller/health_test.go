@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tarkov-database/rest-api/core/health"
+	"github.com/tarkov-database/rest-api/model/api"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestProbes(t *testing.T) {
+	t.Cleanup(func() { health.SetReady(false) })
+
+	w := httptest.NewRecorder()
+	LivezGET(w, httptest.NewRequest("GET", "http://example.com/livez", nil), httprouter.Params{})
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Getting liveness failed: unexpected response code %v", w.Code)
+	}
+
+	health.SetReady(false)
+
+	w = httptest.NewRecorder()
+	ReadyzGET(w, httptest.NewRequest("GET", "http://example.com/readyz", nil), httprouter.Params{})
+
+	if w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("Getting readiness during startup failed: unexpected response code %v", w.Code)
+	}
+
+	health.SetReady(true)
+
+	w = httptest.NewRecorder()
+	ReadyzGET(w, httptest.NewRequest("GET", "http://example.com/readyz", nil), httprouter.Params{})
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Getting readiness failed: unexpected response code %v", w.Code)
+	}
+}
+
+func TestHealth(t *testing.T) {
+	w := httptest.NewRecorder()
+	HealthGET(w, httptest.NewRequest("GET", "http://example.com", nil), httprouter.Params{})
+
+	output := &api.Health{}
+	if err := json.NewDecoder(w.Body).Decode(output); err != nil {
+		t.Fatalf("Decoding health failed: %s", err)
+	}
+
+	if output.Version != api.Version || output.Certificates == nil {
+		t.Errorf("Getting health failed: incomplete report %+v", output)
+	}
+
+	if output.Indexes == nil {
+		t.Error("Getting health failed: indexes are missing")
+	}
+}
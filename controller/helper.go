@@ -72,6 +72,8 @@ func handleError(err error, w http.ResponseWriter) {
 		res = StatusNotFound("Resource ID is not valid")
 	case model.ErrInvalidInput:
 		res = StatusUnprocessableEntity("Input is not valid")
+	case model.ErrDuplicate:
+		res = StatusConflict("Resource already exists")
 	case model.ErrInternalError:
 		res = StatusInternalServerError("Backend error")
 	default:
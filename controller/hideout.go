@@ -6,18 +6,19 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/audit"
 	"github.com/tarkov-database/rest-api/model"
 	"github.com/tarkov-database/rest-api/model/hideout/module"
 	"github.com/tarkov-database/rest-api/model/hideout/production"
 	"github.com/tarkov-database/rest-api/view"
 
-	"github.com/google/logger"
 	"github.com/julienschmidt/httprouter"
 )
 
 // ModuleGET handles a GET request on a module entity endpoint
-func ModuleGET(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
-	mod, err := module.GetByID(ps.ByName("id"))
+func ModuleGET(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	mod, err := module.GetByID(r.Context(), ps.ByName("id"))
 	if err != nil {
 		handleError(err, w)
 		return
@@ -55,7 +56,7 @@ Loop:
 				return
 			}
 
-			result, err = module.GetByIDs(ids, opts)
+			result, err = module.GetByIDs(r.Context(), ids, opts)
 			if err != nil {
 				var res *Status
 
@@ -91,7 +92,7 @@ Loop:
 				return
 			}
 
-			result, err = module.GetByText(txt, opts)
+			result, err = module.GetByText(r.Context(), txt, opts)
 			if err != nil {
 				handleError(err, w)
 				return
@@ -105,7 +106,7 @@ Loop:
 				return
 			}
 
-			result, err = module.GetByMaterial(mat, opts)
+			result, err = module.GetByMaterial(r.Context(), mat, opts)
 			if err != nil {
 				handleError(err, w)
 				return
@@ -116,7 +117,7 @@ Loop:
 	}
 
 	if result == nil {
-		result, err = module.GetAll(opts)
+		result, err = module.GetAll(r.Context(), opts)
 		if err != nil {
 			handleError(err, w)
 			return
@@ -145,11 +146,13 @@ func ModulePOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 		return
 	}
 
-	if err := module.Create(mod); err != nil {
+	if err := module.Create(r.Context(), mod); err != nil {
 		handleError(err, w)
 		return
 	}
 
+	audit.SetResource(r, mod.ID.Hex())
+
 	logger.Infof("Module %s created", mod.ID.Hex())
 
 	view.RenderJSON(mod, http.StatusCreated, w)
@@ -181,7 +184,7 @@ func ModulePUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
-	if err := module.Replace(id, mod); err != nil {
+	if err := module.Replace(r.Context(), id, mod); err != nil {
 		handleError(err, w)
 		return
 	}
@@ -192,10 +195,10 @@ func ModulePUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 }
 
 // ModuleDELETE handles a DELETE request on a module entity endpoint
-func ModuleDELETE(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
+func ModuleDELETE(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	id := ps.ByName("id")
 
-	if err := module.Remove(id); err != nil {
+	if err := module.Remove(r.Context(), id); err != nil {
 		handleError(err, w)
 		return
 	}
@@ -206,8 +209,8 @@ func ModuleDELETE(w http.ResponseWriter, _ *http.Request, ps httprouter.Params)
 }
 
 // ProductionGET handles a GET request on a production entity endpoint
-func ProductionGET(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
-	prod, err := production.GetByID(ps.ByName("id"))
+func ProductionGET(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	prod, err := production.GetByID(r.Context(), ps.ByName("id"))
 	if err != nil {
 		handleError(err, w)
 		return
@@ -245,7 +248,7 @@ Loop:
 				return
 			}
 
-			result, err = production.GetByIDs(ids, opts)
+			result, err = production.GetByIDs(r.Context(), ids, opts)
 			if err != nil {
 				var res *Status
 
@@ -271,7 +274,7 @@ Loop:
 				return
 			}
 
-			result, err = production.GetByModule(mod, opts)
+			result, err = production.GetByModule(r.Context(), mod, opts)
 			if err != nil {
 				handleError(err, w)
 				return
@@ -285,7 +288,7 @@ Loop:
 				return
 			}
 
-			result, err = production.GetByMaterial(mat, opts)
+			result, err = production.GetByMaterial(r.Context(), mat, opts)
 			if err != nil {
 				handleError(err, w)
 				return
@@ -299,7 +302,7 @@ Loop:
 				return
 			}
 
-			result, err = production.GetByOutcome(out, opts)
+			result, err = production.GetByOutcome(r.Context(), out, opts)
 			if err != nil {
 				handleError(err, w)
 				return
@@ -310,7 +313,7 @@ Loop:
 	}
 
 	if result == nil {
-		result, err = production.GetAll(opts)
+		result, err = production.GetAll(r.Context(), opts)
 		if err != nil {
 			handleError(err, w)
 			return
@@ -339,11 +342,13 @@ func ProductionPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params)
 		return
 	}
 
-	if err := production.Create(prod); err != nil {
+	if err := production.Create(r.Context(), prod); err != nil {
 		handleError(err, w)
 		return
 	}
 
+	audit.SetResource(r, prod.ID.Hex())
+
 	logger.Infof("Production %s created", prod.ID.Hex())
 
 	view.RenderJSON(prod, http.StatusCreated, w)
@@ -375,7 +380,7 @@ func ProductionPUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 		return
 	}
 
-	if err := production.Replace(id, prod); err != nil {
+	if err := production.Replace(r.Context(), id, prod); err != nil {
 		handleError(err, w)
 		return
 	}
@@ -386,10 +391,10 @@ func ProductionPUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 }
 
 // ProductionDELETE handles a DELETE request on a production entity endpoint
-func ProductionDELETE(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
+func ProductionDELETE(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	id := ps.ByName("id")
 
-	if err := production.Remove(id); err != nil {
+	if err := production.Remove(r.Context(), id); err != nil {
 		handleError(err, w)
 		return
 	}
@@ -7,11 +7,13 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/audit"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
 	"github.com/tarkov-database/rest-api/model"
 	"github.com/tarkov-database/rest-api/model/item"
 	"github.com/tarkov-database/rest-api/view"
 
-	"github.com/google/logger"
 	"github.com/julienschmidt/httprouter"
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -26,7 +28,7 @@ func ItemIndexGET(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 		}
 	}
 
-	idx, err := item.GetIndex(skipKinds)
+	idx, err := item.GetIndex(r.Context(), skipKinds)
 	if err != nil {
 		handleError(err, w)
 		return
@@ -36,14 +38,14 @@ func ItemIndexGET(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 }
 
 // ItemGET handles a GET request on a item entity endpoint
-func ItemGET(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
+func ItemGET(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	kind := item.Kind(ps.ByName("kind"))
 	if !kind.IsValid() {
 		StatusNotFound("Kind not found").Render(w)
 		return
 	}
 
-	i, err := item.GetByID(ps.ByName("id"), kind)
+	i, err := item.GetByID(r.Context(), ps.ByName("id"), kind)
 	if err != nil {
 		handleError(err, w)
 		return
@@ -87,7 +89,7 @@ Loop:
 				return
 			}
 
-			result, err = item.GetByIDs(ids, kind, opts)
+			result, err = item.GetByIDs(r.Context(), ids, kind, opts)
 			if err != nil {
 				var res *Status
 
@@ -123,7 +125,7 @@ Loop:
 				return
 			}
 
-			result, err = item.GetByText(txt, opts, kind)
+			result, err = item.GetByText(r.Context(), txt, opts, kind)
 			if err != nil {
 				handleError(err, w)
 				return
@@ -276,7 +278,7 @@ Loop:
 			return
 		}
 
-		result, err = item.GetAll(filter, kind, opts)
+		result, err = item.GetAll(r.Context(), filter, kind, opts)
 		if err != nil {
 			handleError(err, w)
 			return
@@ -316,11 +318,13 @@ func ItemPOST(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
-	if err = item.Create(entity); err != nil {
+	if err = item.Create(r.Context(), entity); err != nil {
 		handleError(err, w)
 		return
 	}
 
+	audit.SetResource(r, entity.GetID().Hex())
+
 	logger.Infof("Item %s created", entity.GetID().Hex())
 
 	view.RenderJSON(entity, http.StatusCreated, w)
@@ -361,7 +365,7 @@ func ItemPUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
-	if err := item.Replace(id, entity); err != nil {
+	if err := item.Replace(r.Context(), id, entity); err != nil {
 		handleError(err, w)
 		return
 	}
@@ -372,10 +376,26 @@ func ItemPUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 }
 
 // ItemDELETE handles a DELETE request on a item entity endpoint
-func ItemDELETE(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
+func ItemDELETE(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	id := ps.ByName("id")
 
-	if err := item.Remove(id); err != nil {
+	// Tokens restricted to some kinds need the kind of the item to be checked
+	if clm, ok := jwt.FromContext(r.Context()); ok && !clm.HasScope(jwt.ScopeItemWrite) {
+		kind, err := item.GetKindByID(r.Context(), id)
+		if err != nil {
+			handleError(err, w)
+			return
+		}
+
+		scope := jwt.QualifyScope(jwt.ScopeItemWrite, kind.String())
+		if !clm.HasScope(scope) {
+			jwt.AddAuthenticateHeader(w, jwt.ErrInvalidScope, scope, jwt.ScopeAllWrite)
+			StatusForbidden("Insufficient permissions").Render(w)
+			return
+		}
+	}
+
+	if err := item.Remove(r.Context(), id); err != nil {
 		handleError(err, w)
 		return
 	}
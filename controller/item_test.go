@@ -2,11 +2,15 @@ package controller
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/tarkov-database/rest-api/core/cache"
+	"github.com/tarkov-database/rest-api/core/config"
 	"github.com/tarkov-database/rest-api/model/item"
 
 	"github.com/julienschmidt/httprouter"
@@ -132,6 +136,55 @@ func TestItemsGET(t *testing.T) {
 	}
 }
 
+// TestItemsGETCached covers the invalidation of the cached list of a kind
+// whose name isn't lowercase
+func TestItemsGETCached(t *testing.T) {
+	cache.Configure(config.Cache{Enabled: true, Size: 1 << 20, TTL: config.Duration{Duration: time.Minute}})
+	cache.Init()
+	defer cache.Configure(config.Default().Cache)
+
+	kind := item.KindModificationBarrel
+
+	h := cache.Handler("/v2/item/:kind", cache.ParamTag(item.Collection, "kind"), ItemsGET)
+	params := httprouter.Params{httprouter.Param{Key: "kind", Value: kind.String()}}
+
+	get := func() (string, int64) {
+		w := httptest.NewRecorder()
+		h(w, httptest.NewRequest("GET", "http://example.com/v2/item/"+kind.String(), nil), params)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("Getting items failed: unexpcted response code %v", w.Code)
+		}
+
+		res := &itemResult{}
+		if err := json.NewDecoder(w.Body).Decode(res); err != nil {
+			t.Fatalf("Getting items failed: %s", err)
+		}
+
+		return w.Header().Get("X-Cache"), res.Count
+	}
+
+	get()
+
+	state, count := get()
+	if state != "HIT" {
+		t.Fatalf("Getting items failed: second response is a cache %s", state)
+	}
+
+	barrel := &item.Barrel{}
+	barrel.Name, barrel.Kind = "test barrel", kind
+
+	if err := item.Create(context.Background(), barrel); err != nil {
+		t.Fatalf("Creating item failed: %s", err)
+	}
+	defer item.Remove(context.Background(), barrel.ID.Hex())
+
+	state, fresh := get()
+	if state != "MISS" || fresh != count+1 {
+		t.Errorf("Getting items failed: response after creation is a cache %s with %v items, expected %v", state, fresh, count+1)
+	}
+}
+
 func TestItemPOST(t *testing.T) {
 	itemID := createItemID()
 
@@ -142,10 +195,8 @@ func TestItemPOST(t *testing.T) {
 		Name:        "test item",
 		ShortName:   "test",
 		Description: "test description",
-		Price:       1000,
 		Weight:      3.7,
 		MaxStack:    1,
-		Rarity:      "rare",
 		Kind:        "common",
 	}
 
@@ -198,10 +249,8 @@ func TestItemPUT(t *testing.T) {
 		Name:        "change item name",
 		ShortName:   "test",
 		Description: "test description",
-		Price:       1000,
 		Weight:      3.7,
 		MaxStack:    1,
-		Rarity:      "rare",
 		Kind:        "common",
 	}
 
@@ -8,19 +8,20 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/audit"
 	"github.com/tarkov-database/rest-api/model"
 	"github.com/tarkov-database/rest-api/model/location"
 	"github.com/tarkov-database/rest-api/model/location/feature"
 	"github.com/tarkov-database/rest-api/model/location/featuregroup"
 	"github.com/tarkov-database/rest-api/view"
 
-	"github.com/google/logger"
 	"github.com/julienschmidt/httprouter"
 )
 
 // LocationGET handles a GET request on a location entity endpoint
-func LocationGET(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
-	loc, err := location.GetByID(ps.ByName("id"))
+func LocationGET(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	loc, err := location.GetByID(r.Context(), ps.ByName("id"))
 	if err != nil {
 		handleError(err, w)
 		return
@@ -57,7 +58,7 @@ Loop:
 				return
 			}
 
-			result, err = location.GetByText(txt, opts)
+			result, err = location.GetByText(r.Context(), txt, opts)
 			if err != nil {
 				handleError(err, w)
 				return
@@ -71,7 +72,7 @@ Loop:
 				return
 			}
 
-			result, err = location.GetByAvailability(available, opts)
+			result, err = location.GetByAvailability(r.Context(), available, opts)
 			if err != nil {
 				handleError(err, w)
 				return
@@ -82,7 +83,7 @@ Loop:
 	}
 
 	if result == nil {
-		result, err = location.GetAll(opts)
+		result, err = location.GetAll(r.Context(), opts)
 		if err != nil {
 			handleError(err, w)
 			return
@@ -111,11 +112,13 @@ func LocationPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 		return
 	}
 
-	if err := location.Create(loc); err != nil {
+	if err := location.Create(r.Context(), loc); err != nil {
 		handleError(err, w)
 		return
 	}
 
+	audit.SetResource(r, loc.ID.Hex())
+
 	logger.Infof("Location %s created", loc.ID.Hex())
 
 	view.RenderJSON(loc, http.StatusCreated, w)
@@ -147,7 +150,7 @@ func LocationPUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
-	if err := location.Replace(id, loc); err != nil {
+	if err := location.Replace(r.Context(), id, loc); err != nil {
 		handleError(err, w)
 		return
 	}
@@ -158,10 +161,10 @@ func LocationPUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 }
 
 // LocationDELETE handles a DELETE request on a location entity endpoint
-func LocationDELETE(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
+func LocationDELETE(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	id := ps.ByName("id")
 
-	if err := location.Remove(id); err != nil {
+	if err := location.Remove(r.Context(), id); err != nil {
 		handleError(err, w)
 		return
 	}
@@ -172,8 +175,8 @@ func LocationDELETE(w http.ResponseWriter, _ *http.Request, ps httprouter.Params
 }
 
 // FeatureGET handles a GET request on a feature entity endpoint
-func FeatureGET(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
-	ft, err := feature.GetByID(ps.ByName("fid"), ps.ByName("id"))
+func FeatureGET(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ft, err := feature.GetByID(r.Context(), ps.ByName("fid"), ps.ByName("id"))
 	if err != nil {
 		handleError(err, w)
 		return
@@ -212,7 +215,7 @@ Loop:
 				return
 			}
 
-			result, err = feature.GetByText(txt, lID, opts)
+			result, err = feature.GetByText(r.Context(), txt, lID, opts)
 			if err != nil {
 				handleError(err, w)
 				return
@@ -226,7 +229,7 @@ Loop:
 				return
 			}
 
-			result, err = feature.GetByGroup(grp, lID, opts)
+			result, err = feature.GetByGroup(r.Context(), grp, lID, opts)
 			if err != nil {
 				handleError(err, w)
 				return
@@ -237,7 +240,7 @@ Loop:
 	}
 
 	if result == nil {
-		result, err = feature.GetAll(ps.ByName("id"), opts)
+		result, err = feature.GetAll(r.Context(), ps.ByName("id"), opts)
 		if err != nil {
 			handleError(err, w)
 			return
@@ -273,7 +276,7 @@ func FeaturePOST(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
-	loc, err := location.GetByID(lID)
+	loc, err := location.GetByID(r.Context(), lID)
 	if err != nil {
 		if errors.Is(err, model.ErrNoResult) {
 			StatusUnprocessableEntity("Location doesn't exist").Render(w)
@@ -287,7 +290,7 @@ func FeaturePOST(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		ft.Location = loc.ID
 	}
 
-	if _, err := featuregroup.GetByID(ft.Group.Hex(), lID); err != nil {
+	if _, err := featuregroup.GetByID(r.Context(), ft.Group.Hex(), lID); err != nil {
 		if errors.Is(err, model.ErrNoResult) {
 			StatusUnprocessableEntity("Feature group doesn't exist").Render(w)
 			return
@@ -296,11 +299,13 @@ func FeaturePOST(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
-	if err := feature.Create(ft); err != nil {
+	if err := feature.Create(r.Context(), ft); err != nil {
 		handleError(err, w)
 		return
 	}
 
+	audit.SetResource(r, ft.ID.Hex())
+
 	logger.Infof("Feature %s created", ft.ID.Hex())
 
 	view.RenderJSON(ft, http.StatusCreated, w)
@@ -338,7 +343,7 @@ func FeaturePUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
-	loc, err := location.GetByID(lID)
+	loc, err := location.GetByID(r.Context(), lID)
 	if err != nil {
 		if errors.Is(err, model.ErrNoResult) {
 			StatusUnprocessableEntity("Location doesn't exist").Render(w)
@@ -352,7 +357,7 @@ func FeaturePUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		ft.Location = loc.ID
 	}
 
-	if _, err := featuregroup.GetByID(ft.Group.Hex(), lID); err != nil {
+	if _, err := featuregroup.GetByID(r.Context(), ft.Group.Hex(), lID); err != nil {
 		if errors.Is(err, model.ErrNoResult) {
 			StatusUnprocessableEntity("Feature group doesn't exist").Render(w)
 			return
@@ -361,7 +366,7 @@ func FeaturePUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
-	if err := feature.Replace(fID, ft); err != nil {
+	if err := feature.Replace(r.Context(), fID, lID, ft); err != nil {
 		handleError(err, w)
 		return
 	}
@@ -372,10 +377,10 @@ func FeaturePUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 }
 
 // FeatureDELETE handles a DELETE request on a feature entity endpoint
-func FeatureDELETE(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
-	id := ps.ByName("id")
+func FeatureDELETE(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("fid")
 
-	if err := feature.Remove(id); err != nil {
+	if err := feature.Remove(r.Context(), id, ps.ByName("id")); err != nil {
 		handleError(err, w)
 		return
 	}
@@ -386,8 +391,8 @@ func FeatureDELETE(w http.ResponseWriter, _ *http.Request, ps httprouter.Params)
 }
 
 // FeatureGroupGET handles a GET request on a feature group entity endpoint
-func FeatureGroupGET(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
-	ft, err := featuregroup.GetByID(ps.ByName("gid"), ps.ByName("id"))
+func FeatureGroupGET(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ft, err := featuregroup.GetByID(r.Context(), ps.ByName("gid"), ps.ByName("id"))
 	if err != nil {
 		handleError(err, w)
 		return
@@ -426,7 +431,7 @@ Loop:
 				return
 			}
 
-			result, err = featuregroup.GetByText(txt, lID, opts)
+			result, err = featuregroup.GetByText(r.Context(), txt, lID, opts)
 			if err != nil {
 				handleError(err, w)
 				return
@@ -452,7 +457,7 @@ Loop:
 
 			tags := strings.Split(q, ",")
 
-			result, err = featuregroup.GetByTags(tags, lID, opts)
+			result, err = featuregroup.GetByTags(r.Context(), tags, lID, opts)
 			if err != nil {
 				handleError(err, w)
 				return
@@ -463,7 +468,7 @@ Loop:
 	}
 
 	if result == nil {
-		result, err = featuregroup.GetAll(lID, opts)
+		result, err = featuregroup.GetAll(r.Context(), lID, opts)
 		if err != nil {
 			handleError(err, w)
 			return
@@ -499,7 +504,7 @@ func FeatureGroupPOST(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		return
 	}
 
-	loc, err := location.GetByID(lID)
+	loc, err := location.GetByID(r.Context(), lID)
 	if err != nil {
 		if errors.Is(err, model.ErrNoResult) {
 			StatusUnprocessableEntity("Location doesn't exist").Render(w)
@@ -513,11 +518,13 @@ func FeatureGroupPOST(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		fg.Location = loc.ID
 	}
 
-	if err := featuregroup.Create(fg); err != nil {
+	if err := featuregroup.Create(r.Context(), fg); err != nil {
 		handleError(err, w)
 		return
 	}
 
+	audit.SetResource(r, fg.ID.Hex())
+
 	logger.Infof("Feature group %s created", fg.ID.Hex())
 
 	view.RenderJSON(fg, http.StatusCreated, w)
@@ -555,7 +562,7 @@ func FeatureGroupPUT(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 		return
 	}
 
-	loc, err := location.GetByID(lID)
+	loc, err := location.GetByID(r.Context(), lID)
 	if err != nil {
 		if errors.Is(err, model.ErrNoResult) {
 			StatusUnprocessableEntity("Location doesn't exist").Render(w)
@@ -569,7 +576,7 @@ func FeatureGroupPUT(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 		fg.Location = loc.ID
 	}
 
-	if err := featuregroup.Replace(fID, fg); err != nil {
+	if err := featuregroup.Replace(r.Context(), fID, lID, fg); err != nil {
 		handleError(err, w)
 		return
 	}
@@ -580,10 +587,10 @@ func FeatureGroupPUT(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 }
 
 // FeatureGroupDELETE handles a DELETE request on a feature group entity endpoint
-func FeatureGroupDELETE(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
-	id := ps.ByName("id")
+func FeatureGroupDELETE(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("gid")
 
-	if err := featuregroup.Remove(id); err != nil {
+	if err := featuregroup.Remove(r.Context(), id, ps.ByName("id")); err != nil {
 		handleError(err, w)
 		return
 	}
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -350,6 +351,55 @@ func TestFeaturePOST(t *testing.T) {
 	}
 }
 
+func TestFeaturePOSTInvalidGeometry(t *testing.T) {
+	locationID := locationIDs[0]
+
+	ring := func(coords ...[2]float64) feature.Coordinates {
+		r := make(feature.Coordinates, len(coords))
+		for i, c := range coords {
+			r[i] = []interface{}{c[0], c[1]}
+		}
+		return feature.Coordinates{[]interface{}(r)}
+	}
+
+	tests := []struct {
+		name     string
+		geometry feature.Geometry
+	}{
+		{"longitude out of range", feature.Geometry{Type: feature.Point, Coordinates: feature.Coordinates{181.0, 0.0}}},
+		{"latitude out of range", feature.Geometry{Type: feature.Point, Coordinates: feature.Coordinates{0.0, -91.0}}},
+		{"unclosed ring", feature.Geometry{Type: feature.Polygon, Coordinates: ring([2]float64{0, 0}, [2]float64{1, 0}, [2]float64{1, 1}, [2]float64{0, 1})}},
+		{"short ring", feature.Geometry{Type: feature.Polygon, Coordinates: ring([2]float64{0, 0}, [2]float64{1, 0}, [2]float64{0, 0})}},
+	}
+
+	for _, tt := range tests {
+		buf := new(bytes.Buffer)
+
+		input := &feature.Feature{
+			ID:       createFeatureID(),
+			Name:     "feature",
+			Group:    featureGroupIDs[0],
+			Geometry: tt.geometry,
+			Location: locationID,
+		}
+
+		if err := json.NewEncoder(buf).Encode(input); err != nil {
+			t.Fatalf("Creating feature failed: %s", err)
+		}
+
+		req := httptest.NewRequest("POST", fmt.Sprintf("http://example.com/v2/location/%s/feature", locationID), buf)
+		req.Header.Set("Content-Type", contentTypeJSON)
+
+		w := httptest.NewRecorder()
+
+		FeaturePOST(w, req, httprouter.Params{{Key: "id", Value: locationID.Hex()}})
+
+		if code := w.Result().StatusCode; code != http.StatusUnprocessableEntity {
+			t.Errorf("Creating feature with %s failed: unexpected response code %v", tt.name, code)
+		}
+	}
+}
+
 func TestFeaturePUT(t *testing.T) {
 	locationID := locationIDs[0]
 	featureID := featureIDs[0]
@@ -416,6 +466,10 @@ func TestFeatureDELETE(t *testing.T) {
 	params := httprouter.Params{
 		httprouter.Param{
 			Key:   "id",
+			Value: locationIDs[0].Hex(),
+		},
+		httprouter.Param{
+			Key:   "fid",
 			Value: featureID.Hex(),
 		},
 	}
@@ -434,6 +488,80 @@ func TestFeatureDELETE(t *testing.T) {
 	removeFeatureID(featureID)
 }
 
+// TestFeatureForeignLocation covers a token scoped to location A, which
+// passes the route of A, trying to change a feature of location B
+func TestFeatureForeignLocation(t *testing.T) {
+	ctx := context.Background()
+
+	locA := &location.Location{Name: "location c"}
+	if err := location.Create(ctx, locA); err != nil {
+		t.Fatalf("Creating location failed: %s", err)
+	}
+	defer location.Remove(ctx, locA.ID.Hex())
+
+	group := &featuregroup.Group{Name: "group c", Location: locA.ID}
+	if err := featuregroup.Create(ctx, group); err != nil {
+		t.Fatalf("Creating feature group failed: %s", err)
+	}
+	defer featuregroup.Remove(ctx, group.ID.Hex(), locA.ID.Hex())
+
+	locationB, featureID := locationIDs[0], featureIDs[0]
+
+	params := httprouter.Params{
+		httprouter.Param{
+			Key:   "id",
+			Value: locA.ID.Hex(),
+		},
+		httprouter.Param{
+			Key:   "fid",
+			Value: featureID.Hex(),
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	input := &feature.Feature{
+		ID:    featureID,
+		Name:  "Foreign feature",
+		Group: group.ID,
+		Geometry: feature.Geometry{
+			Type:        feature.Point,
+			Coordinates: createFeatureCoords(),
+		},
+	}
+
+	if err := json.NewEncoder(buf).Encode(input); err != nil {
+		t.Fatalf("Replacing feature failed: %s", err)
+	}
+
+	req := httptest.NewRequest("PUT", fmt.Sprintf("http://example.com/v2/location/%s/feature/%s", locA.ID, featureID), buf)
+	req.Header.Set("Content-Type", contentTypeJSON)
+
+	w := httptest.NewRecorder()
+
+	FeaturePUT(w, req, params)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Replacing feature of other location failed: unexpcted response code %v", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+
+	FeatureDELETE(w, &http.Request{}, params)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Deleting feature of other location failed: unexpcted response code %v", w.Code)
+	}
+
+	ft, err := feature.GetByID(ctx, featureID.Hex(), locationB.Hex())
+	if err != nil {
+		t.Fatalf("Getting feature of other location failed: %s", err)
+	}
+	if ft.Name == input.Name {
+		t.Error("Feature of other location was replaced")
+	}
+}
+
 type featureGroupResult struct {
 	Count int64                `json:"total"`
 	Items []featuregroup.Group `json:"items"`
@@ -635,6 +763,10 @@ func TestFeatureGroupDELETE(t *testing.T) {
 	params := httprouter.Params{
 		httprouter.Param{
 			Key:   "id",
+			Value: locationIDs[0].Hex(),
+		},
+		httprouter.Param{
+			Key:   "gid",
 			Value: featureGroupID.Hex(),
 		},
 	}
@@ -652,3 +784,68 @@ func TestFeatureGroupDELETE(t *testing.T) {
 
 	removeFeatureGroupID(featureGroupID)
 }
+
+// TestFeatureGroupForeignLocation covers a token scoped to location A, which
+// passes the route of A, trying to change a feature group of location B
+func TestFeatureGroupForeignLocation(t *testing.T) {
+	ctx := context.Background()
+
+	locA := &location.Location{Name: "location c"}
+	if err := location.Create(ctx, locA); err != nil {
+		t.Fatalf("Creating location failed: %s", err)
+	}
+	defer location.Remove(ctx, locA.ID.Hex())
+
+	locationB, featureGroupID := locationIDs[0], featureGroupIDs[0]
+
+	params := httprouter.Params{
+		httprouter.Param{
+			Key:   "id",
+			Value: locA.ID.Hex(),
+		},
+		httprouter.Param{
+			Key:   "gid",
+			Value: featureGroupID.Hex(),
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	input := &featuregroup.Group{
+		ID:          featureGroupID,
+		Name:        "Foreign group",
+		Description: "description",
+		Tags:        []string{"test"},
+	}
+
+	if err := json.NewEncoder(buf).Encode(input); err != nil {
+		t.Fatalf("Replacing feature group failed: %s", err)
+	}
+
+	req := httptest.NewRequest("PUT", fmt.Sprintf("http://example.com/v2/location/%s/featuregroup/%s", locA.ID, featureGroupID), buf)
+	req.Header.Set("Content-Type", contentTypeJSON)
+
+	w := httptest.NewRecorder()
+
+	FeatureGroupPUT(w, req, params)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Replacing feature group of other location failed: unexpcted response code %v", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+
+	FeatureGroupDELETE(w, &http.Request{}, params)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Deleting feature group of other location failed: unexpcted response code %v", w.Code)
+	}
+
+	fg, err := featuregroup.GetByID(ctx, featureGroupID.Hex(), locationB.Hex())
+	if err != nil {
+		t.Fatalf("Getting feature group of other location failed: %s", err)
+	}
+	if fg.Name == input.Name {
+		t.Error("Feature group of other location was replaced")
+	}
+}
@@ -0,0 +1,19 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/tarkov-database/rest-api/core/metrics"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// MetricsGET handles a GET request on the metrics endpoint
+func MetricsGET(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	if !metrics.Enabled() {
+		StatusNotFound("Metrics are disabled").Render(w)
+		return
+	}
+
+	metrics.Exporter().ServeHTTP(w, r)
+}
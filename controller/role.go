@@ -0,0 +1,204 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/audit"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model/role"
+	"github.com/tarkov-database/rest-api/model/user"
+	"github.com/tarkov-database/rest-api/view"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// RoleGET handles a GET request on a role entity endpoint
+func RoleGET(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	rl, err := role.GetByID(r.Context(), ps.ByName("id"))
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	view.RenderJSON(rl, http.StatusOK, w)
+}
+
+// RolesGET handles a GET request on the role root endpoint
+func RolesGET(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	result, err := role.GetAll(r.Context())
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	view.RenderJSON(result, http.StatusOK, w)
+}
+
+// RolePOST handles a POST request on the role root endpoint
+func RolePOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	if !isSupportedMediaType(r) {
+		StatusUnsupportedMediaType("Wrong content type").Render(w)
+		return
+	}
+
+	rl := &role.Role{}
+
+	if err := parseJSONBody(r.Body, rl); err != nil {
+		StatusBadRequest(fmt.Sprintf("JSON parsing error: %s", err)).Render(w)
+		return
+	}
+
+	if err := rl.Validate(); err != nil {
+		StatusUnprocessableEntity(fmt.Sprintf("Validation error: %s", err)).Render(w)
+		return
+	}
+
+	if !mayGrantScope(r, rl.Scope, w) {
+		return
+	}
+
+	if err := role.Create(r.Context(), rl); err != nil {
+		handleRoleError(err, w)
+		return
+	}
+
+	audit.SetResource(r, rl.ID)
+
+	logger.Infof("Role %s created", rl.ID)
+
+	view.RenderJSON(rl, http.StatusCreated, w)
+}
+
+// RolePUT handles a PUT request on a role entity endpoint
+func RolePUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	if !isSupportedMediaType(r) {
+		StatusUnsupportedMediaType("Wrong content type").Render(w)
+		return
+	}
+
+	rl := &role.Role{}
+
+	if err := parseJSONBody(r.Body, rl); err != nil {
+		StatusBadRequest(fmt.Sprintf("JSON parsing error: %s", err)).Render(w)
+		return
+	}
+
+	id := ps.ByName("id")
+
+	if rl.ID == "" {
+		rl.ID = id
+	} else if rl.ID != id {
+		StatusUnprocessableEntity("ID mismatch").Render(w)
+		return
+	}
+
+	if err := rl.Validate(); err != nil {
+		StatusUnprocessableEntity(fmt.Sprintf("Validation error: %s", err)).Render(w)
+		return
+	}
+
+	if !mayGrantScope(r, rl.Scope, w) {
+		return
+	}
+
+	if err := role.Replace(r.Context(), id, rl); err != nil {
+		handleRoleError(err, w)
+		return
+	}
+
+	logger.Infof("Role %s updated", rl.ID)
+
+	view.RenderJSON(rl, http.StatusOK, w)
+}
+
+// RoleDELETE handles a DELETE request on a role entity endpoint
+func RoleDELETE(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+
+	if err := role.Remove(r.Context(), id); err != nil {
+		handleRoleError(err, w)
+		return
+	}
+
+	logger.Infof("Role %s removed", id)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func handleRoleError(err error, w http.ResponseWriter) {
+	switch err {
+	case role.ErrBuiltin, role.ErrUnknownRole:
+		StatusUnprocessableEntity(err.Error()).Render(w)
+	default:
+		handleError(err, w)
+	}
+}
+
+// mayGrantScope checks if the client of the request holds every permission
+// of the given scope, so that it can pass them on to others
+func mayGrantScope(r *http.Request, scope []string, w http.ResponseWriter) bool {
+	if len(scope) == 0 {
+		return true
+	}
+
+	clm, ok := jwt.FromContext(r.Context())
+	if !ok {
+		StatusUnauthorized("Missing token claims").Render(w)
+		return false
+	}
+
+	for _, s := range scope {
+		if !clm.Covers(s) {
+			jwt.AddAuthenticateHeader(w, jwt.ErrInvalidScope, s)
+			StatusForbidden(fmt.Sprintf("Scope %s can't be granted", s)).Render(w)
+			return false
+		}
+	}
+
+	return true
+}
+
+// mayAssignRoles checks if the client of the request may assign the roles
+// which are added to the user
+func mayAssignRoles(r *http.Request, usr, prev *user.User, w http.ResponseWriter) bool {
+	assigned := make(map[string]bool)
+	if prev != nil {
+		for _, name := range prev.Roles {
+			assigned[name] = true
+		}
+	}
+
+	var added []string
+	for _, name := range usr.Roles {
+		if !assigned[name] {
+			added = append(added, name)
+		}
+	}
+
+	scope, err := role.Scope(r.Context(), added)
+	if err != nil {
+		handleRoleError(err, w)
+		return false
+	}
+
+	return mayGrantScope(r, scope, w)
+}
+
+// isScopeOfUser checks if the scope is covered by the roles of the user
+func isScopeOfUser(ctx context.Context, usr *user.User, scope []string, w http.ResponseWriter) bool {
+	allowed, err := role.Constrain(ctx, usr.Roles, scope)
+	if err != nil {
+		handleRoleError(err, w)
+		return false
+	}
+
+	if len(allowed) != len(scope) {
+		StatusForbidden("Scope exceeds the roles of the user").Render(w)
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model/role"
+	"github.com/tarkov-database/rest-api/model/token"
+	"github.com/tarkov-database/rest-api/model/user"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func requestWithClaims(t *testing.T, method string, body interface{}, scope ...string) *http.Request {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+
+	if body != nil {
+		if err := json.NewEncoder(buf).Encode(body); err != nil {
+			t.Fatalf("Encoding body failed: %s", err)
+		}
+	}
+
+	req := httptest.NewRequest(method, "http://example.com", buf)
+	req.Header.Set("Content-Type", contentTypeJSON)
+
+	return req.WithContext(jwt.NewContext(req.Context(), &jwt.Claims{Scope: scope}))
+}
+
+func TestRole(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	RolesGET(w, httptest.NewRequest("GET", "http://example.com", nil), httprouter.Params{})
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Getting roles failed: unexpcted response code %v", w.Code)
+	}
+
+	input := &role.Role{ID: "ammo-editor", Scope: []string{jwt.ScopeItemRead, "write:item:ammunition"}}
+
+	w = httptest.NewRecorder()
+	RolePOST(w, requestWithClaims(t, "POST", input, jwt.ScopeItemRead), httprouter.Params{})
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("Creating role beyond own scope failed: unexpcted response code %v", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	RolePOST(w, requestWithClaims(t, "POST", input, jwt.ScopeAllWrite, jwt.ScopeAllRead), httprouter.Params{})
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Creating role failed: unexpcted response code %v", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	RolePUT(w, requestWithClaims(t, "PUT", &role.Role{Scope: []string{jwt.ScopeItemRead}}, jwt.ScopeAllWrite),
+		httprouter.Params{{Key: "id", Value: role.Admin}})
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("Replacing built-in role failed: unexpcted response code %v", w.Code)
+	}
+
+	userID := userIDs[1]
+	params := httprouter.Params{{Key: "id", Value: userID.Hex()}}
+
+	usr := &user.User{Email: "editor@testing.dev", Roles: []string{role.Viewer, input.ID}}
+
+	w = httptest.NewRecorder()
+	UserPUT(w, requestWithClaims(t, "PUT", usr, jwt.ScopeUserWrite), params)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("Assigning role beyond own scope failed: unexpcted response code %v", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	UserPUT(w, requestWithClaims(t, "PUT", usr, jwt.ScopeUserWrite, jwt.ScopeItemWrite, jwt.ScopeItemRead), params)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Assigning role failed: unexpcted response code %v", w.Code)
+	}
+
+	issuer := &jwt.Claims{Scope: []string{jwt.ScopeTokenWrite}}
+	issuer.Subject = userIDs[0].Hex()
+
+	tkn, err := jwt.SignToken(issuer, nil)
+	if err != nil {
+		t.Fatalf("Creating token failed: %s", err)
+	}
+
+	tests := []struct {
+		scope []string
+		code  int
+	}{
+		{[]string{jwt.ScopeItemRead, "write:item:ammunition"}, http.StatusCreated},
+		{[]string{"write:item:firearm"}, http.StatusForbidden},
+		{[]string{jwt.ScopeItemWrite}, http.StatusForbidden},
+		{[]string{jwt.ScopeUserRead}, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		req := &token.Request{Subject: userID.Hex(), Scope: tt.scope}
+
+		resp := postToken(t, TokenPOST, tkn, req)
+		resp.Body.Close()
+
+		if resp.StatusCode != tt.code {
+			t.Errorf("Creating token with scope %v failed: unexpcted response code %v", tt.scope, resp.StatusCode)
+		}
+	}
+
+	w = httptest.NewRecorder()
+	RoleDELETE(w, httptest.NewRequest("DELETE", "http://example.com", nil), httprouter.Params{{Key: "id", Value: input.ID}})
+
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("Removing role failed: unexpcted response code %v", w.Code)
+	}
+
+	out, err := user.GetByID(context.Background(), userID.Hex())
+	if err != nil {
+		t.Fatalf("Getting user failed: %s", err)
+	}
+
+	if len(out.Roles) != 1 || out.Roles[0] != role.Viewer {
+		t.Errorf("Removing role failed: user still has roles %v", out.Roles)
+	}
+}
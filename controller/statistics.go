@@ -7,18 +7,19 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/audit"
 	"github.com/tarkov-database/rest-api/model"
 	"github.com/tarkov-database/rest-api/model/statistic/ammunition/armor"
 	"github.com/tarkov-database/rest-api/model/statistic/ammunition/distance"
 	"github.com/tarkov-database/rest-api/view"
 
-	"github.com/google/logger"
 	"github.com/julienschmidt/httprouter"
 )
 
 // DistanceStatGET handles a GET request on a ammunition statistics distance entity endpoint
-func DistanceStatGET(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
-	loc, err := distance.GetByID(ps.ByName("id"))
+func DistanceStatGET(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	loc, err := distance.GetByID(r.Context(), ps.ByName("id"))
 	if err != nil {
 		handleError(err, w)
 		return
@@ -74,7 +75,7 @@ func DistanceStatsGET(w http.ResponseWriter, r *http.Request, _ httprouter.Param
 		ids = v
 	}
 
-	result, err = distance.GetByRefsAndRange(ids, gte, lte, opts)
+	result, err = distance.GetByRefsAndRange(r.Context(), ids, gte, lte, opts)
 	if err != nil {
 		handleError(err, w)
 		return
@@ -102,7 +103,7 @@ func DistanceStatPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Param
 		return
 	}
 
-	result, err := distance.GetByRefsAndRange([]string{stat.Reference.Hex()},
+	result, err := distance.GetByRefsAndRange(r.Context(), []string{stat.Reference.Hex()},
 		&stat.Distance, &stat.Distance, &distance.Options{})
 	if err != nil {
 		handleError(err, w)
@@ -113,11 +114,13 @@ func DistanceStatPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Param
 		StatusBadRequest("entity already exists").Render(w)
 	}
 
-	if err := distance.Create(stat); err != nil {
+	if err := distance.Create(r.Context(), stat); err != nil {
 		handleError(err, w)
 		return
 	}
 
+	audit.SetResource(r, stat.ID.Hex())
+
 	logger.Infof("Distance statistics %s created", stat.ID.Hex())
 
 	view.RenderJSON(stat, http.StatusCreated, w)
@@ -149,7 +152,7 @@ func DistanceStatPUT(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 		return
 	}
 
-	if err := distance.Replace(id, stat); err != nil {
+	if err := distance.Replace(r.Context(), id, stat); err != nil {
 		handleError(err, w)
 		return
 	}
@@ -160,10 +163,10 @@ func DistanceStatPUT(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 }
 
 // DistanceStatDELETE handles a DELETE request on a distance entity endpoint
-func DistanceStatDELETE(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
+func DistanceStatDELETE(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	id := ps.ByName("id")
 
-	if err := distance.Remove(id); err != nil {
+	if err := distance.Remove(r.Context(), id); err != nil {
 		handleError(err, w)
 		return
 	}
@@ -174,8 +177,8 @@ func DistanceStatDELETE(w http.ResponseWriter, _ *http.Request, ps httprouter.Pa
 }
 
 // ArmorStatGET handles a GET request on a ammunition statistics armor entity endpoint
-func ArmorStatGET(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
-	loc, err := armor.GetByID(ps.ByName("id"))
+func ArmorStatGET(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	loc, err := armor.GetByID(r.Context(), ps.ByName("id"))
 	if err != nil {
 		handleError(err, w)
 		return
@@ -241,7 +244,7 @@ func ArmorStatsGET(w http.ResponseWriter, r *http.Request, _ httprouter.Params)
 		armorIDs = v
 	}
 
-	result, err = armor.GetByRefs(ammoIDs, armorIDs, rangeOpts, opts)
+	result, err = armor.GetByRefs(r.Context(), ammoIDs, armorIDs, rangeOpts, opts)
 	if err != nil {
 		handleError(err, w)
 		return
@@ -274,7 +277,7 @@ func ArmorStatPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params)
 		LTE: &stat.Distance,
 	}
 
-	result, err := armor.GetByRefs([]string{stat.Ammo.Hex()}, []string{stat.Armor.ID.Hex()},
+	result, err := armor.GetByRefs(r.Context(), []string{stat.Ammo.Hex()}, []string{stat.Armor.ID.Hex()},
 		rangeOpts, &armor.Options{})
 	if err != nil {
 		handleError(err, w)
@@ -285,11 +288,13 @@ func ArmorStatPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params)
 		StatusBadRequest("entity already exists").Render(w)
 	}
 
-	if err := armor.Create(stat); err != nil {
+	if err := armor.Create(r.Context(), stat); err != nil {
 		handleError(err, w)
 		return
 	}
 
+	audit.SetResource(r, stat.ID.Hex())
+
 	logger.Infof("Armor statistics %s created", stat.ID.Hex())
 
 	view.RenderJSON(stat, http.StatusCreated, w)
@@ -321,7 +326,7 @@ func ArmorStatPUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 		return
 	}
 
-	if err := armor.Replace(id, stat); err != nil {
+	if err := armor.Replace(r.Context(), id, stat); err != nil {
 		handleError(err, w)
 		return
 	}
@@ -332,10 +337,10 @@ func ArmorStatPUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 }
 
 // ArmorStatDELETE handles a DELETE request on a armor entity endpoint
-func ArmorStatDELETE(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
+func ArmorStatDELETE(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	id := ps.ByName("id")
 
-	if err := armor.Remove(id); err != nil {
+	if err := armor.Remove(r.Context(), id); err != nil {
 		handleError(err, w)
 		return
 	}
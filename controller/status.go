@@ -35,6 +35,14 @@ func StatusCreated(msg string) *Status {
 	}
 }
 
+// StatusAccepted fills Status with an HTTP 202 status and message
+func StatusAccepted(msg string) *Status {
+	return &Status{
+		Code:    http.StatusAccepted,
+		Message: msg,
+	}
+}
+
 // StatusBadRequest fills Status with an HTTP 400 status and message
 func StatusBadRequest(msg string) *Status {
 	return &Status{
@@ -67,6 +75,14 @@ func StatusNotFound(msg string) *Status {
 	}
 }
 
+// StatusConflict fills Status with an HTTP 409 status and message
+func StatusConflict(msg string) *Status {
+	return &Status{
+		Code:    http.StatusConflict,
+		Message: msg,
+	}
+}
+
 // StatusUnsupportedMediaType fills Status with an HTTP 415 status and message
 func StatusUnsupportedMediaType(msg string) *Status {
 	return &Status{
@@ -91,6 +107,14 @@ func StatusInternalServerError(msg string) *Status {
 	}
 }
 
+// StatusServiceUnavailable fills Status with an HTTP 503 status and message
+func StatusServiceUnavailable(msg string) *Status {
+	return &Status{
+		Code:    http.StatusServiceUnavailable,
+		Message: msg,
+	}
+}
+
 // StatusNotFoundHandler returns a HTTP 404 handler
 func StatusNotFoundHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
@@ -1,11 +1,17 @@
 package controller
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/audit"
 	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/model/role"
 	"github.com/tarkov-database/rest-api/model/token"
 	"github.com/tarkov-database/rest-api/model/user"
 	"github.com/tarkov-database/rest-api/view"
@@ -22,14 +28,16 @@ func TokenGET(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 		return
 	}
 
-	clm, err := jwt.VerifyToken(t)
-	if err != nil && !errors.Is(err, jwt.ErrExpiredToken) {
+	clm, err := jwt.Authenticate(r.Context(), t)
+	if err != nil {
 		jwt.AddAuthenticateHeader(w, err)
 		StatusUnauthorized(err.Error()).Render(w)
 		return
 	}
 
-	usr, err := user.GetByID(clm.Subject)
+	audit.SetActor(r, clm)
+
+	usr, err := user.GetByID(r.Context(), clm.Subject)
 	if err != nil {
 		handleError(err, w)
 		return
@@ -40,12 +48,24 @@ func TokenGET(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 		return
 	}
 
+	// The renewed token loses scopes no longer covered by the roles of the user
+	clm.Scope, err = role.Constrain(r.Context(), usr.Roles, clm.Scope)
+	if err != nil {
+		handleRoleError(err, w)
+		return
+	}
+
+	// The renewed token gets its own ID and audience
+	clm.ID, clm.Audience = "", nil
+
 	t, err = jwt.SignToken(clm, nil)
 	if err != nil {
 		StatusUnprocessableEntity(fmt.Sprintf("Creation error: %s", err)).Render(w)
 		return
 	}
 
+	audit.SetResource(r, clm.ID)
+
 	view.RenderJSON(token.Response{Token: t, Expires: clm.ExpiresAt.Unix()}, http.StatusCreated, w)
 }
 
@@ -63,22 +83,16 @@ func TokenPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 		return
 	}
 
-	issClaims, err := jwt.VerifyToken(issToken)
+	issClaims, err := jwt.Authenticate(r.Context(), issToken)
 	if err != nil {
 		jwt.AddAuthenticateHeader(w, err, jwt.ScopeTokenWrite, jwt.ScopeAllWrite)
 		StatusUnauthorized(err.Error()).Render(w)
 		return
 	}
 
-	var ok bool
-	for _, s := range issClaims.Scope {
-		if s == jwt.ScopeTokenWrite || s == jwt.ScopeAllWrite {
-			ok = true
-			break
-		}
-	}
+	audit.SetActor(r, issClaims)
 
-	if !ok {
+	if !issClaims.HasScope(jwt.ScopeTokenWrite) {
 		jwt.AddAuthenticateHeader(w, jwt.ErrInvalidScope, jwt.ScopeTokenWrite, jwt.ScopeAllWrite)
 		StatusForbidden("Insufficient permissions").Render(w)
 		return
@@ -104,7 +118,7 @@ func TokenPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 		return
 	}
 
-	usr, err := user.GetByID(clm.Subject)
+	usr, err := user.GetByID(r.Context(), clm.Subject)
 	if err != nil {
 		handleError(err, w)
 		return
@@ -115,13 +129,219 @@ func TokenPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 		return
 	}
 
+	if !isScopeOfUser(r.Context(), usr, clm.Scope, w) {
+		return
+	}
+
 	clm.Issuer = issClaims.Issuer
 
-	t, err := jwt.SignToken(clm, lt)
+	res, err := issueToken(r.Context(), clm, lt, rb.Refresh, model.ObjectID{})
 	if err != nil {
 		StatusInternalServerError(fmt.Sprintf("Creation error: %s", err)).Render(w)
 		return
 	}
 
-	view.RenderJSON(token.Response{Token: t, Expires: clm.ExpiresAt.Unix()}, http.StatusCreated, w)
+	audit.SetResource(r, clm.ID)
+
+	view.RenderJSON(res, http.StatusCreated, w)
+}
+
+// TokenRefreshPOST handles a POST request on the token refresh endpoint
+func TokenRefreshPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	if !isSupportedMediaType(r) {
+		StatusUnsupportedMediaType("Wrong content type").Render(w)
+		return
+	}
+
+	rb := &token.RefreshRequest{}
+
+	if err := parseJSONBody(r.Body, rb); err != nil {
+		StatusBadRequest(fmt.Sprintf("JSON parsing error: %s", err)).Render(w)
+		return
+	}
+
+	if rb.RefreshToken == "" {
+		StatusBadRequest("Refresh token is missing").Render(w)
+		return
+	}
+
+	rt, err := token.UseRefreshToken(r.Context(), rb.RefreshToken)
+	if err != nil {
+		switch err {
+		case token.ErrReusedRefreshToken:
+			logger.Warningf("Reuse of refresh token detected, token family revoked")
+			StatusUnauthorized(err.Error()).Render(w)
+		case token.ErrInvalidRefreshToken:
+			StatusUnauthorized(err.Error()).Render(w)
+		default:
+			handleError(err, w)
+		}
+		return
+	}
+
+	usr, err := user.GetByID(r.Context(), rt.Subject)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	if usr.Locked {
+		StatusForbidden("User is locked").Render(w)
+		return
+	}
+
+	clm := rt.Claims()
+
+	clm.Scope, err = role.Constrain(r.Context(), usr.Roles, clm.Scope)
+	if err != nil {
+		handleRoleError(err, w)
+		return
+	}
+
+	res, err := issueToken(r.Context(), clm, nil, true, rt.Family)
+	if err != nil {
+		StatusInternalServerError(fmt.Sprintf("Creation error: %s", err)).Render(w)
+		return
+	}
+
+	audit.SetActor(r, clm)
+	audit.SetResource(r, clm.ID)
+
+	view.RenderJSON(res, http.StatusCreated, w)
+}
+
+// TokenRevokePOST handles a POST request on the token revocation endpoint
+func TokenRevokePOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	if !isSupportedMediaType(r) {
+		StatusUnsupportedMediaType("Wrong content type").Render(w)
+		return
+	}
+
+	t, err := jwt.ExtractToken(r)
+	if err != nil {
+		jwt.AddAuthenticateHeader(w, err)
+		StatusUnauthorized(err.Error()).Render(w)
+		return
+	}
+
+	clm, err := jwt.Authenticate(r.Context(), t)
+	if err != nil {
+		jwt.AddAuthenticateHeader(w, err)
+		StatusUnauthorized(err.Error()).Render(w)
+		return
+	}
+
+	audit.SetActor(r, clm)
+
+	rb := &token.RevokeRequest{}
+
+	if err := parseJSONBody(r.Body, rb); err != nil {
+		StatusBadRequest(fmt.Sprintf("JSON parsing error: %s", err)).Render(w)
+		return
+	}
+
+	if rb.Token == "" && rb.RefreshToken == "" {
+		StatusBadRequest("Token is missing").Render(w)
+		return
+	}
+
+	// Tokens of other subjects can only be revoked with the token write scope
+	mayRevoke := func(subject string) bool {
+		return subject == clm.Subject || clm.HasScope(jwt.ScopeTokenWrite)
+	}
+
+	if rb.Token != "" {
+		target, err := jwt.VerifyToken(rb.Token)
+		switch {
+		case errors.Is(err, jwt.ErrExpiredToken):
+			// An expired token does not need to be revoked
+		case err != nil:
+			StatusUnprocessableEntity(fmt.Sprintf("Token error: %s", err)).Render(w)
+			return
+		case !mayRevoke(target.Subject):
+			StatusForbidden("Insufficient permissions").Render(w)
+			return
+		default:
+			if err := token.Revoke(r.Context(), target); err != nil {
+				handleError(err, w)
+				return
+			}
+
+			audit.SetResource(r, target.ID)
+
+			logger.Infof("Token %s of %s revoked", target.ID, target.Subject)
+		}
+	}
+
+	if rb.RefreshToken != "" {
+		rt, err := token.GetRefreshToken(r.Context(), rb.RefreshToken)
+		switch {
+		case err == model.ErrNoResult:
+			// An unknown refresh token does not need to be revoked
+		case err != nil:
+			handleError(err, w)
+			return
+		case !mayRevoke(rt.Subject):
+			StatusForbidden("Insufficient permissions").Render(w)
+			return
+		default:
+			if err := token.RemoveRefreshTokenFamily(r.Context(), rt.Family); err != nil {
+				handleError(err, w)
+				return
+			}
+
+			audit.SetResource(r, rt.Family.Hex())
+
+			logger.Infof("Refresh token family %s of %s revoked", rt.Family.Hex(), rt.Subject)
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UserTokenDELETE handles a DELETE request on the token endpoint of a user
+func UserTokenDELETE(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+
+	if _, err := user.GetByID(r.Context(), id); err != nil {
+		handleError(err, w)
+		return
+	}
+
+	if err := token.RevokeAll(r.Context(), id); err != nil {
+		handleError(err, w)
+		return
+	}
+
+	logger.Infof("All tokens of user %s revoked", id)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// JWKSGET handles a GET request on the JSON Web Key Set endpoint
+func JWKSGET(w http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
+	w.Header().Set("Cache-Control", "public, max-age=300")
+
+	view.RenderJSON(jwt.KeySet(), http.StatusOK, w)
+}
+
+// issueToken signs a token for the claims and optionally creates a refresh token
+func issueToken(ctx context.Context, clm *jwt.Claims, lt *time.Duration, refresh bool, family model.ObjectID) (*token.Response, error) {
+	t, err := jwt.SignToken(clm, lt)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &token.Response{Token: t, Expires: clm.ExpiresAt.Unix()}
+
+	if refresh {
+		rs, rt, err := token.NewRefreshToken(ctx, clm, family)
+		if err != nil {
+			return nil, err
+		}
+
+		res.RefreshToken, res.RefreshExpires = rs, rt.Expires.Unix()
+	}
+
+	return res, nil
 }
@@ -2,7 +2,9 @@ package controller
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -128,3 +130,204 @@ func TestTokenPOST(t *testing.T) {
 		t.Error("Getting token failed: lifetime mismatch")
 	}
 }
+
+func TestTokenGETExpired(t *testing.T) {
+	clm := &jwt.Claims{}
+	clm.Subject = userIDs[0].Hex()
+
+	lt := -time.Hour
+
+	tkn, err := jwt.SignToken(clm, &lt)
+	if err != nil {
+		t.Fatalf("Getting token failed: %s", err)
+	}
+
+	header := http.Header{}
+	header.Add("Authorization", fmt.Sprintf("Bearer %s", tkn))
+
+	w := httptest.NewRecorder()
+
+	TokenGET(w, &http.Request{Header: header}, httprouter.Params{})
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("Getting token failed: unexpcted response code %v", resp.StatusCode)
+	}
+}
+
+func postToken(t *testing.T, handle httprouter.Handle, tkn string, body interface{}) *http.Response {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+
+	if err := json.NewEncoder(buf).Encode(body); err != nil {
+		t.Fatalf("Encoding body failed: %s", err)
+	}
+
+	req := httptest.NewRequest("POST", "http://example.com/v2/token", buf)
+	req.Header.Set("Content-Type", contentTypeJSON)
+	if tkn != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tkn))
+	}
+
+	w := httptest.NewRecorder()
+
+	handle(w, req, httprouter.Params{})
+
+	return w.Result()
+}
+
+func TestTokenRefreshPOST(t *testing.T) {
+	clm := &jwt.Claims{Scope: []string{jwt.ScopeTokenWrite}}
+	clm.Subject = userIDs[0].Hex()
+
+	tkn, err := jwt.SignToken(clm, nil)
+	if err != nil {
+		t.Fatalf("Creating token failed: %s", err)
+	}
+
+	input := &token.Request{Scope: []string{jwt.ScopeAllRead}, Refresh: true}
+	input.Subject = userIDs[0].Hex()
+
+	resp := postToken(t, TokenPOST, tkn, input)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Creating token failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	created := &token.Response{}
+	if err := json.NewDecoder(resp.Body).Decode(created); err != nil {
+		t.Fatalf("Creating token failed: %s", err)
+	}
+
+	if created.RefreshToken == "" {
+		t.Fatal("Creating token failed: refresh token is missing")
+	}
+
+	resp = postToken(t, TokenRefreshPOST, "", &token.RefreshRequest{RefreshToken: created.RefreshToken})
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Refreshing token failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	refreshed := &token.Response{}
+	if err := json.NewDecoder(resp.Body).Decode(refreshed); err != nil {
+		t.Fatalf("Refreshing token failed: %s", err)
+	}
+
+	if refreshed.RefreshToken == "" || refreshed.RefreshToken == created.RefreshToken {
+		t.Error("Refreshing token failed: refresh token was not rotated")
+	}
+
+	clmOut, err := jwt.VerifyToken(refreshed.Token)
+	if err != nil {
+		t.Fatalf("Refreshing token failed: token invalid: %s", err)
+	}
+
+	if clmOut.Subject != input.Subject || len(clmOut.Scope) != 1 || clmOut.Scope[0] != jwt.ScopeAllRead {
+		t.Error("Refreshing token failed: claims mismatch")
+	}
+
+	// Reusing a refresh token revokes the whole family
+	resp = postToken(t, TokenRefreshPOST, "", &token.RefreshRequest{RefreshToken: created.RefreshToken})
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("Reusing refresh token failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	resp = postToken(t, TokenRefreshPOST, "", &token.RefreshRequest{RefreshToken: refreshed.RefreshToken})
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("Refreshing revoked family failed: unexpcted response code %v", resp.StatusCode)
+	}
+}
+
+func TestTokenRevokePOST(t *testing.T) {
+	clm := &jwt.Claims{}
+	clm.Subject = userIDs[0].Hex()
+
+	tkn, err := jwt.SignToken(clm, nil)
+	if err != nil {
+		t.Fatalf("Creating token failed: %s", err)
+	}
+
+	other := &jwt.Claims{}
+	other.Subject = userIDs[1].Hex()
+
+	otherTkn, err := jwt.SignToken(other, nil)
+	if err != nil {
+		t.Fatalf("Creating token failed: %s", err)
+	}
+
+	resp := postToken(t, TokenRevokePOST, tkn, &token.RevokeRequest{Token: otherTkn})
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("Revoking token of other user failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	resp = postToken(t, TokenRevokePOST, tkn, &token.RevokeRequest{Token: tkn})
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("Revoking token failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	if _, err := jwt.Authenticate(context.Background(), tkn); !errors.Is(err, jwt.ErrRevokedToken) {
+		t.Errorf("Revoking token failed: token is still accepted: %v", err)
+	}
+
+	if _, err := jwt.Authenticate(context.Background(), otherTkn); err != nil {
+		t.Errorf("Revoking token failed: other token is not accepted: %s", err)
+	}
+}
+
+func TestUserTokenDELETE(t *testing.T) {
+	userID := userIDs[1]
+
+	clm := &jwt.Claims{}
+	clm.Subject = userID.Hex()
+
+	tkn, err := jwt.SignToken(clm, nil)
+	if err != nil {
+		t.Fatalf("Creating token failed: %s", err)
+	}
+
+	w := httptest.NewRecorder()
+
+	UserTokenDELETE(w, httptest.NewRequest("DELETE", "http://example.com", nil), httprouter.Params{
+		httprouter.Param{Key: "id", Value: userID.Hex()},
+	})
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("Revoking tokens failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	if _, err := jwt.Authenticate(context.Background(), tkn); !errors.Is(err, jwt.ErrRevokedToken) {
+		t.Errorf("Revoking tokens failed: token is still accepted: %v", err)
+	}
+
+	// A token issued after the second of the revocation is accepted
+	time.Sleep(time.Until(time.Now().Truncate(time.Second).Add(time.Second)))
+
+	clm = &jwt.Claims{}
+	clm.Subject = userID.Hex()
+
+	fresh, err := jwt.SignToken(clm, nil)
+	if err != nil {
+		t.Fatalf("Creating token failed: %s", err)
+	}
+
+	if _, err := jwt.Authenticate(context.Background(), fresh); err != nil {
+		t.Errorf("Revoking tokens failed: new token is not accepted: %s", err)
+	}
+}
@@ -7,19 +7,23 @@ import (
 	"net/url"
 	"strconv"
 
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/audit"
 	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/model/apikey"
+	"github.com/tarkov-database/rest-api/model/clientcert"
+	"github.com/tarkov-database/rest-api/model/token"
 	"github.com/tarkov-database/rest-api/model/user"
 	"github.com/tarkov-database/rest-api/view"
 
-	"github.com/google/logger"
 	"github.com/julienschmidt/httprouter"
 )
 
 var errInvalidUserID = errors.New("invalid user id")
 
 // UserGET handles a GET request on a user entity endpoint
-func UserGET(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
-	usr, err := user.GetByID(ps.ByName("id"))
+func UserGET(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	usr, err := user.GetByID(r.Context(), ps.ByName("id"))
 	if err != nil {
 		handleError(err, w)
 		return
@@ -52,7 +56,7 @@ Loop:
 				return
 			}
 
-			result, err = user.GetByLockedState(locked, opts)
+			result, err = user.GetByLockedState(r.Context(), locked, opts)
 			if err != nil {
 				handleError(err, w)
 				return
@@ -76,7 +80,26 @@ Loop:
 				return
 			}
 
-			result, err = user.GetByEmail(addr, opts)
+			result, err = user.GetByEmail(r.Context(), addr, opts)
+			if err != nil {
+				handleError(err, w)
+				return
+			}
+
+			break Loop
+		case "role":
+			name, err := url.QueryUnescape(v[0])
+			if err != nil {
+				StatusBadRequest(fmt.Sprintf("Query string error: %s", err)).Render(w)
+				return
+			}
+
+			if l := len(name); l < 2 || l > 32 {
+				StatusBadRequest("Query string has an invalid length").Render(w)
+				return
+			}
+
+			result, err = user.GetByRole(r.Context(), name, opts)
 			if err != nil {
 				handleError(err, w)
 				return
@@ -87,7 +110,7 @@ Loop:
 	}
 
 	if result == nil {
-		result, err = user.GetAll(opts)
+		result, err = user.GetAll(r.Context(), opts)
 		if err != nil {
 			handleError(err, w)
 			return
@@ -116,11 +139,23 @@ func UserPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 		return
 	}
 
-	if err := user.Create(usr); err != nil {
+	if !mayAssignRoles(r, usr, nil, w) {
+		return
+	}
+
+	if err := user.Create(r.Context(), usr); err != nil {
 		handleError(err, w)
 		return
 	}
 
+	if !usr.Verified {
+		if err := sendLink(r.Context(), usr, token.PurposeVerify); err != nil {
+			logger.Errorf("Error while sending verification link to user %s: %s", usr.ID.Hex(), err)
+		}
+	}
+
+	audit.SetResource(r, usr.ID.Hex())
+
 	logger.Infof("User %s created", usr.ID.Hex())
 
 	view.RenderJSON(usr, http.StatusCreated, w)
@@ -152,21 +187,66 @@ func UserPUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
-	if err := user.Replace(id, usr); err != nil {
+	prev, err := user.GetByID(r.Context(), id)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	if !mayAssignRoles(r, usr, prev, w) {
+		return
+	}
+
+	// The password is kept, and a changed e-mail address has to be verified again
+	usr.Password = prev.Password
+	if usr.Email != prev.Email {
+		usr.Verified = false
+	}
+
+	if err := user.Replace(r.Context(), id, usr); err != nil {
 		handleError(err, w)
 		return
 	}
 
+	if usr.Email != prev.Email {
+		if err := sendLink(r.Context(), usr, token.PurposeVerify); err != nil {
+			logger.Errorf("Error while sending verification link to user %s: %s", usr.ID.Hex(), err)
+		}
+	}
+
+	// Tokens of a locked user must not be accepted any longer
+	if usr.Locked {
+		if err := token.RevokeAll(r.Context(), id); err != nil {
+			handleError(err, w)
+			return
+		}
+	}
+
 	logger.Infof("User %s updated", usr.ID.Hex())
 
 	view.RenderJSON(usr, http.StatusOK, w)
 }
 
 // UserDELETE handles a DELETE request on a user entity endpoint
-func UserDELETE(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
+func UserDELETE(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	id := ps.ByName("id")
 
-	if err := user.Remove(id); err != nil {
+	if err := user.Remove(r.Context(), id); err != nil {
+		handleError(err, w)
+		return
+	}
+
+	if err := token.RevokeAll(r.Context(), id); err != nil {
+		handleError(err, w)
+		return
+	}
+
+	if err := apikey.RemoveByUser(r.Context(), id); err != nil {
+		handleError(err, w)
+		return
+	}
+
+	if err := clientcert.RemoveByUser(r.Context(), id); err != nil {
 		handleError(err, w)
 		return
 	}
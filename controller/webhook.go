@@ -0,0 +1,168 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/tarkov-database/rest-api/core/logger"
+	delivery "github.com/tarkov-database/rest-api/core/webhook"
+	"github.com/tarkov-database/rest-api/middleware/audit"
+	"github.com/tarkov-database/rest-api/model/webhook"
+	"github.com/tarkov-database/rest-api/view"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// WebhookGET handles a GET request on a webhook entity endpoint
+func WebhookGET(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	hook, err := webhook.GetByID(r.Context(), ps.ByName("id"))
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	hook.Secret = ""
+
+	view.RenderJSON(hook, http.StatusOK, w)
+}
+
+// WebhooksGET handles a GET request on the webhook root endpoint
+func WebhooksGET(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	opts := &webhook.Options{Sort: getSort("-_modified", r)}
+	opts.Limit, opts.Offset = getLimitOffset(r)
+
+	result, err := webhook.GetAll(r.Context(), opts)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	for _, v := range result.Items {
+		v.(*webhook.Webhook).Secret = ""
+	}
+
+	view.RenderJSON(result, http.StatusOK, w)
+}
+
+// WebhookDeliveriesGET handles a GET request on the delivery endpoint of a webhook
+func WebhookDeliveriesGET(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	opts := &webhook.Options{Sort: getSort("-_modified", r)}
+	opts.Limit, opts.Offset = getLimitOffset(r)
+
+	result, err := webhook.GetDeliveries(r.Context(), ps.ByName("id"), opts)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	view.RenderJSON(result, http.StatusOK, w)
+}
+
+// WebhookPOST handles a POST request on the webhook root endpoint
+func WebhookPOST(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	if !isSupportedMediaType(r) {
+		StatusUnsupportedMediaType("Wrong content type").Render(w)
+		return
+	}
+
+	hook := &webhook.Webhook{}
+
+	if err := parseJSONBody(r.Body, hook); err != nil {
+		StatusBadRequest(fmt.Sprintf("JSON parsing error: %s", err)).Render(w)
+		return
+	}
+
+	if err := hook.Validate(); err != nil {
+		StatusUnprocessableEntity(fmt.Sprintf("Validation error: %s", err)).Render(w)
+		return
+	}
+
+	if err := delivery.CheckURL(hook.URL); err != nil {
+		StatusUnprocessableEntity(fmt.Sprintf("Validation error: %s", err)).Render(w)
+		return
+	}
+
+	if hook.Secret == "" {
+		if err := hook.GenerateSecret(); err != nil {
+			StatusInternalServerError(fmt.Sprintf("Secret generation error: %s", err)).Render(w)
+			return
+		}
+	}
+
+	if err := webhook.Create(r.Context(), hook); err != nil {
+		handleError(err, w)
+		return
+	}
+
+	audit.SetResource(r, hook.ID.Hex())
+
+	logger.Infof("Webhook %s created", hook.ID.Hex())
+
+	view.RenderJSON(hook, http.StatusCreated, w)
+}
+
+// WebhookPUT handles a PUT request on a webhook entity endpoint
+func WebhookPUT(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	if !isSupportedMediaType(r) {
+		StatusUnsupportedMediaType("Wrong content type").Render(w)
+		return
+	}
+
+	hook := &webhook.Webhook{}
+
+	if err := parseJSONBody(r.Body, hook); err != nil {
+		StatusBadRequest(fmt.Sprintf("JSON parsing error: %s", err)).Render(w)
+		return
+	}
+
+	if err := hook.Validate(); err != nil {
+		StatusUnprocessableEntity(fmt.Sprintf("Validation error: %s", err)).Render(w)
+		return
+	}
+
+	if err := delivery.CheckURL(hook.URL); err != nil {
+		StatusUnprocessableEntity(fmt.Sprintf("Validation error: %s", err)).Render(w)
+		return
+	}
+
+	id := ps.ByName("id")
+
+	if !hook.ID.IsZero() && hook.ID.Hex() != id {
+		StatusUnprocessableEntity("ID mismatch").Render(w)
+		return
+	}
+
+	if hook.Secret == "" {
+		current, err := webhook.GetByID(r.Context(), id)
+		if err != nil {
+			handleError(err, w)
+			return
+		}
+		hook.Secret = current.Secret
+	}
+
+	if err := webhook.Replace(r.Context(), id, hook); err != nil {
+		handleError(err, w)
+		return
+	}
+
+	logger.Infof("Webhook %s updated", hook.ID.Hex())
+
+	hook.Secret = ""
+
+	view.RenderJSON(hook, http.StatusOK, w)
+}
+
+// WebhookDELETE handles a DELETE request on a webhook entity endpoint
+func WebhookDELETE(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+
+	if err := webhook.Remove(r.Context(), id); err != nil {
+		handleError(err, w)
+		return
+	}
+
+	logger.Infof("Webhook %s removed", id)
+
+	w.WriteHeader(http.StatusNoContent)
+}
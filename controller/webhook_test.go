@@ -0,0 +1,235 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tarkov-database/rest-api/core/event"
+	"github.com/tarkov-database/rest-api/model/webhook"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+type webhookResult struct {
+	Count int64             `json:"total"`
+	Items []webhook.Webhook `json:"items"`
+}
+
+func TestWebhookGET(t *testing.T) {
+	hookID := webhookIDs[0]
+
+	params := httprouter.Params{
+		httprouter.Param{
+			Key:   "id",
+			Value: hookID.Hex(),
+		},
+	}
+
+	w := httptest.NewRecorder()
+
+	WebhookGET(w, &http.Request{}, params)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Getting webhook failed: unexpcted response code %v", resp.StatusCode)
+	}
+	if resp.Header.Get("Content-Type") != contentTypeJSON {
+		t.Error("Getting webhook failed: content type is invalid")
+	}
+
+	output := &webhook.Webhook{}
+
+	if err := json.NewDecoder(resp.Body).Decode(output); err != nil {
+		t.Fatalf("Getting webhook failed: %s", err)
+	}
+
+	if output.ID != hookID {
+		t.Error("Getting webhook failed: webhook ID invalid")
+	}
+	if output.Secret != "" {
+		t.Error("Getting webhook failed: secret exposed")
+	}
+}
+
+func TestWebhooksGET(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/v2/webhook", nil)
+
+	w := httptest.NewRecorder()
+
+	WebhooksGET(w, req, httprouter.Params{})
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Getting webhooks failed: unexpcted response code %v", resp.StatusCode)
+	}
+	if resp.Header.Get("Content-Type") != contentTypeJSON {
+		t.Error("Getting webhooks failed: content type is invalid")
+	}
+
+	res := &webhookResult{}
+
+	if err := json.NewDecoder(resp.Body).Decode(res); err != nil {
+		t.Fatalf("Getting webhooks failed: %s", err)
+	}
+
+	if res.Count < 2 {
+		t.Error("Getting webhooks failed: result count invalid")
+	}
+}
+
+func TestWebhookPOST(t *testing.T) {
+	hookID := createWebhookID()
+
+	buf := new(bytes.Buffer)
+
+	input := &webhook.Webhook{
+		ID:        hookID,
+		URL:       "https://example.com/hook",
+		Resources: []event.Resource{event.ResourceItem},
+	}
+
+	if err := json.NewEncoder(buf).Encode(input); err != nil {
+		t.Fatalf("Creating webhook failed: %s", err)
+	}
+
+	req := httptest.NewRequest("POST", "http://example.com/v2/webhook", buf)
+	req.Header.Set("Content-Type", contentTypeJSON)
+
+	w := httptest.NewRecorder()
+
+	WebhookPOST(w, req, httprouter.Params{})
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Creating webhook failed: unexpcted response code %v", resp.StatusCode)
+	}
+	if resp.Header.Get("Content-Type") != contentTypeJSON {
+		t.Error("Creating webhook failed: content type is invalid")
+	}
+
+	output := &webhook.Webhook{}
+
+	if err := json.NewDecoder(resp.Body).Decode(output); err != nil {
+		t.Fatalf("Creating webhook failed: %s", err)
+	}
+
+	if output.ID != input.ID {
+		t.Errorf("Creating webhook failed: webhook ID %s and %s unequal", output.ID, input.ID)
+	}
+	if output.Secret == "" {
+		t.Error("Creating webhook failed: secret not generated")
+	}
+}
+
+func TestWebhookPOSTPrivateAddress(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	input := &webhook.Webhook{
+		URL:       "http://169.254.169.254/latest/meta-data",
+		Resources: []event.Resource{event.ResourceItem},
+	}
+
+	if err := json.NewEncoder(buf).Encode(input); err != nil {
+		t.Fatalf("Creating webhook failed: %s", err)
+	}
+
+	req := httptest.NewRequest("POST", "http://example.com/v2/webhook", buf)
+	req.Header.Set("Content-Type", contentTypeJSON)
+
+	w := httptest.NewRecorder()
+
+	WebhookPOST(w, req, httprouter.Params{})
+
+	if code := w.Result().StatusCode; code != http.StatusUnprocessableEntity {
+		t.Errorf("Creating webhook failed: unexpected response code %v", code)
+	}
+}
+
+func TestWebhookPUT(t *testing.T) {
+	hookID := webhookIDs[0]
+
+	buf := new(bytes.Buffer)
+
+	input := &webhook.Webhook{URL: "https://example.com/hook/c"}
+
+	if err := json.NewEncoder(buf).Encode(input); err != nil {
+		t.Fatalf("Replacing webhook failed: %s", err)
+	}
+
+	req := httptest.NewRequest("PUT", "http://example.com/v2/webhook", buf)
+	req.Header.Set("Content-Type", contentTypeJSON)
+
+	params := httprouter.Params{
+		httprouter.Param{
+			Key:   "id",
+			Value: hookID.Hex(),
+		},
+	}
+
+	w := httptest.NewRecorder()
+
+	WebhookPUT(w, req, params)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Replacing webhook failed: unexpcted response code %v", resp.StatusCode)
+	}
+	if resp.Header.Get("Content-Type") != contentTypeJSON {
+		t.Error("Replacing webhook failed: content type is invalid")
+	}
+
+	output := &webhook.Webhook{}
+
+	if err := json.NewDecoder(resp.Body).Decode(output); err != nil {
+		t.Fatalf("Replacing webhook failed: %s", err)
+	}
+
+	if output.URL != input.URL {
+		t.Errorf("Replacing webhook failed: webhook URL %s and %s unequal", output.URL, input.URL)
+	}
+
+	hook, err := webhook.GetByID(context.Background(), hookID.Hex())
+	if err != nil {
+		t.Fatalf("Replacing webhook failed: %s", err)
+	}
+
+	if hook.Secret != "secret a" {
+		t.Error("Replacing webhook failed: secret not preserved")
+	}
+}
+
+func TestWebhookDELETE(t *testing.T) {
+	hookID := webhookIDs[0]
+
+	params := httprouter.Params{
+		httprouter.Param{
+			Key:   "id",
+			Value: hookID.Hex(),
+		},
+	}
+
+	w := httptest.NewRecorder()
+
+	WebhookDELETE(w, &http.Request{}, params)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("Deleting webhook failed: unexpcted response code %v", resp.StatusCode)
+	}
+
+	removeWebhookID(hookID)
+}
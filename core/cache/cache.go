@@ -0,0 +1,236 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/internal/httputil"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	headerCacheControl = "Cache-Control"
+	headerContentType  = "Content-Type"
+	headerCache        = "X-Cache"
+)
+
+// Entry is a cached response
+type Entry struct {
+	ContentType string
+	Body        []byte
+
+	// Tags are the data the response depends on
+	Tags    []string
+	Expires time.Time
+}
+
+// size returns the approximate memory size of the entry
+func (e *Entry) size() int64 {
+	n := len(e.ContentType) + len(e.Body)
+	for _, t := range e.Tags {
+		n += len(t)
+	}
+
+	return int64(n)
+}
+
+// Store describes the storage of cached responses. Expired entries must not
+// be returned and an invalidated tag must remove all entries of the tag.
+type Store interface {
+	// Get returns the entry of the key, if any
+	Get(ctx context.Context, key string) (*Entry, bool, error)
+
+	// Set stores the entry under the key
+	Set(ctx context.Context, key string, e *Entry) error
+
+	// Invalidate removes all entries of the tags
+	Invalidate(ctx context.Context, tags ...string) error
+}
+
+var store, external Store
+
+// SetStore sets an external store which is used instead of the in-memory
+// store. It must be called before Init.
+func SetStore(s Store) {
+	external = s
+}
+
+// Init initializes the store of the cache
+func Init() {
+	if !cfg.Enabled {
+		return
+	}
+
+	if external != nil {
+		store = external
+		return
+	}
+
+	s := newMemoryStore(cfg.Size)
+	store = s
+
+	go func() {
+		for now := range time.Tick(time.Minute) {
+			s.cleanup(now)
+		}
+	}()
+}
+
+// Enabled reports whether responses are cached
+func Enabled() bool {
+	return cfg.Enabled && store != nil
+}
+
+// Tag returns the tag of the data of a collection, which can be qualified
+// by the kind of an item, the ID of a document or the ID of its parent. The
+// qualifiers are lowercased, so that a kind given in a path and the hex
+// encoding of an ID match those of the model.
+func Tag(collection string, qualifiers ...string) string {
+	parts := []string{collection}
+	for _, q := range qualifiers {
+		parts = append(parts, strings.ToLower(q))
+	}
+
+	return strings.Join(parts, ":")
+}
+
+// ParamTag returns the tag function of a route whose responses depend on the
+// data of a collection qualified by the values of the route parameters
+func ParamTag(collection string, params ...string) TagFunc {
+	return func(ps httprouter.Params) []string {
+		values := make([]string, len(params))
+		for i, p := range params {
+			values[i] = ps.ByName(p)
+		}
+
+		return []string{Tag(collection, values...)}
+	}
+}
+
+// generation is increased by every invalidation, so that responses which
+// were generated during an invalidation are not stored
+var generation atomic.Uint64
+
+// Invalidate removes the cached responses of the tags. Errors are only
+// logged, since the data the responses depend on is changed already.
+func Invalidate(ctx context.Context, tags ...string) {
+	if !Enabled() {
+		return
+	}
+
+	generation.Add(1)
+
+	if err := store.Invalidate(ctx, tags...); err != nil {
+		logger.Errorf("Cache invalidation error: %s", err)
+	}
+}
+
+// ObserveFunc observes the outcome of a cache lookup of a route
+type ObserveFunc func(route string, hit bool)
+
+var observe ObserveFunc = func(string, bool) {}
+
+// SetObserveFunc sets the function observing the outcomes of cache lookups
+func SetObserveFunc(f ObserveFunc) {
+	observe = f
+}
+
+// TagFunc returns the tags of the response of a request
+type TagFunc func(ps httprouter.Params) []string
+
+// Handler returns a handler serving the responses of the route from the
+// cache. Successful responses are stored with the tags of the request and
+// allowed to be cached privately by the client.
+func Handler(route string, tags TagFunc, h httprouter.Handle) httprouter.Handle {
+	if !cfg.Enabled {
+		return h
+	}
+
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		if store == nil {
+			h(w, r, ps)
+			return
+		}
+
+		ctx := r.Context()
+		key := requestKey(r)
+
+		if !noCache(r) {
+			e, ok, err := store.Get(ctx, key)
+			if err != nil {
+				logger.Errorf("Cache lookup error: %s", err)
+			}
+
+			if ok {
+				observe(route, true)
+
+				w.Header().Set(headerContentType, e.ContentType)
+				w.Header().Set(headerCacheControl, cacheControl())
+				w.Header().Set(headerCache, "HIT")
+				w.WriteHeader(http.StatusOK)
+				w.Write(e.Body)
+
+				return
+			}
+		}
+
+		observe(route, false)
+		w.Header().Set(headerCache, "MISS")
+
+		gen := generation.Load()
+
+		// Only successful responses are cacheable by the client
+		rec := httputil.NewRecorder(w)
+		rec.Body = new(bytes.Buffer)
+		rec.OnHeader = func(status int) {
+			if status == http.StatusOK {
+				rec.Header().Set(headerCacheControl, cacheControl())
+			}
+		}
+
+		h(rec, r, ps)
+
+		if rec.Status != http.StatusOK || gen != generation.Load() {
+			return
+		}
+
+		e := &Entry{
+			ContentType: rec.Header().Get(headerContentType),
+			Body:        rec.Body.Bytes(),
+			Tags:        tags(ps),
+			Expires:     time.Now().Add(cfg.TTL.Duration),
+		}
+
+		if err := store.Set(ctx, key, e); err != nil {
+			logger.Errorf("Cache store error: %s", err)
+		}
+	}
+}
+
+// requestKey returns the key of the response of a request, which doesn't
+// depend on the order of the query parameters
+func requestKey(r *http.Request) string {
+	return r.URL.Path + "?" + r.URL.Query().Encode()
+}
+
+// noCache reports whether the client requests a fresh response
+func noCache(r *http.Request) bool {
+	v := r.Header.Get(headerCacheControl)
+
+	return strings.Contains(v, "no-cache") || strings.Contains(v, "no-store")
+}
+
+func cacheControl() string {
+	if age := int64(cfg.MaxAge.Seconds()); age > 0 {
+		return fmt.Sprintf("private, max-age=%d", age)
+	}
+
+	return "private, no-cache"
+}
@@ -0,0 +1,215 @@
+package cache
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/config"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func testEntry(body string, expires time.Time, tags ...string) *Entry {
+	return &Entry{ContentType: "application/json", Body: []byte(body), Tags: tags, Expires: expires}
+}
+
+func TestMemoryStoreEviction(t *testing.T) {
+	ctx := context.Background()
+	expires := time.Now().Add(time.Minute)
+
+	s := newMemoryStore(3 * testEntry("aaaa", expires).size())
+
+	s.Set(ctx, "a", testEntry("aaaa", expires))
+	s.Set(ctx, "b", testEntry("bbbb", expires))
+	s.Set(ctx, "c", testEntry("cccc", expires))
+
+	// "a" becomes the most recently used entry, so "b" is evicted
+	if _, ok, _ := s.Get(ctx, "a"); !ok {
+		t.Fatal("Entry a is missing")
+	}
+
+	s.Set(ctx, "d", testEntry("dddd", expires))
+
+	for key, expected := range map[string]bool{"a": true, "b": false, "c": true, "d": true} {
+		if _, ok, _ := s.Get(ctx, key); ok != expected {
+			t.Errorf("Presence of entry %s is %v, expected %v", key, ok, expected)
+		}
+	}
+
+	if s.size > s.maxSize {
+		t.Errorf("Size is %v, expected at most %v", s.size, s.maxSize)
+	}
+
+	// An entry larger than the store is not stored
+	s.Set(ctx, "e", testEntry("eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee", expires))
+	if _, ok, _ := s.Get(ctx, "e"); ok {
+		t.Error("Entry e is present, expected to exceed the store")
+	}
+}
+
+func TestMemoryStoreExpiry(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	s := newMemoryStore(1 << 10)
+	s.now = func() time.Time { return now }
+
+	s.Set(ctx, "a", testEntry("a", now.Add(time.Minute)))
+	s.Set(ctx, "b", testEntry("b", now.Add(time.Hour)))
+
+	now = now.Add(2 * time.Minute)
+
+	if _, ok, _ := s.Get(ctx, "a"); ok {
+		t.Error("Entry a is present, expected to be expired")
+	}
+
+	s.cleanup(now.Add(2 * time.Hour))
+
+	if s.lru.Len() != 0 || s.size != 0 {
+		t.Errorf("Store holds %v entries of size %v after cleanup, expected none", s.lru.Len(), s.size)
+	}
+}
+
+func TestMemoryStoreInvalidate(t *testing.T) {
+	ctx := context.Background()
+	expires := time.Now().Add(time.Minute)
+
+	s := newMemoryStore(1 << 10)
+
+	s.Set(ctx, "index", testEntry("index", expires, "items"))
+	s.Set(ctx, "list", testEntry("list", expires, "items:ammunition"))
+	s.Set(ctx, "other", testEntry("other", expires, "items:armor"))
+
+	s.Invalidate(ctx, "items", "items:ammunition")
+
+	for key, expected := range map[string]bool{"index": false, "list": false, "other": true} {
+		if _, ok, _ := s.Get(ctx, key); ok != expected {
+			t.Errorf("Presence of entry %s is %v, expected %v", key, ok, expected)
+		}
+	}
+
+	if len(s.tags) != 1 {
+		t.Errorf("Store holds %v tags, expected 1", len(s.tags))
+	}
+}
+
+func TestHandler(t *testing.T) {
+	prevCfg, prevStore, prevObserve := cfg, store, observe
+	defer func() { cfg, store, observe = prevCfg, prevStore, prevObserve }()
+
+	cfg = &config.Cache{
+		Enabled: true,
+		Size:    1 << 10,
+		TTL:     config.Duration{Duration: time.Minute},
+		MaxAge:  config.Duration{Duration: 30 * time.Second},
+	}
+	store = newMemoryStore(cfg.Size)
+
+	var hits, misses int
+	observe = func(_ string, hit bool) {
+		if hit {
+			hits++
+		} else {
+			misses++
+		}
+	}
+
+	calls := 0
+	status := http.StatusOK
+	h := Handler("/item/:kind", func(ps httprouter.Params) []string {
+		return []string{Tag("items", ps.ByName("kind"))}
+	}, func(w http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(`{"total":1}`))
+	})
+
+	ps := httprouter.Params{{Key: "kind", Value: "ammunition"}}
+
+	request := func(target string, header http.Header) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, target, nil)
+		for k, v := range header {
+			r.Header[k] = v
+		}
+		w := httptest.NewRecorder()
+		h(w, r, ps)
+
+		return w
+	}
+
+	tests := []struct {
+		target string
+		header http.Header
+		cache  string
+		calls  int
+	}{
+		{"/item/ammunition?limit=1&offset=0", nil, "MISS", 1},
+		{"/item/ammunition?offset=0&limit=1", nil, "HIT", 1},
+		{"/item/ammunition?limit=2", nil, "MISS", 2},
+		{"/item/ammunition?limit=1&offset=0", http.Header{"Cache-Control": {"no-cache"}}, "MISS", 3},
+	}
+
+	for _, tt := range tests {
+		w := request(tt.target, tt.header)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("Status code of %s is %v, expected %v", tt.target, w.Code, http.StatusOK)
+		}
+		if v := w.Header().Get(headerCache); v != tt.cache {
+			t.Errorf("Cache header of %s is %q, expected %q", tt.target, v, tt.cache)
+		}
+		if v := w.Header().Get(headerCacheControl); v != "private, max-age=30" {
+			t.Errorf("Cache control of %s is %q", tt.target, v)
+		}
+		if v := w.Header().Get(headerContentType); v != "application/json" {
+			t.Errorf("Content type of %s is %q", tt.target, v)
+		}
+		if w.Body.String() != `{"total":1}` {
+			t.Errorf("Body of %s is %q", tt.target, w.Body.String())
+		}
+		if calls != tt.calls {
+			t.Errorf("Handler is called %v times after %s, expected %v", calls, tt.target, tt.calls)
+		}
+	}
+
+	if hits != 1 || misses != 3 {
+		t.Errorf("Observed %v hits and %v misses, expected 1 and 3", hits, misses)
+	}
+
+	Invalidate(context.Background(), Tag("items", "ammunition"))
+
+	if w := request("/item/ammunition?limit=2", nil); w.Header().Get(headerCache) != "MISS" {
+		t.Error("Response is served from the cache after invalidation")
+	}
+
+	// Unsuccessful responses are neither stored nor cacheable by the client
+	status = http.StatusInternalServerError
+
+	for i := 0; i < 2; i++ {
+		w := request("/item/ammunition?limit=3", nil)
+		if v := w.Header().Get(headerCache); v != "MISS" {
+			t.Errorf("Cache header of failed response is %q, expected %q", v, "MISS")
+		}
+		if v := w.Header().Get(headerCacheControl); v != "" {
+			t.Errorf("Cache control of failed response is %q, expected none", v)
+		}
+	}
+}
+
+func TestTag(t *testing.T) {
+	if tag := Tag("items"); tag != "items" {
+		t.Errorf("Tag is %q, expected %q", tag, "items")
+	}
+	if tag := Tag("features", "5c5f1b1a2a0ab0b6e8b4567a"); tag != "features:5c5f1b1a2a0ab0b6e8b4567a" {
+		t.Errorf("Tag is %q, expected %q", tag, "features:5c5f1b1a2a0ab0b6e8b4567a")
+	}
+
+	ps := httprouter.Params{{Key: "kind", Value: "modificationBarrel"}}
+	if tags := ParamTag("items", "kind")(ps); len(tags) != 1 || tags[0] != Tag("items", "modificationBarrel") {
+		t.Errorf("Tags of the route are %q, expected %q", tags, Tag("items", "modificationBarrel"))
+	}
+}
@@ -0,0 +1,11 @@
+package cache
+
+import "github.com/tarkov-database/rest-api/core/config"
+
+var cfg = &config.Default().Cache
+
+// Configure applies the configuration to the cache. It has to be called
+// before Init.
+func Configure(c config.Cache) {
+	cfg = &c
+}
@@ -0,0 +1,135 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// memoryStore holds the entries in memory and evicts the least recently
+// used ones when the size is exceeded
+type memoryStore struct {
+	mu      sync.Mutex
+	maxSize int64
+	size    int64
+
+	// lru holds the elements of the entries, most recently used first
+	lru     *list.List
+	entries map[string]*list.Element
+	tags    map[string]map[string]struct{}
+
+	now func() time.Time
+}
+
+type memoryEntry struct {
+	key   string
+	entry *Entry
+}
+
+func newMemoryStore(maxSize int64) *memoryStore {
+	return &memoryStore{
+		maxSize: maxSize,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+		tags:    make(map[string]map[string]struct{}),
+		now:     time.Now,
+	}
+}
+
+// Get implements the Store interface
+func (s *memoryStore) Get(_ context.Context, key string) (*Entry, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	el, ok := s.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+
+	e := el.Value.(*memoryEntry).entry
+	if !s.now().Before(e.Expires) {
+		s.remove(el)
+		return nil, false, nil
+	}
+
+	s.lru.MoveToFront(el)
+
+	return e, true, nil
+}
+
+// Set implements the Store interface
+func (s *memoryStore) Set(_ context.Context, key string, e *Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if el, ok := s.entries[key]; ok {
+		s.remove(el)
+	}
+
+	// An entry larger than the store would evict all others
+	if e.size() > s.maxSize {
+		return nil
+	}
+
+	s.entries[key] = s.lru.PushFront(&memoryEntry{key: key, entry: e})
+	s.size += e.size()
+
+	for _, t := range e.Tags {
+		keys, ok := s.tags[t]
+		if !ok {
+			keys = make(map[string]struct{})
+			s.tags[t] = keys
+		}
+		keys[key] = struct{}{}
+	}
+
+	for s.size > s.maxSize {
+		s.remove(s.lru.Back())
+	}
+
+	return nil
+}
+
+// Invalidate implements the Store interface
+func (s *memoryStore) Invalidate(_ context.Context, tags ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range tags {
+		for key := range s.tags[t] {
+			s.remove(s.entries[key])
+		}
+	}
+
+	return nil
+}
+
+// remove drops the entry of the element along with its tag references
+func (s *memoryStore) remove(el *list.Element) {
+	me := s.lru.Remove(el).(*memoryEntry)
+
+	delete(s.entries, me.key)
+	s.size -= me.entry.size()
+
+	for _, t := range me.entry.Tags {
+		delete(s.tags[t], me.key)
+		if len(s.tags[t]) == 0 {
+			delete(s.tags, t)
+		}
+	}
+}
+
+// cleanup removes the expired entries
+func (s *memoryStore) cleanup(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for el := s.lru.Back(); el != nil; {
+		prev := el.Prev()
+		if !now.Before(el.Value.(*memoryEntry).entry.Expires) {
+			s.remove(el)
+		}
+		el = prev
+	}
+}
@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/tarkov-database/rest-api/core/config"
+	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/logger"
+)
+
+// ErrUsage indicates that a command was called with invalid arguments
+var ErrUsage = errors.New("invalid usage")
+
+// Command is a subcommand of the binary
+type Command struct {
+	// Name is the name of the command, which can consist of multiple words
+	// like "user create"
+	Name    string
+	Args    string
+	Summary string
+
+	// Database indicates that the command needs a database connection
+	Database bool
+
+	// Flags defines the flags of the command on the given set
+	Flags func(fs *flag.FlagSet)
+
+	// Run runs the command with the remaining arguments
+	Run func(ctx context.Context, cfg *config.Config, args []string) error
+}
+
+var commands = make(map[string]*Command)
+
+// Register adds a command
+func Register(c *Command) {
+	commands[c.Name] = c
+}
+
+var (
+	stdout io.Writer = os.Stdout
+	stderr io.Writer = os.Stderr
+	stdin  io.Reader = os.Stdin
+)
+
+// Run parses the global flags and runs the command of the arguments, which
+// is "serve" if none is given. It returns the exit code of the process.
+func Run(args []string) int {
+	fs := flag.NewFlagSet("apiserver", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { usage(fs) }
+
+	configFile := fs.String("config", os.Getenv("CONFIG_FILE"), "path of a YAML or TOML configuration file")
+	printConfig := fs.Bool("print-config", false, "print the configuration with redacted secrets and exit")
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	cmd, rest := lookup(fs.Args())
+	if cmd == nil && !*printConfig {
+		usage(fs)
+		return 2
+	}
+
+	// The output of administrative commands is kept apart from the log
+	if *printConfig || cmd != commands["serve"] {
+		logger.SetOutput(stderr)
+	}
+
+	cfg, err := config.Load(*configFile)
+
+	if *printConfig {
+		if err := config.Print(stdout, cfg); err != nil {
+			fmt.Fprintf(stderr, "Printing configuration failed: %s\n", err)
+			return 1
+		}
+	}
+
+	if err == nil {
+		err = configure(cfg)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "Configuration error:\n%s\n", err)
+		return 2
+	}
+
+	if *printConfig {
+		return 0
+	}
+
+	cmdFlags := flag.NewFlagSet(cmd.Name, flag.ContinueOnError)
+	cmdFlags.SetOutput(stderr)
+	cmdFlags.Usage = func() { commandUsage(cmd, cmdFlags) }
+	if cmd.Flags != nil {
+		cmd.Flags(cmdFlags)
+	}
+
+	if err := cmdFlags.Parse(rest); err != nil {
+		return 2
+	}
+
+	ctx := context.Background()
+
+	if cmd.Database {
+		if err := database.Init(); err != nil {
+			fmt.Fprintf(stderr, "Database initiation error: %s\n", err)
+			return 1
+		}
+		defer database.Shutdown()
+	}
+
+	if err := cmd.Run(ctx, cfg, cmdFlags.Args()); err != nil {
+		if errors.Is(err, ErrUsage) {
+			fmt.Fprintf(stderr, "%s\n\n", err)
+			commandUsage(cmd, cmdFlags)
+			return 2
+		}
+
+		fmt.Fprintf(stderr, "%s: %s\n", cmd.Name, err)
+		return 1
+	}
+
+	return 0
+}
+
+// lookup returns the command with the longest name matching the leading
+// arguments and the remaining arguments
+func lookup(args []string) (*Command, []string) {
+	if len(args) == 0 {
+		return commands["serve"], nil
+	}
+
+	for n := len(args); n > 0; n-- {
+		if c, ok := commands[strings.Join(args[:n], " ")]; ok {
+			return c, args[n:]
+		}
+	}
+
+	return nil, nil
+}
+
+func usage(fs *flag.FlagSet) {
+	fmt.Fprintf(stderr, "Usage: apiserver [flags] [command]\n\nCommands:\n")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(stderr, "  %-16s %s\n", name, commands[name].Summary)
+	}
+
+	fmt.Fprintf(stderr, "\nFlags:\n")
+	fs.PrintDefaults()
+}
+
+func commandUsage(cmd *Command, fs *flag.FlagSet) {
+	fmt.Fprintf(stderr, "Usage: apiserver [flags] %s [flags] %s\n\n%s\n", cmd.Name, cmd.Args, cmd.Summary)
+
+	if cmd.Flags != nil {
+		fmt.Fprintf(stderr, "\nFlags:\n")
+		fs.PrintDefaults()
+	}
+}
+
+// splitList returns the non-empty elements of a comma-separated list
+func splitList(s string) []string {
+	var l []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			l = append(l, v)
+		}
+	}
+
+	return l
+}
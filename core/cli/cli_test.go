@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model/user"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// useOutput captures the output of a command for the duration of the test
+func useOutput(t *testing.T) (out, errOut *bytes.Buffer) {
+	t.Helper()
+
+	out, errOut = &bytes.Buffer{}, &bytes.Buffer{}
+
+	prevOut, prevErr := stdout, stderr
+	stdout, stderr = out, errOut
+	t.Cleanup(func() { stdout, stderr = prevOut, prevErr })
+
+	return out, errOut
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		args []string
+		name string
+		rest int
+	}{
+		{nil, "serve", 0},
+		{[]string{"serve"}, "serve", 0},
+		{[]string{"user", "create", "-email", "a@b.cd"}, "user create", 2},
+		{[]string{"token", "issue"}, "token issue", 0},
+		{[]string{"token"}, "", 0},
+		{[]string{"unknown"}, "", 0},
+	}
+
+	for _, tt := range tests {
+		cmd, rest := lookup(tt.args)
+
+		switch {
+		case tt.name == "" && cmd != nil:
+			t.Errorf("Lookup of %q returned %q, expected none", tt.args, cmd.Name)
+		case tt.name != "" && (cmd == nil || cmd.Name != tt.name):
+			t.Errorf("Lookup of %q failed, expected %q", tt.args, tt.name)
+		case len(rest) != tt.rest:
+			t.Errorf("Lookup of %q returned arguments %q", tt.args, rest)
+		}
+	}
+}
+
+func TestTokenIssue(t *testing.T) {
+	t.Setenv("MONGO_URI", "mongodb://localhost:27017")
+	t.Setenv("MONGO_DB", "testing")
+	t.Setenv("JWT_KEY", "testing-secret")
+	t.Setenv("JWT_ALG", "HS256")
+	t.Setenv("JWT_AUDIENCE", "testing")
+
+	out, _ := useOutput(t)
+
+	if code := Run([]string{"token", "issue", "-sub", "admin", "-scope", "read:all, write:item"}); code != 0 {
+		t.Fatalf("Issuing token failed with code %v", code)
+	}
+
+	clm, err := jwt.VerifyToken(strings.TrimSpace(out.String()))
+	if err != nil {
+		t.Fatalf("Verifying issued token failed: %v", err)
+	}
+	if clm.Subject != "admin" || len(clm.Scope) != 2 || clm.Scope[1] != jwt.ScopeItemWrite {
+		t.Errorf("Issued token has unexpected claims %+v", clm)
+	}
+
+	if code := Run([]string{"token", "issue", "-sub", "admin", "-scope", "read:everything"}); code != 1 {
+		t.Errorf("Issuing token of invalid scope exited with %v, expected 1", code)
+	}
+	if code := Run([]string{"token", "issue", "-scope", jwt.ScopeAllRead}); code != 2 {
+		t.Errorf("Issuing token without subject exited with %v, expected 2", code)
+	}
+}
+
+func TestDecodeDocs(t *testing.T) {
+	inputs := map[string]string{
+		formatJSON:   `[{"_id": {"$oid": "5f3e3e3e3e3e3e3e3e3e3e3e"}, "n": 1}, {"n": {"$numberLong": "2"}}]`,
+		formatNDJSON: "{\"_id\": {\"$oid\": \"5f3e3e3e3e3e3e3e3e3e3e3e\"}, \"n\": 1}\n\n{\"n\": {\"$numberLong\": \"2\"}}\n",
+	}
+
+	for format, input := range inputs {
+		var docs []bson.Raw
+
+		err := decodeDocs(strings.NewReader(input), format, func(doc bson.Raw) error {
+			docs = append(docs, doc)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Decoding %s failed: %v", format, err)
+		}
+
+		if len(docs) != 2 {
+			t.Fatalf("Decoding %s returned %v documents, expected 2", format, len(docs))
+		}
+		if id, ok := docs[0].Lookup("_id").ObjectIDOK(); !ok || id.Hex() != "5f3e3e3e3e3e3e3e3e3e3e3e" {
+			t.Errorf("Decoding %s lost the object ID: %v", format, docs[0])
+		}
+		if n, ok := docs[1].Lookup("n").Int64OK(); !ok || n != 2 {
+			t.Errorf("Decoding %s lost the integer type: %v", format, docs[1])
+		}
+	}
+
+	if err := decodeDocs(strings.NewReader(`{"n": 1}`), formatJSON, func(bson.Raw) error { return nil }); err == nil {
+		t.Error("Decoding JSON object as array succeeded")
+	}
+	if err := decodeDocs(strings.NewReader("{\"n\": 1}\n{\"n\": "), formatNDJSON, func(bson.Raw) error { return nil }); err == nil {
+		t.Error("Decoding truncated NDJSON succeeded")
+	}
+}
+
+func TestValidateDocument(t *testing.T) {
+	tests := []struct {
+		doc   interface{}
+		valid bool
+	}{
+		{bson.M{"email": "user@testing.dev"}, true},
+		{bson.M{"email": "invalid"}, false},
+		{bson.M{"email": "user@testing.dev", "quota": "unlimited"}, false},
+	}
+
+	for _, tt := range tests {
+		err := validateDocument(mustMarshal(t, tt.doc), entities[user.Collection])
+		if (err == nil) != tt.valid {
+			t.Errorf("Validation of %v returned %v", tt.doc, err)
+		}
+	}
+
+	if err := validateDocument(mustMarshal(t, bson.M{"_kind": "unknown"}), itemEntity); err == nil {
+		t.Error("Validation of item of unknown kind succeeded")
+	}
+}
+
+func mustMarshal(t *testing.T, v interface{}) bson.Raw {
+	t.Helper()
+
+	b, err := bson.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return b
+}
@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/tarkov-database/rest-api/model/apikey"
+	"github.com/tarkov-database/rest-api/model/clientcert"
+	"github.com/tarkov-database/rest-api/model/hideout/module"
+	"github.com/tarkov-database/rest-api/model/hideout/production"
+	"github.com/tarkov-database/rest-api/model/item"
+	"github.com/tarkov-database/rest-api/model/location"
+	"github.com/tarkov-database/rest-api/model/location/feature"
+	"github.com/tarkov-database/rest-api/model/location/featuregroup"
+	"github.com/tarkov-database/rest-api/model/role"
+	"github.com/tarkov-database/rest-api/model/statistic/ammunition/armor"
+	"github.com/tarkov-database/rest-api/model/statistic/ammunition/distance"
+	"github.com/tarkov-database/rest-api/model/user"
+	"github.com/tarkov-database/rest-api/model/webhook"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// entity is a stored document which validates its fields
+type entity interface {
+	Validate() error
+}
+
+// entities maps the collections of entities to a function returning the
+// entity type of a document
+var entities = map[string]func(doc bson.Raw) (entity, error){
+	item.Collection:         itemEntity,
+	module.Collection:       func(bson.Raw) (entity, error) { return &module.Module{}, nil },
+	production.Collection:   func(bson.Raw) (entity, error) { return &production.Production{}, nil },
+	location.Collection:     func(bson.Raw) (entity, error) { return &location.Location{}, nil },
+	feature.Collection:      func(bson.Raw) (entity, error) { return &feature.Feature{}, nil },
+	featuregroup.Collection: func(bson.Raw) (entity, error) { return &featuregroup.Group{}, nil },
+	armor.Collection:        func(bson.Raw) (entity, error) { return &armor.AmmoArmorStatistics{}, nil },
+	distance.Collection:     func(bson.Raw) (entity, error) { return &distance.AmmoDistanceStatistics{}, nil },
+	user.Collection:         func(bson.Raw) (entity, error) { return &user.User{}, nil },
+	role.Collection:         func(bson.Raw) (entity, error) { return &role.Role{}, nil },
+	webhook.Collection:      func(bson.Raw) (entity, error) { return &webhook.Webhook{}, nil },
+	apikey.Collection:       func(bson.Raw) (entity, error) { return &apikey.Key{}, nil },
+	clientcert.Collection:   func(bson.Raw) (entity, error) { return &clientcert.Mapping{}, nil },
+}
+
+// itemEntity returns the entity of the kind of an item
+func itemEntity(doc bson.Raw) (entity, error) {
+	var k struct {
+		Kind item.Kind `bson:"_kind"`
+	}
+
+	if err := bson.Unmarshal(doc, &k); err != nil {
+		return nil, err
+	}
+
+	return k.Kind.GetEntity()
+}
+
+// collectionNames returns the names of all entity collections in order
+func collectionNames() []string {
+	names := make([]string, 0, len(entities))
+	for name := range entities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// checkCollection returns an error if the name is not one of an entity
+// collection
+func checkCollection(name string) error {
+	if _, ok := entities[name]; !ok {
+		return fmt.Errorf("%w: collection %q is not one of %v", ErrUsage, name, collectionNames())
+	}
+
+	return nil
+}
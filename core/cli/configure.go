@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"errors"
+
+	"github.com/tarkov-database/rest-api/core/cache"
+	"github.com/tarkov-database/rest-api/core/config"
+	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/event"
+	"github.com/tarkov-database/rest-api/core/health"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/core/mail"
+	"github.com/tarkov-database/rest-api/core/metrics"
+	"github.com/tarkov-database/rest-api/core/oidc"
+	"github.com/tarkov-database/rest-api/core/reload"
+	"github.com/tarkov-database/rest-api/core/rpc"
+	"github.com/tarkov-database/rest-api/core/server"
+	"github.com/tarkov-database/rest-api/core/tracing"
+	"github.com/tarkov-database/rest-api/core/webhook"
+	"github.com/tarkov-database/rest-api/middleware/accesslog"
+	"github.com/tarkov-database/rest-api/middleware/audit"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/middleware/ratelimit"
+)
+
+// configure applies the configuration to all components. It has to be called
+// before they are initiated.
+func configure(c *config.Config) error {
+	var errs []error
+
+	if err := logger.Configure(c.Log); err != nil {
+		errs = append(errs, err)
+	}
+
+	server.Configure(c.Server)
+	database.Configure(c.Database)
+
+	if err := jwt.Configure(c.JWT); err != nil {
+		errs = append(errs, err)
+	}
+
+	health.Configure(c.Health)
+
+	if err := ratelimit.Configure(c.RateLimit); err != nil {
+		errs = append(errs, err)
+	}
+
+	audit.Configure(c.Audit)
+	accesslog.Configure(c.AccessLog)
+	webhook.Configure(c.Webhook)
+	oidc.Configure(c.OIDC)
+	tracing.Configure(c.Tracing)
+	metrics.Configure(c.Metrics)
+	rpc.Configure(c.GRPC)
+	event.Configure(c.Event)
+	reload.Configure(c.Reload)
+	cache.Configure(c.Cache)
+
+	if err := mail.Configure(c.Mail); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/tarkov-database/rest-api/core/config"
+	"github.com/tarkov-database/rest-api/core/database"
+)
+
+var ensureIndexesFlags struct {
+	check bool
+}
+
+func init() {
+	Register(&Command{
+		Name:     "ensure-indexes",
+		Summary:  "create the missing indexes of the models and report drift",
+		Database: true,
+		Flags: func(fs *flag.FlagSet) {
+			fs.BoolVar(&ensureIndexesFlags.check, "check", false, "only report the state of the indexes, failing on drift")
+		},
+		Run: ensureIndexes,
+	})
+}
+
+func ensureIndexes(ctx context.Context, _ *config.Config, _ []string) error {
+	var status []database.IndexStatus
+	var err error
+
+	if ensureIndexesFlags.check {
+		status, err = database.CheckIndexes(ctx)
+	} else {
+		status, err = database.EnsureIndexes(ctx)
+	}
+
+	drift := 0
+	for _, s := range status {
+		if s.Drifted() {
+			drift++
+		}
+		fmt.Fprintf(stdout, "%-8s %s.%s (%s)\n", s.State, s.Collection, s.Name, s.Keys)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if drift > 0 && ensureIndexesFlags.check {
+		return fmt.Errorf("%d of %d indexes drifted", drift, len(status))
+	}
+
+	return nil
+}
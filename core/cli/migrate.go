@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/config"
+	"github.com/tarkov-database/rest-api/core/migration"
+)
+
+var migrateFlags struct {
+	dryRun bool
+	status bool
+}
+
+func init() {
+	Register(&Command{
+		Name:     "migrate",
+		Summary:  "apply the pending schema migrations",
+		Database: true,
+		Flags: func(fs *flag.FlagSet) {
+			fs.BoolVar(&migrateFlags.dryRun, "dry-run", false, "report the matching documents without modifying them")
+			fs.BoolVar(&migrateFlags.status, "status", false, "list the applied and pending migrations instead")
+		},
+		Run: migrate,
+	})
+}
+
+func migrate(ctx context.Context, _ *config.Config, _ []string) error {
+	f := migrateFlags
+
+	if f.status {
+		status, err := migration.GetStatus(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, s := range status {
+			state := "pending"
+			if s.Record != nil {
+				state = "applied " + s.Record.Applied.Format(time.RFC3339)
+			}
+			fmt.Fprintf(stdout, "%4d  %-30s %s\n", s.Version, state, s.Description)
+		}
+
+		return nil
+	}
+
+	results, err := migration.Run(ctx, f.dryRun)
+
+	verb := "modified"
+	if f.dryRun {
+		verb = "matching"
+	}
+
+	for _, r := range results {
+		fmt.Fprintf(stdout, "%4d  %d documents %s  %s\n", r.Version, r.Affected, verb, r.Description)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(results) == 0 {
+		fmt.Fprintln(stdout, "No pending migrations")
+	}
+
+	return nil
+}
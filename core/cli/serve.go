@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tarkov-database/rest-api/core/cache"
+	"github.com/tarkov-database/rest-api/core/config"
+	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/event"
+	"github.com/tarkov-database/rest-api/core/graph"
+	"github.com/tarkov-database/rest-api/core/health"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/core/mail"
+	"github.com/tarkov-database/rest-api/core/metrics"
+	"github.com/tarkov-database/rest-api/core/migration"
+	"github.com/tarkov-database/rest-api/core/oidc"
+	"github.com/tarkov-database/rest-api/core/reload"
+	"github.com/tarkov-database/rest-api/core/rpc"
+	"github.com/tarkov-database/rest-api/core/server"
+	"github.com/tarkov-database/rest-api/core/tracing"
+	"github.com/tarkov-database/rest-api/core/webhook"
+	"github.com/tarkov-database/rest-api/middleware/audit"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/middleware/ratelimit"
+	"github.com/tarkov-database/rest-api/model/api"
+	"github.com/tarkov-database/rest-api/model/apikey"
+	"github.com/tarkov-database/rest-api/model/clientcert"
+	"github.com/tarkov-database/rest-api/model/token"
+	"github.com/tarkov-database/rest-api/model/user"
+)
+
+func init() {
+	Register(&Command{
+		Name:    "serve",
+		Summary: "start the HTTP and gRPC servers (default)",
+		Run:     serve,
+	})
+}
+
+func serve(ctx context.Context, cfg *config.Config, _ []string) error {
+	fmt.Printf("Starting up Tarkov Database REST API %s\n\n", api.Version)
+
+	if err := tracing.Init(); err != nil {
+		return fmt.Errorf("tracing initiation error: %s", err)
+	}
+	defer func() {
+		if err := tracing.Shutdown(); err != nil {
+			logger.Errorf("Tracing shutdown error: %s", err)
+		}
+	}()
+
+	if tracing.Enabled() {
+		database.AddMonitor(tracing.CommandMonitor())
+	}
+
+	if metrics.Enabled() {
+		database.AddMonitor(metrics.CommandMonitor())
+		jwt.SetOutcomeFunc(metrics.ObserveAuthorization)
+		cache.SetObserveFunc(metrics.ObserveCache)
+	}
+
+	if err := database.Init(); err != nil {
+		return fmt.Errorf("database initiation error: %s", err)
+	}
+	defer func() {
+		if err := database.Shutdown(); err != nil {
+			logger.Errorf("Database shutdown error: %s", err)
+		}
+	}()
+
+	// Background work is stopped before the database is disconnected
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if cfg.Migration.OnStartup {
+		results, err := migration.Run(ctx, false)
+		for _, r := range results {
+			logger.Infof("Applied migration %d to %d documents: %s", r.Version, r.Affected, r.Description)
+		}
+		if err != nil {
+			return fmt.Errorf("migration error: %s", err)
+		}
+	}
+
+	// Missing indexes only slow down queries, so their errors are not fatal
+	if cfg.Database.EnsureIndexes {
+		status, err := database.EnsureIndexes(ctx)
+		for _, s := range status {
+			switch {
+			case s.State == database.IndexCreated:
+				logger.Infof("Created index %s of %s", s.Name, s.Collection)
+			case s.Drifted():
+				logger.Warningf("Index %s of %s is %s: %s", s.Name, s.Collection, s.State, s.Keys)
+			}
+		}
+		if err != nil {
+			logger.Errorf("Index creation error: %s", err)
+		}
+	}
+
+	jwt.SetRevocationFunc(token.IsRevoked)
+	jwt.SetAPIKeyFunc(apikey.Authenticate)
+	jwt.SetClientCertFunc(clientcert.Authenticate)
+
+	ratelimit.SetQuotaFunc(user.GetQuota)
+	ratelimit.Init()
+
+	audit.Init()
+
+	cache.Init()
+
+	if err := event.Init(); err != nil {
+		return fmt.Errorf("event broker initiation error: %s", err)
+	}
+
+	webhook.Init(ctx)
+
+	mail.Init()
+
+	oidc.Init()
+
+	if err := graph.Init(); err != nil {
+		return fmt.Errorf("GraphQL schema error: %s", err)
+	}
+
+	health.InitChecks()
+
+	// Key files and root certificates are read again on SIGHUP or on change
+	jwtFiles := append([]string{cfg.JWT.KeyFile, cfg.JWT.RootCerts}, cfg.JWT.VerifyKeys...)
+	reload.Register("JWT keys and root certificates", func() error {
+		return jwt.Configure(cfg.JWT)
+	}, jwtFiles...)
+
+	reload.Start()
+
+	if rpc.Enabled() {
+		go func() {
+			if err := rpc.ListenAndServe(); err != nil {
+				logger.Errorf("gRPC server error: %s", err)
+			}
+		}()
+	}
+
+	if err := server.ListenAndServe(); err != nil {
+		return fmt.Errorf("HTTP server error: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/config"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+)
+
+var tokenIssueFlags struct {
+	subject    string
+	scope      string
+	expiration time.Duration
+}
+
+func init() {
+	Register(&Command{
+		Name:    "token issue",
+		Summary: "issue an access token signed with the configured key",
+		Flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&tokenIssueFlags.subject, "sub", "", "subject of the token, e.g. the ID of a user")
+			fs.StringVar(&tokenIssueFlags.scope, "scope", "", "comma-separated scopes of the token")
+			fs.DurationVar(&tokenIssueFlags.expiration, "expiration", 0, "lifetime of the token instead of the configured one")
+		},
+		Run: tokenIssue,
+	})
+}
+
+func tokenIssue(_ context.Context, _ *config.Config, _ []string) error {
+	f := tokenIssueFlags
+
+	if f.subject == "" {
+		return fmt.Errorf("%w: subject is required", ErrUsage)
+	}
+
+	clm := &jwt.Claims{Scope: splitList(f.scope)}
+	clm.Subject = f.subject
+
+	if len(clm.Scope) == 0 {
+		return fmt.Errorf("%w: scope is required", ErrUsage)
+	}
+
+	if err := clm.Validate(); err != nil {
+		return err
+	}
+
+	var exp *time.Duration
+	if f.expiration > 0 {
+		exp = &f.expiration
+	}
+
+	tkn, err := jwt.SignToken(clm, exp)
+	if err != nil {
+		return fmt.Errorf("signing token failed: %w", err)
+	}
+
+	fmt.Fprintln(stdout, tkn)
+
+	return nil
+}
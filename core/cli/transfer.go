@@ -0,0 +1,252 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/tarkov-database/rest-api/core/config"
+	"github.com/tarkov-database/rest-api/core/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Formats of imported and exported documents, which are MongoDB Extended JSON
+const (
+	formatJSON   = "json"
+	formatNDJSON = "ndjson"
+)
+
+// importBatchSize is the number of documents written at once
+const importBatchSize = 1000
+
+var transferFlags struct {
+	collection string
+	format     string
+	file       string
+	canonical  bool
+	replace    bool
+}
+
+func init() {
+	Register(&Command{
+		Name:     "export",
+		Summary:  "export the documents of a collection as JSON or NDJSON",
+		Database: true,
+		Flags: func(fs *flag.FlagSet) {
+			transferFlagSet(fs, "output file instead of stdout")
+			fs.BoolVar(&transferFlags.canonical, "canonical", false, "use the canonical instead of the relaxed Extended JSON")
+		},
+		Run: export,
+	})
+
+	Register(&Command{
+		Name:     "import",
+		Summary:  "import documents of JSON or NDJSON into a collection",
+		Database: true,
+		Flags: func(fs *flag.FlagSet) {
+			transferFlagSet(fs, "input file instead of stdin")
+			fs.BoolVar(&transferFlags.replace, "replace", false, "replace documents of existing IDs instead of failing")
+		},
+		Run: importDocs,
+	})
+}
+
+func transferFlagSet(fs *flag.FlagSet, fileUsage string) {
+	fs.StringVar(&transferFlags.collection, "collection", "", "name of the collection")
+	fs.StringVar(&transferFlags.format, "format", formatNDJSON, "format of the documents, json or ndjson")
+	fs.StringVar(&transferFlags.file, "file", "", fileUsage)
+}
+
+func checkTransferFlags() error {
+	if err := checkCollection(transferFlags.collection); err != nil {
+		return err
+	}
+
+	switch transferFlags.format {
+	case formatJSON, formatNDJSON:
+	default:
+		return fmt.Errorf("%w: format %q is not one of json or ndjson", ErrUsage, transferFlags.format)
+	}
+
+	return nil
+}
+
+func export(ctx context.Context, _ *config.Config, _ []string) (err error) {
+	if err := checkTransferFlags(); err != nil {
+		return err
+	}
+
+	f := transferFlags
+
+	out := stdout
+	if f.file != "" {
+		file, err := os.Create(f.file)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := file.Close(); err == nil {
+				err = cerr
+			}
+		}()
+		out = file
+	}
+
+	w := bufio.NewWriter(out)
+
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+
+	cur, err := database.GetDB().Collection(f.collection).Find(ctx, bson.D{}, opts)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	n := 0
+
+	if f.format == formatJSON {
+		w.WriteString("[")
+	}
+
+	for cur.Next(ctx) {
+		b, err := bson.MarshalExtJSON(cur.Current, f.canonical, false)
+		if err != nil {
+			return fmt.Errorf("encoding document %s failed: %w", cur.Current.Lookup("_id"), err)
+		}
+
+		if f.format == formatJSON {
+			if n > 0 {
+				w.WriteString(",")
+			}
+			w.WriteString("\n  ")
+			w.Write(b)
+		} else {
+			w.Write(b)
+			w.WriteString("\n")
+		}
+
+		n++
+	}
+	if err := cur.Err(); err != nil {
+		return err
+	}
+
+	if f.format == formatJSON {
+		w.WriteString("\n]\n")
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stderr, "Exported %d documents of %s\n", n, f.collection)
+
+	return nil
+}
+
+func importDocs(ctx context.Context, _ *config.Config, _ []string) error {
+	if err := checkTransferFlags(); err != nil {
+		return err
+	}
+
+	f := transferFlags
+
+	in := stdin
+	if f.file != "" {
+		file, err := os.Open(f.file)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		in = file
+	}
+
+	c := database.GetDB().Collection(f.collection)
+
+	var batch []mongo.WriteModel
+	var written int64
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		res, err := c.BulkWrite(ctx, batch, options.BulkWrite().SetOrdered(true))
+		if res != nil {
+			written += res.InsertedCount + res.UpsertedCount + res.ModifiedCount
+		}
+		batch = batch[:0]
+
+		return err
+	}
+
+	err := decodeDocs(in, f.format, func(doc bson.Raw) error {
+		if f.replace {
+			if _, err := doc.LookupErr("_id"); err != nil {
+				batch = append(batch, mongo.NewInsertOneModel().SetDocument(doc))
+			} else {
+				filter := bson.D{{Key: "_id", Value: doc.Lookup("_id")}}
+				batch = append(batch, mongo.NewReplaceOneModel().SetFilter(filter).SetReplacement(doc).SetUpsert(true))
+			}
+		} else {
+			batch = append(batch, mongo.NewInsertOneModel().SetDocument(doc))
+		}
+
+		if len(batch) >= importBatchSize {
+			return flush()
+		}
+
+		return nil
+	})
+	if err == nil {
+		err = flush()
+	}
+
+	fmt.Fprintf(stderr, "Imported %d documents into %s\n", written, f.collection)
+
+	return err
+}
+
+// decodeDocs decodes Extended JSON documents of a JSON array or of NDJSON and
+// calls fn for each of them
+func decodeDocs(r io.Reader, format string, fn func(bson.Raw) error) error {
+	dec := json.NewDecoder(bufio.NewReader(r))
+
+	if format == formatJSON {
+		if t, err := dec.Token(); err != nil || t != json.Delim('[') {
+			return errors.New("json input is not an array")
+		}
+	}
+
+	for n := 1; ; n++ {
+		if format == formatJSON && !dec.More() {
+			break
+		}
+
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err == io.EOF {
+			break
+		} else if err != nil {
+			return fmt.Errorf("document %d: %w", n, err)
+		}
+
+		var doc bson.Raw
+		if err := bson.UnmarshalExtJSON(raw, false, &doc); err != nil {
+			return fmt.Errorf("document %d: %w", n, err)
+		}
+
+		if err := fn(doc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/tarkov-database/rest-api/core/config"
+	"github.com/tarkov-database/rest-api/model/role"
+	"github.com/tarkov-database/rest-api/model/token"
+	"github.com/tarkov-database/rest-api/model/user"
+)
+
+var userCreateFlags struct {
+	email    string
+	roles    string
+	password string
+	verified bool
+	quota    int64
+}
+
+var userLockFlags struct {
+	id     string
+	email  string
+	unlock bool
+}
+
+func init() {
+	Register(&Command{
+		Name:     "user create",
+		Summary:  "create a user with the given roles",
+		Database: true,
+		Flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&userCreateFlags.email, "email", "", "e-mail address of the user")
+			fs.StringVar(&userCreateFlags.roles, "roles", role.Viewer, "comma-separated roles of the user")
+			fs.StringVar(&userCreateFlags.password, "password", "", "password of the user, if any")
+			fs.BoolVar(&userCreateFlags.verified, "verified", false, "mark the e-mail address as verified")
+			fs.Int64Var(&userCreateFlags.quota, "quota", 0, "daily request quota, where zero is the default quota")
+		},
+		Run: userCreate,
+	})
+
+	Register(&Command{
+		Name:     "user lock",
+		Summary:  "lock a user and revoke its tokens, or unlock it",
+		Database: true,
+		Flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&userLockFlags.id, "id", "", "ID of the user")
+			fs.StringVar(&userLockFlags.email, "email", "", "e-mail address of the user")
+			fs.BoolVar(&userLockFlags.unlock, "unlock", false, "unlock the user instead")
+		},
+		Run: userLock,
+	})
+}
+
+func userCreate(ctx context.Context, _ *config.Config, _ []string) error {
+	f := userCreateFlags
+
+	usr := &user.User{
+		Email:    f.email,
+		Verified: f.verified,
+		Roles:    splitList(f.roles),
+		Quota:    f.quota,
+	}
+
+	if err := usr.Validate(); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	if _, err := role.Scope(ctx, usr.Roles); err != nil {
+		return fmt.Errorf("roles %v are not valid: %w", usr.Roles, err)
+	}
+
+	if f.password != "" {
+		if err := user.ValidatePassword(f.password); err != nil {
+			return err
+		}
+
+		hash, err := user.HashPassword(f.password)
+		if err != nil {
+			return err
+		}
+		usr.Password = hash
+	}
+
+	if err := user.Create(ctx, usr); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(stdout, usr.ID.Hex())
+
+	return nil
+}
+
+func userLock(ctx context.Context, _ *config.Config, _ []string) error {
+	f := userLockFlags
+
+	var usr *user.User
+	var err error
+
+	switch {
+	case f.id != "" && f.email != "":
+		return fmt.Errorf("%w: either id or email is required, not both", ErrUsage)
+	case f.id != "":
+		usr, err = user.GetByID(ctx, f.id)
+	case f.email != "":
+		usr, err = user.GetOneByEmail(ctx, f.email)
+	default:
+		return fmt.Errorf("%w: id or email is required", ErrUsage)
+	}
+	if err != nil {
+		return err
+	}
+
+	id := usr.ID.Hex()
+	usr.Locked = !f.unlock
+
+	if err := user.Replace(ctx, id, usr); err != nil {
+		return err
+	}
+
+	// Tokens of a locked user must not be accepted any longer
+	if usr.Locked {
+		if err := token.RevokeAll(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	state := "locked"
+	if !usr.Locked {
+		state = "unlocked"
+	}
+
+	fmt.Fprintf(stdout, "User %s %s\n", id, state)
+
+	return nil
+}
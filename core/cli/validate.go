@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/tarkov-database/rest-api/core/config"
+	"github.com/tarkov-database/rest-api/core/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var validateFlags struct {
+	collection string
+}
+
+func init() {
+	Register(&Command{
+		Name:     "validate",
+		Summary:  "validate the stored entities and report the invalid ones",
+		Database: true,
+		Flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&validateFlags.collection, "collection", "", "collection to validate instead of all")
+		},
+		Run: validate,
+	})
+}
+
+func validate(ctx context.Context, _ *config.Config, _ []string) error {
+	names := collectionNames()
+
+	if c := validateFlags.collection; c != "" {
+		if err := checkCollection(c); err != nil {
+			return err
+		}
+		names = []string{c}
+	}
+
+	var total, failed int
+
+	for _, name := range names {
+		n, f, err := validateCollection(ctx, name)
+		if err != nil {
+			return fmt.Errorf("validating %s failed: %w", name, err)
+		}
+
+		fmt.Fprintf(stdout, "%s: %d documents, %d invalid\n", name, n, f)
+
+		total += n
+		failed += f
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d documents are invalid", failed, total)
+	}
+
+	return nil
+}
+
+// validateCollection decodes and validates every document of the collection,
+// reporting the invalid ones, and returns the number of all and invalid
+// documents
+func validateCollection(ctx context.Context, name string) (total, failed int, err error) {
+	newEntity := entities[name]
+
+	cur, err := database.GetDB().Collection(name).Find(ctx, bson.D{})
+	if err != nil {
+		return 0, 0, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		total++
+
+		if err := validateDocument(cur.Current, newEntity); err != nil {
+			failed++
+			fmt.Fprintf(stdout, "  %s: %s\n", cur.Current.Lookup("_id"), err)
+		}
+	}
+
+	return total, failed, cur.Err()
+}
+
+func validateDocument(doc bson.Raw, newEntity func(bson.Raw) (entity, error)) error {
+	e, err := newEntity(doc)
+	if err != nil {
+		return err
+	}
+
+	if err := bson.Unmarshal(doc, e); err != nil {
+		return fmt.Errorf("decoding error: %w", err)
+	}
+
+	return e.Validate()
+}
@@ -0,0 +1,165 @@
+// Package config loads the configuration of the service from defaults, a
+// file and the environment. Each component holds the defaults of its section
+// until the Configure function of its package is called with the result.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Config holds the configuration of all components of the service
+type Config struct {
+	Server    Server    `yaml:"server" toml:"server"`
+	Database  Database  `yaml:"database" toml:"database"`
+	JWT       JWT       `yaml:"jwt" toml:"jwt"`
+	Health    Health    `yaml:"health" toml:"health"`
+	RateLimit RateLimit `yaml:"rateLimit" toml:"rateLimit"`
+	Audit     Audit     `yaml:"audit" toml:"audit"`
+	AccessLog AccessLog `yaml:"accessLog" toml:"accessLog"`
+	Webhook   Webhook   `yaml:"webhook" toml:"webhook"`
+	OIDC      OIDC      `yaml:"oidc" toml:"oidc"`
+	Tracing   Tracing   `yaml:"tracing" toml:"tracing"`
+	Metrics   Metrics   `yaml:"metrics" toml:"metrics"`
+	GRPC      GRPC      `yaml:"grpc" toml:"grpc"`
+	Event     Event     `yaml:"event" toml:"event"`
+	Mail      Mail      `yaml:"mail" toml:"mail"`
+	Log       Log       `yaml:"log" toml:"log"`
+	Reload    Reload    `yaml:"reload" toml:"reload"`
+	Migration Migration `yaml:"migration" toml:"migration"`
+	Cache     Cache     `yaml:"cache" toml:"cache"`
+}
+
+// Default returns the configuration used for values which are neither set
+// in the file nor in the environment
+func Default() *Config {
+	return &Config{
+		Server:   Server{Port: 8080},
+		Database: Database{EnsureIndexes: true},
+		JWT: JWT{
+			Expiration:        Duration{30 * time.Minute},
+			RefreshExpiration: Duration{30 * 24 * time.Hour},
+			Leeway:            Duration{30 * time.Second},
+		},
+		Health: Health{
+			Interval:         Duration{30 * time.Second},
+			UnhealthyLatency: Duration{300 * time.Millisecond},
+		},
+		RateLimit: RateLimit{Store: "memory", ProxyHops: 1},
+		Audit:     Audit{Enabled: true, QueueSize: 1000},
+		AccessLog: AccessLog{Enabled: true},
+		Webhook: Webhook{
+			MaxAttempts: 5,
+			Backoff:     Duration{5 * time.Second},
+			Timeout:     Duration{10 * time.Second},
+			QueueSize:   1000,
+			Workers:     4,
+		},
+		OIDC:    OIDC{Roles: []string{"viewer"}, Timeout: Duration{10 * time.Second}},
+		Tracing: Tracing{SampleRatio: 1},
+		Metrics: Metrics{Enabled: true},
+		GRPC:    GRPC{Port: 9090},
+		Event:   Event{Broker: "memory", HistorySize: 1000},
+		Mail: Mail{
+			Driver:         "log",
+			From:           "noreply@tarkov-database.com",
+			LinkURL:        "http://localhost:8080/v2/auth",
+			LinkExpiration: Duration{time.Hour},
+		},
+		Log:       Log{Level: "info", Format: "text"},
+		Migration: Migration{OnStartup: true},
+		Cache: Cache{
+			Enabled: true,
+			Size:    64 << 20,
+			TTL:     Duration{5 * time.Minute},
+			MaxAge:  Duration{time.Minute},
+		},
+	}
+}
+
+// Load returns the default configuration, overridden by the file at path,
+// if given, and by the environment. All invalid values are reported
+// together in the returned error, along with the configuration.
+func Load(path string) (*Config, error) {
+	c := Default()
+
+	if path != "" {
+		if err := decodeFile(path, c); err != nil {
+			return c, err
+		}
+	}
+
+	var errs []error
+
+	if err := applyEnv(c); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := c.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return c, errors.Join(errs...)
+}
+
+// validator is implemented by sections which validate their values
+type validator interface {
+	validate() []error
+}
+
+// Validate checks the values of all sections
+func (c *Config) Validate() error {
+	sections := []struct {
+		name string
+		v    validator
+	}{
+		{"server", &c.Server},
+		{"database", &c.Database},
+		{"jwt", &c.JWT},
+		{"health", &c.Health},
+		{"rateLimit", &c.RateLimit},
+		{"audit", &c.Audit},
+		{"webhook", &c.Webhook},
+		{"oidc", &c.OIDC},
+		{"tracing", &c.Tracing},
+		{"grpc", &c.GRPC},
+		{"event", &c.Event},
+		{"mail", &c.Mail},
+		{"log", &c.Log},
+		{"reload", &c.Reload},
+		{"cache", &c.Cache},
+	}
+
+	var errs []error
+	for _, s := range sections {
+		for _, err := range s.v.validate() {
+			errs = append(errs, fmt.Errorf("%s: %w", s.name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Duration is a time.Duration which is read from and written as a string
+// like "30s"
+type Duration struct {
+	time.Duration
+}
+
+// MarshalText implements the encoding.TextMarshaler interface
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface
+func (d *Duration) UnmarshalText(b []byte) error {
+	v, err := time.ParseDuration(string(b))
+	if err != nil {
+		return err
+	}
+
+	d.Duration = v
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"io"
+	"net/url"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+const redacted = "REDACTED"
+
+// Print writes the configuration as YAML to w. Values of fields with a
+// "secret" tag are replaced, so that the output can be shared.
+func Print(w io.Writer, c *Config) error {
+	out := *c
+
+	walk(reflect.ValueOf(&out).Elem(), func(f reflect.Value, sf reflect.StructField) {
+		if f.Kind() != reflect.String || f.String() == "" {
+			return
+		}
+
+		switch sf.Tag.Get("secret") {
+		case "true":
+			f.SetString(redacted)
+		case "userinfo":
+			f.SetString(redactUserinfo(f.String()))
+		}
+	})
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(&out); err != nil {
+		return err
+	}
+
+	return enc.Close()
+}
+
+// redactUserinfo replaces the password of a URL
+func redactUserinfo(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return redacted
+	}
+
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), redacted)
+	}
+
+	return u.String()
+}
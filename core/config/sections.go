@@ -0,0 +1,467 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Server holds the configuration of the HTTP server
+type Server struct {
+	Port        int    `yaml:"port" toml:"port" env:"SERVER_PORT"`
+	TLS         bool   `yaml:"tls" toml:"tls" env:"SERVER_TLS"`
+	Certificate string `yaml:"certificate" toml:"certificate" env:"SERVER_CERT"`
+	PrivateKey  string `yaml:"privateKey" toml:"privateKey" env:"SERVER_KEY"`
+
+	// ClientAuth is one of "none", "optional" or "require". Client
+	// certificates are verified against the JWT root certificates.
+	ClientAuth string `yaml:"clientAuth" toml:"clientAuth" env:"SERVER_CLIENT_AUTH"`
+
+	// ShutdownDelay is the time between failing readiness and closing the
+	// listener, so that load balancers stop routing new requests
+	ShutdownDelay Duration `yaml:"shutdownDelay" toml:"shutdownDelay" env:"SERVER_SHUTDOWN_DELAY"`
+}
+
+func (s *Server) validate() (errs []error) {
+	errs = append(errs, validatePort(s.Port)...)
+
+	if s.TLS {
+		if s.Certificate == "" {
+			errs = append(errs, errors.New("certificate missing"))
+		}
+		if s.PrivateKey == "" {
+			errs = append(errs, errors.New("private key missing"))
+		}
+	}
+
+	switch s.ClientAuth {
+	case "", "none":
+	case "optional", "require":
+		if !s.TLS {
+			errs = append(errs, errors.New("client auth requires tls"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("client auth %q is not one of none, optional or require", s.ClientAuth))
+	}
+
+	if s.ShutdownDelay.Duration < 0 {
+		errs = append(errs, errors.New("shutdown delay can't be negative"))
+	}
+
+	return errs
+}
+
+// Database holds the configuration of the MongoDB connection
+type Database struct {
+	URI         string `yaml:"uri" toml:"uri" env:"MONGO_URI" secret:"userinfo"`
+	Database    string `yaml:"database" toml:"database" env:"MONGO_DB"`
+	TLS         bool   `yaml:"tls" toml:"tls" env:"MONGO_TLS"`
+	RootCA      string `yaml:"rootCA" toml:"rootCA" env:"MONGO_CA"`
+	Certificate string `yaml:"certificate" toml:"certificate" env:"MONGO_CERT"`
+	PrivateKey  string `yaml:"privateKey" toml:"privateKey" env:"MONGO_KEY"`
+
+	// EnsureIndexes creates the missing indexes of the models on startup
+	EnsureIndexes bool `yaml:"ensureIndexes" toml:"ensureIndexes" env:"MONGO_ENSURE_INDEXES"`
+}
+
+func (d *Database) validate() (errs []error) {
+	switch {
+	case d.URI == "":
+		errs = append(errs, errors.New("uri not set"))
+	case !strings.HasPrefix(d.URI, "mongodb://") && !strings.HasPrefix(d.URI, "mongodb+srv://"):
+		errs = append(errs, errors.New("uri is not a mongodb:// or mongodb+srv:// uri"))
+	}
+
+	if d.Database == "" {
+		errs = append(errs, errors.New("database not set"))
+	}
+
+	if d.TLS && d.Certificate != "" && d.PrivateKey == "" {
+		errs = append(errs, errors.New("private key of the client certificate not set"))
+	}
+
+	return errs
+}
+
+// JWT holds the configuration of the token signing and verification
+type JWT struct {
+	Algorithm string `yaml:"algorithm" toml:"algorithm" env:"JWT_ALG"`
+
+	// KeyFile is a PEM file of an asymmetric private key. It takes
+	// precedence over the symmetric Key.
+	KeyFile string `yaml:"keyFile" toml:"keyFile" env:"JWT_KEY_FILE"`
+	Key     string `yaml:"key" toml:"key" env:"JWT_KEY" secret:"true"`
+
+	// VerifyKeys are PEM files of previous keys, which remain valid for
+	// verification during a key rotation
+	VerifyKeys []string `yaml:"verifyKeys" toml:"verifyKeys" env:"JWT_VERIFY_KEYS"`
+
+	Audience          string   `yaml:"audience" toml:"audience" env:"JWT_AUDIENCE"`
+	Expiration        Duration `yaml:"expiration" toml:"expiration" env:"JWT_EXPIRATION"`
+	RefreshExpiration Duration `yaml:"refreshExpiration" toml:"refreshExpiration" env:"JWT_REFRESH_EXPIRATION"`
+	Leeway            Duration `yaml:"leeway" toml:"leeway" env:"JWT_LEEWAY"`
+
+	// RootCerts is a PEM file of the certificates that client certificates
+	// and certificate-bound tokens are verified against
+	RootCerts string `yaml:"rootCerts" toml:"rootCerts" env:"JWT_ROOT_CERTS"`
+}
+
+func (j *JWT) validate() (errs []error) {
+	if j.KeyFile == "" && j.Key == "" {
+		errs = append(errs, errors.New("key is not set"))
+	}
+
+	if len(j.Audience) < 3 {
+		errs = append(errs, errors.New("audience is not set or too short"))
+	}
+
+	if j.Expiration.Duration <= 0 {
+		errs = append(errs, errors.New("expiration must be positive"))
+	}
+	if j.RefreshExpiration.Duration <= 0 {
+		errs = append(errs, errors.New("refresh expiration must be positive"))
+	}
+	if j.Leeway.Duration < 0 {
+		errs = append(errs, errors.New("leeway can't be negative"))
+	}
+
+	return errs
+}
+
+// Health holds the configuration of the health checks
+type Health struct {
+	// Interval is the time between two checks, at least ten seconds
+	Interval         Duration `yaml:"interval" toml:"interval" env:"HEALTHCHECK_INTERVAL"`
+	UnhealthyLatency Duration `yaml:"unhealthyLatency" toml:"unhealthyLatency" env:"UNHEALTHY_LATENCY"`
+}
+
+func (h *Health) validate() (errs []error) {
+	if h.Interval.Duration <= 0 {
+		errs = append(errs, errors.New("interval must be positive"))
+	}
+
+	if h.UnhealthyLatency.Duration > h.Interval.Duration {
+		errs = append(errs, errors.New("unhealthy latency can't be higher than the interval"))
+	}
+
+	return errs
+}
+
+// RateLimit holds the configuration of the rate limiting
+type RateLimit struct {
+	Enabled bool `yaml:"enabled" toml:"enabled" env:"RATELIMIT_ENABLED"`
+
+	// Store is "memory" for a limit per instance or "database" for a limit
+	// shared by all instances
+	Store      string `yaml:"store" toml:"store" env:"RATELIMIT_STORE"`
+	TrustProxy bool   `yaml:"trustProxy" toml:"trustProxy" env:"RATELIMIT_TRUST_PROXY"`
+
+	// ProxyHops is the number of trusted proxies in front of the API, each
+	// appending the address of its peer to X-Forwarded-For
+	ProxyHops int `yaml:"proxyHops" toml:"proxyHops" env:"RATELIMIT_PROXY_HOPS"`
+
+	// Tiers are given as "<tier>:<rate>/<burst>", where a rate of zero
+	// disables the limit. The tier "address" limits all requests of a client
+	// address, including those failing the authorization.
+	Tiers []string `yaml:"tiers" toml:"tiers" env:"RATELIMIT_TIERS"`
+	Quota int64    `yaml:"quota" toml:"quota" env:"RATELIMIT_QUOTA"`
+}
+
+func (r *RateLimit) validate() (errs []error) {
+	switch r.Store {
+	case "memory", "database":
+	default:
+		errs = append(errs, fmt.Errorf("store %q is not one of memory or database", r.Store))
+	}
+
+	if r.Quota < 0 {
+		errs = append(errs, errors.New("quota can't be negative"))
+	}
+	if r.TrustProxy && r.ProxyHops < 1 {
+		errs = append(errs, errors.New("proxy hops is not a positive integer"))
+	}
+
+	return errs
+}
+
+// Audit holds the configuration of the audit log
+type Audit struct {
+	Enabled   bool `yaml:"enabled" toml:"enabled" env:"AUDIT_ENABLED"`
+	QueueSize int  `yaml:"queueSize" toml:"queueSize" env:"AUDIT_QUEUE_SIZE"`
+}
+
+func (a *Audit) validate() (errs []error) {
+	if a.QueueSize < 1 {
+		errs = append(errs, errors.New("queue size is not a positive integer"))
+	}
+
+	return errs
+}
+
+// AccessLog holds the configuration of the access log
+type AccessLog struct {
+	Enabled bool `yaml:"enabled" toml:"enabled" env:"ACCESS_LOG_ENABLED"`
+}
+
+// Webhook holds the configuration of the webhook delivery
+type Webhook struct {
+	MaxAttempts int      `yaml:"maxAttempts" toml:"maxAttempts" env:"WEBHOOK_MAX_ATTEMPTS"`
+	Backoff     Duration `yaml:"backoff" toml:"backoff" env:"WEBHOOK_BACKOFF"`
+	Timeout     Duration `yaml:"timeout" toml:"timeout" env:"WEBHOOK_TIMEOUT"`
+	QueueSize   int      `yaml:"queueSize" toml:"queueSize" env:"WEBHOOK_QUEUE_SIZE"`
+
+	// Workers is the number of concurrent deliveries
+	Workers int `yaml:"workers" toml:"workers" env:"WEBHOOK_WORKERS"`
+
+	// AllowPrivate allows webhook URLs of loopback, link-local and private
+	// addresses, which are rejected otherwise
+	AllowPrivate bool `yaml:"allowPrivate" toml:"allowPrivate" env:"WEBHOOK_ALLOW_PRIVATE"`
+}
+
+func (w *Webhook) validate() (errs []error) {
+	if w.MaxAttempts < 1 {
+		errs = append(errs, errors.New("max attempts is not a positive integer"))
+	}
+	if w.Backoff.Duration < 0 {
+		errs = append(errs, errors.New("backoff can't be negative"))
+	}
+	if w.Timeout.Duration <= 0 {
+		errs = append(errs, errors.New("timeout must be positive"))
+	}
+	if w.QueueSize < 1 {
+		errs = append(errs, errors.New("queue size is not a positive integer"))
+	}
+	if w.Workers < 1 {
+		errs = append(errs, errors.New("workers is not a positive integer"))
+	}
+
+	return errs
+}
+
+// OIDC holds the configuration of the login through an OpenID Connect
+// provider, which is disabled without an issuer
+type OIDC struct {
+	Issuer        string   `yaml:"issuer" toml:"issuer" env:"OIDC_ISSUER"`
+	ClientID      string   `yaml:"clientID" toml:"clientID" env:"OIDC_CLIENT_ID"`
+	AutoProvision bool     `yaml:"autoProvision" toml:"autoProvision" env:"OIDC_AUTO_PROVISION"`
+	Roles         []string `yaml:"roles" toml:"roles" env:"OIDC_ROLES"`
+	Timeout       Duration `yaml:"timeout" toml:"timeout" env:"OIDC_TIMEOUT"`
+}
+
+func (o *OIDC) validate() (errs []error) {
+	if o.Issuer == "" {
+		return nil
+	}
+
+	if u, err := url.Parse(o.Issuer); err != nil || !u.IsAbs() {
+		errs = append(errs, fmt.Errorf("issuer %q is not valid", o.Issuer))
+	}
+
+	if o.ClientID == "" {
+		errs = append(errs, errors.New("client id is not set"))
+	}
+
+	if o.Timeout.Duration <= 0 {
+		errs = append(errs, errors.New("timeout must be positive"))
+	}
+
+	return errs
+}
+
+// Tracing holds the configuration of the OpenTelemetry tracing
+type Tracing struct {
+	Enabled bool `yaml:"enabled" toml:"enabled" env:"TRACING_ENABLED"`
+
+	// Without an endpoint, the OTEL_EXPORTER_OTLP_* variables apply and the
+	// exporter defaults to a local collector
+	Endpoint    string  `yaml:"endpoint" toml:"endpoint" env:"TRACING_ENDPOINT"`
+	Insecure    bool    `yaml:"insecure" toml:"insecure" env:"TRACING_INSECURE"`
+	SampleRatio float64 `yaml:"sampleRatio" toml:"sampleRatio" env:"TRACING_SAMPLE_RATIO"`
+}
+
+func (t *Tracing) validate() (errs []error) {
+	if t.SampleRatio < 0 || t.SampleRatio > 1 {
+		errs = append(errs, errors.New("sample ratio must be between 0 and 1"))
+	}
+
+	return errs
+}
+
+// Metrics holds the configuration of the Prometheus metrics
+type Metrics struct {
+	Enabled bool `yaml:"enabled" toml:"enabled" env:"METRICS_ENABLED"`
+}
+
+// GRPC holds the configuration of the gRPC server
+type GRPC struct {
+	Enabled     bool   `yaml:"enabled" toml:"enabled" env:"GRPC_ENABLED"`
+	Port        int    `yaml:"port" toml:"port" env:"GRPC_PORT"`
+	TLS         bool   `yaml:"tls" toml:"tls" env:"GRPC_TLS"`
+	Certificate string `yaml:"certificate" toml:"certificate" env:"GRPC_CERT"`
+	PrivateKey  string `yaml:"privateKey" toml:"privateKey" env:"GRPC_KEY"`
+}
+
+func (g *GRPC) validate() (errs []error) {
+	errs = append(errs, validatePort(g.Port)...)
+
+	if g.TLS {
+		if g.Certificate == "" {
+			errs = append(errs, errors.New("certificate missing"))
+		}
+		if g.PrivateKey == "" {
+			errs = append(errs, errors.New("private key missing"))
+		}
+	}
+
+	return errs
+}
+
+// Event holds the configuration of the event broker
+type Event struct {
+	// Broker is "memory" for events of a single instance or "mongo" for
+	// events of all instances
+	Broker      string `yaml:"broker" toml:"broker" env:"EVENT_BROKER"`
+	HistorySize int    `yaml:"historySize" toml:"historySize" env:"EVENT_HISTORY_SIZE"`
+}
+
+func (e *Event) validate() (errs []error) {
+	switch strings.ToLower(e.Broker) {
+	case "memory", "mongo":
+	default:
+		errs = append(errs, fmt.Errorf("broker %q is not one of memory or mongo", e.Broker))
+	}
+
+	if e.HistorySize < 0 {
+		errs = append(errs, errors.New("history size can't be negative"))
+	}
+
+	return errs
+}
+
+// Mail holds the configuration of the mail delivery
+type Mail struct {
+	// Driver is one of "log", "file" or "smtp"
+	Driver       string `yaml:"driver" toml:"driver" env:"MAIL_DRIVER"`
+	From         string `yaml:"from" toml:"from" env:"MAIL_FROM"`
+	File         string `yaml:"file" toml:"file" env:"MAIL_FILE"`
+	SMTPAddr     string `yaml:"smtpAddr" toml:"smtpAddr" env:"SMTP_ADDR"`
+	SMTPUser     string `yaml:"smtpUser" toml:"smtpUser" env:"SMTP_USER"`
+	SMTPPassword string `yaml:"smtpPassword" toml:"smtpPassword" env:"SMTP_PASSWORD" secret:"true"`
+
+	// LinkURL is a page of the client which submits the token of one-time
+	// links
+	LinkURL        string   `yaml:"linkURL" toml:"linkURL" env:"MAIL_LINK_URL"`
+	LinkExpiration Duration `yaml:"linkExpiration" toml:"linkExpiration" env:"MAIL_LINK_EXPIRATION"`
+}
+
+func (m *Mail) validate() (errs []error) {
+	switch m.Driver {
+	case "log":
+	case "file":
+		if m.File == "" {
+			errs = append(errs, errors.New("file is not set"))
+		}
+	case "smtp":
+		if m.SMTPAddr == "" {
+			errs = append(errs, errors.New("smtp address is not set"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("driver %q is not one of log, file or smtp", m.Driver))
+	}
+
+	if u, err := url.Parse(m.LinkURL); err != nil || !u.IsAbs() {
+		errs = append(errs, fmt.Errorf("link url %q is not valid", m.LinkURL))
+	}
+
+	if m.LinkExpiration.Duration <= 0 {
+		errs = append(errs, errors.New("link expiration must be positive"))
+	}
+
+	return errs
+}
+
+// Log holds the configuration of the log output
+type Log struct {
+	// Level is one of "debug", "info", "warning" or "error"
+	Level string `yaml:"level" toml:"level" env:"LOG_LEVEL"`
+
+	// Format is one of "text" or "json"
+	Format string `yaml:"format" toml:"format" env:"LOG_FORMAT"`
+}
+
+func (l *Log) validate() (errs []error) {
+	switch strings.ToLower(l.Level) {
+	case "debug", "info", "warning", "warn", "error":
+	default:
+		errs = append(errs, fmt.Errorf("level %q is not one of debug, info, warning or error", l.Level))
+	}
+
+	switch strings.ToLower(l.Format) {
+	case "text", "json":
+	default:
+		errs = append(errs, fmt.Errorf("format %q is not one of text or json", l.Format))
+	}
+
+	return errs
+}
+
+// Reload holds the configuration of the reloading of certificates and keys,
+// which is always triggered by SIGHUP
+type Reload struct {
+	// WatchInterval is the time between two checks of the files for changes,
+	// where zero disables watching
+	WatchInterval Duration `yaml:"watchInterval" toml:"watchInterval" env:"RELOAD_WATCH_INTERVAL"`
+}
+
+func (r *Reload) validate() (errs []error) {
+	if r.WatchInterval.Duration < 0 {
+		errs = append(errs, errors.New("watch interval can't be negative"))
+	}
+
+	return errs
+}
+
+// Cache holds the configuration of the response cache of the read endpoints
+type Cache struct {
+	Enabled bool `yaml:"enabled" toml:"enabled" env:"CACHE_ENABLED"`
+
+	// Size is the maximum size of all cached responses in bytes
+	Size int64 `yaml:"size" toml:"size" env:"CACHE_SIZE"`
+
+	// TTL is the time after which a response is dropped even if its data
+	// didn't change, which bounds the staleness caused by other instances
+	TTL Duration `yaml:"ttl" toml:"ttl" env:"CACHE_TTL"`
+
+	// MaxAge is the max-age of the Cache-Control header of cacheable
+	// responses, where zero requires clients to revalidate
+	MaxAge Duration `yaml:"maxAge" toml:"maxAge" env:"CACHE_MAX_AGE"`
+}
+
+func (c *Cache) validate() (errs []error) {
+	if c.Enabled && c.Size < 1 {
+		errs = append(errs, errors.New("size is too low"))
+	}
+	if c.Enabled && c.TTL.Duration <= 0 {
+		errs = append(errs, errors.New("ttl is too low"))
+	}
+	if c.MaxAge.Duration < 0 {
+		errs = append(errs, errors.New("max age can't be negative"))
+	}
+
+	return errs
+}
+
+// Migration holds the configuration of the schema migrations
+type Migration struct {
+	// OnStartup applies the pending migrations before the servers are started
+	OnStartup bool `yaml:"onStartup" toml:"onStartup" env:"MIGRATE_ON_STARTUP"`
+}
+
+func validatePort(port int) []error {
+	if port < 0 || port > 65535 {
+		return []error{fmt.Errorf("port %d is out of range", port)}
+	}
+
+	return nil
+}
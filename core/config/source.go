@@ -0,0 +1,131 @@
+package config
+
+import (
+	"bytes"
+	"encoding"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// decodeFile decodes a YAML or TOML file, depending on its extension, into c.
+// Unknown keys are rejected, so that typos don't go unnoticed.
+func decodeFile(path string, c *Config) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading config file failed: %w", err)
+	}
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(b))
+		dec.KnownFields(true)
+
+		if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("decoding config file failed: %w", err)
+		}
+	case ".toml":
+		md, err := toml.NewDecoder(bytes.NewReader(b)).Decode(c)
+		if err != nil {
+			return fmt.Errorf("decoding config file failed: %w", err)
+		}
+
+		if keys := md.Undecoded(); len(keys) > 0 {
+			return fmt.Errorf("decoding config file failed: unknown key %s", keys[0])
+		}
+	default:
+		return fmt.Errorf("config file format %q is not supported", ext)
+	}
+
+	return nil
+}
+
+// applyEnv overrides the fields of c with the environment variables named by
+// their "env" tag. Lists are separated by commas.
+func applyEnv(c *Config) error {
+	var errs []error
+
+	walk(reflect.ValueOf(c).Elem(), func(f reflect.Value, sf reflect.StructField) {
+		name := sf.Tag.Get("env")
+		if name == "" {
+			return
+		}
+
+		env, ok := os.LookupEnv(name)
+		if !ok || (env == "" && f.Kind() != reflect.Slice) {
+			return
+		}
+
+		if err := setValue(f, env); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	})
+
+	return errors.Join(errs...)
+}
+
+// walk calls fn for every field of the nested structs of v which has no
+// fields of its own
+func walk(v reflect.Value, fn func(reflect.Value, reflect.StructField)) {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		f, sf := v.Field(i), t.Field(i)
+
+		if _, ok := f.Addr().Interface().(encoding.TextUnmarshaler); !ok && f.Kind() == reflect.Struct {
+			walk(f, fn)
+			continue
+		}
+
+		fn(f, sf)
+	}
+}
+
+func setValue(f reflect.Value, s string) error {
+	if u, ok := f.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+
+	switch f.Kind() {
+	case reflect.String:
+		f.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return errors.New("not a boolean")
+		}
+		f.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return errors.New("not an integer")
+		}
+		f.SetInt(i)
+	case reflect.Float64:
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return errors.New("not a number")
+		}
+		f.SetFloat(n)
+	case reflect.Slice:
+		var l []string
+		for _, v := range strings.Split(s, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				l = append(l, v)
+			}
+		}
+		f.Set(reflect.ValueOf(l))
+	default:
+		return fmt.Errorf("unsupported type %s", f.Type())
+	}
+
+	return nil
+}
@@ -5,81 +5,22 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
-	"log"
 	"os"
-	"strconv"
-	"strings"
+
+	"github.com/tarkov-database/rest-api/core/config"
 
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-var cfg *config
-
-func init() {
-	var err error
-
-	cfg, err = newConfig()
-	if err != nil {
-		log.Printf("Configuration error: %s\n", err)
-		os.Exit(2)
-	}
-}
-
-type config struct {
-	URI         string
-	Database    string
-	TLS         bool
-	Certificate string
-	PrivateKey  string
-	RootCA      string
-}
-
-func newConfig() (*config, error) {
-	c := &config{}
-
-	if env := os.Getenv("MONGO_URI"); len(env) > 0 {
-		if !strings.HasPrefix(env, "mongodb://") && !strings.HasPrefix(env, "mongodb+srv://") {
-			return c, errors.New("mongo uri invalid")
-		}
-		c.URI = env
-	} else {
-		return c, errors.New("mongo uri not set")
-	}
-
-	if env := os.Getenv("MONGO_DB"); len(env) > 0 {
-		c.Database = env
-	} else {
-		return c, errors.New("mongo database not set")
-	}
-
-	if env := os.Getenv("MONGO_TLS"); len(env) > 0 {
-		if b, err := strconv.ParseBool(env); err == nil {
-			c.TLS = b
-		} else {
-			return c, errors.New("invalid boolean in environment variable")
-		}
-	}
-
-	if c.TLS {
-		if env := os.Getenv("MONGO_CA"); len(env) > 0 {
-			c.RootCA = env
-		}
-
-		if env := os.Getenv("MONGO_CERT"); len(env) > 0 {
-			c.Certificate = env
-
-			if env := os.Getenv("MONGO_KEY"); len(env) > 0 {
-				c.PrivateKey = env
-			} else {
-				return c, errors.New("mongo database not set")
-			}
-		}
-	}
+var cfg = &config.Default().Database
 
-	return c, nil
+// Configure applies the configuration to the connection. It has to be called
+// before Init.
+func Configure(c config.Database) {
+	cfg = &c
 }
 
-func (c *config) getClientOptions() (*options.ClientOptions, error) {
+func getClientOptions(c *config.Database) (*options.ClientOptions, error) {
 	opts := options.Client()
 	opts.ApplyURI(c.URI)
 
@@ -92,7 +33,7 @@ func (c *config) getClientOptions() (*options.ClientOptions, error) {
 		}
 
 		if certAuth {
-			clientCert, rootCAs, err := c.getTLSCertificate()
+			clientCert, rootCAs, err := getTLSCertificate(c)
 			if err != nil {
 				return opts, fmt.Errorf("certificate loading error: %s", err)
 			}
@@ -111,7 +52,7 @@ func (c *config) getClientOptions() (*options.ClientOptions, error) {
 	return opts, nil
 }
 
-func (c *config) getTLSCertificate() (tls.Certificate, *x509.CertPool, error) {
+func getTLSCertificate(c *config.Database) (tls.Certificate, *x509.CertPool, error) {
 	var clientCert tls.Certificate
 	var rootCAs *x509.CertPool
 
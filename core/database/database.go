@@ -5,21 +5,57 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/google/logger"
+	"github.com/tarkov-database/rest-api/core/logger"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
 var db *mongo.Database
 
+var monitors []*event.CommandMonitor
+
+// AddMonitor adds a monitor of the commands issued by the client.
+// It must be called before Init.
+func AddMonitor(m *event.CommandMonitor) {
+	monitors = append(monitors, m)
+}
+
+// commandMonitor returns a monitor notifying all added monitors
+func commandMonitor() *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, e *event.CommandStartedEvent) {
+			for _, m := range monitors {
+				m.Started(ctx, e)
+			}
+		},
+		Succeeded: func(ctx context.Context, e *event.CommandSucceededEvent) {
+			for _, m := range monitors {
+				m.Succeeded(ctx, e)
+			}
+		},
+		Failed: func(ctx context.Context, e *event.CommandFailedEvent) {
+			for _, m := range monitors {
+				m.Failed(ctx, e)
+			}
+		},
+	}
+}
+
 // Init initiate the MongoDB connection
 func Init() error {
 	logger.Info("Initiate MongoDB connection\n")
 
-	clientOptions, err := cfg.getClientOptions()
+	clientOptions, err := getClientOptions(cfg)
 	if err != nil {
 		return fmt.Errorf("options error: %s", err)
 	}
 
+	if len(monitors) > 0 {
+		clientOptions.SetMonitor(commandMonitor())
+	}
+
 	client, err := mongo.NewClient(clientOptions)
 	if err != nil {
 		return fmt.Errorf("client error: %s", err)
@@ -77,3 +113,29 @@ func Ping(ctx context.Context) error {
 func GetDB() *mongo.Database {
 	return db
 }
+
+// Indexes returns the names of the indexes of each collection
+func Indexes(ctx context.Context) (map[string][]string, error) {
+	names, err := db.ListCollectionNames(ctx, bson.D{})
+	if err != nil {
+		return nil, fmt.Errorf("listing collections error: %s", err)
+	}
+
+	indexes := make(map[string][]string, len(names))
+
+	for _, name := range names {
+		specs, err := db.Collection(name).Indexes().ListSpecifications(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing indexes of %s error: %s", name, err)
+		}
+
+		list := make([]string, len(specs))
+		for i, s := range specs {
+			list[i] = s.Name
+		}
+
+		indexes[name] = list
+	}
+
+	return indexes, nil
+}
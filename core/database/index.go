@@ -0,0 +1,299 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Index describes an index which is required by the queries of a model
+type Index struct {
+	Collection string
+
+	// Name defaults to the name MongoDB derives from the keys
+	Name string
+
+	// Keys holds the fields in order with their type, which is 1 or -1 for
+	// ascending or descending, "text" or "2dsphere"
+	Keys   bson.D
+	Unique bool
+	Sparse bool
+
+	// Requires describes the documents the index can be built on, which is
+	// reported if its creation fails
+	Requires string
+}
+
+// IndexState describes the state of an index in the database
+type IndexState string
+
+// Represents the states of an index
+const (
+	// IndexPresent indicates that a declared index exists as declared
+	IndexPresent IndexState = "present"
+
+	// IndexMissing indicates that a declared index does not exist
+	IndexMissing IndexState = "missing"
+
+	// IndexCreated indicates that a missing index has been created
+	IndexCreated IndexState = "created"
+
+	// IndexChanged indicates that an index exists with the name of a declared
+	// index, but with different keys or options
+	IndexChanged IndexState = "changed"
+
+	// IndexUnknown indicates that an index exists which is not declared
+	IndexUnknown IndexState = "unknown"
+)
+
+// IndexStatus describes an index and its state
+type IndexStatus struct {
+	Collection string     `json:"collection"`
+	Name       string     `json:"name"`
+	Keys       string     `json:"keys"`
+	Unique     bool       `json:"unique,omitempty"`
+	Sparse     bool       `json:"sparse,omitempty"`
+	State      IndexState `json:"state"`
+}
+
+// Drifted reports whether the index deviates from its declaration
+func (s *IndexStatus) Drifted() bool {
+	return s.State != IndexPresent && s.State != IndexCreated
+}
+
+var indexes []Index
+
+// RegisterIndexes declares indexes which are required by a model.
+// It must be called before EnsureIndexes.
+func RegisterIndexes(idx ...Index) {
+	for _, i := range idx {
+		if i.Name == "" {
+			i.Name = indexName(i.Keys)
+		}
+		indexes = append(indexes, i)
+	}
+}
+
+// DeclaredIndexes returns all declared indexes
+func DeclaredIndexes() []Index {
+	return append([]Index(nil), indexes...)
+}
+
+// indexSpec is the specification of an existing index
+type indexSpec struct {
+	Name    string `bson:"name"`
+	Key     bson.D `bson:"key"`
+	Unique  bool   `bson:"unique"`
+	Sparse  bool   `bson:"sparse"`
+	Weights bson.D `bson:"weights"`
+}
+
+// keys returns the keys of the specification as declared, where the
+// internal keys of a text index are replaced by the weighted fields
+func (s *indexSpec) keys() bson.D {
+	if s.Weights == nil {
+		return s.Key
+	}
+
+	var keys bson.D
+	for _, k := range s.Key {
+		switch k.Key {
+		case "_fts":
+			for _, w := range s.Weights {
+				keys = append(keys, bson.E{Key: w.Key, Value: "text"})
+			}
+		case "_ftsx":
+		default:
+			keys = append(keys, k)
+		}
+	}
+
+	return keys
+}
+
+// CheckIndexes compares the declared indexes with the existing indexes of
+// their collections
+func CheckIndexes(ctx context.Context) ([]IndexStatus, error) {
+	existing := make(map[string][]indexSpec)
+
+	for _, i := range indexes {
+		if _, ok := existing[i.Collection]; ok {
+			continue
+		}
+
+		specs, err := listIndexes(ctx, i.Collection)
+		if err != nil {
+			return nil, err
+		}
+		existing[i.Collection] = specs
+	}
+
+	return compareIndexes(indexes, existing), nil
+}
+
+// EnsureIndexes creates all missing indexes and returns the state of all
+// indexes. Changed indexes are left as they are, since they can only be
+// replaced by dropping them.
+func EnsureIndexes(ctx context.Context) ([]IndexStatus, error) {
+	status, err := CheckIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	declared := make(map[string]Index, len(indexes))
+	for _, i := range indexes {
+		declared[i.Collection+"."+i.Name] = i
+	}
+
+	var errs []error
+
+	for n, s := range status {
+		if s.State != IndexMissing {
+			continue
+		}
+
+		i := declared[s.Collection+"."+s.Name]
+
+		opts := options.Index().SetName(i.Name)
+		if i.Unique {
+			opts.SetUnique(true)
+		}
+		if i.Sparse {
+			opts.SetSparse(true)
+		}
+
+		model := mongo.IndexModel{Keys: i.Keys, Options: opts}
+		if _, err := db.Collection(i.Collection).Indexes().CreateOne(ctx, model); err != nil {
+			if i.Requires != "" {
+				err = fmt.Errorf("%s (%s)", err, i.Requires)
+			}
+			errs = append(errs, fmt.Errorf("creating index %s of %s error: %s", i.Name, i.Collection, err))
+			continue
+		}
+
+		status[n].State = IndexCreated
+	}
+
+	return status, errors.Join(errs...)
+}
+
+func listIndexes(ctx context.Context, collection string) ([]indexSpec, error) {
+	cur, err := db.Collection(collection).Indexes().List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing indexes of %s error: %s", collection, err)
+	}
+
+	var specs []indexSpec
+	if err := cur.All(ctx, &specs); err != nil {
+		return nil, fmt.Errorf("listing indexes of %s error: %s", collection, err)
+	}
+
+	return specs, nil
+}
+
+// compareIndexes returns the state of the declared indexes and of the
+// undeclared indexes of their collections, in order of the collections
+func compareIndexes(declared []Index, existing map[string][]indexSpec) []IndexStatus {
+	var status []IndexStatus
+	var collections []string
+
+	byName := make(map[string]map[string]*Index)
+
+	for n := range declared {
+		i := &declared[n]
+
+		if _, ok := byName[i.Collection]; !ok {
+			byName[i.Collection] = make(map[string]*Index)
+			collections = append(collections, i.Collection)
+		}
+		byName[i.Collection][i.Name] = i
+	}
+
+	sort.Strings(collections)
+
+	for _, c := range collections {
+		specs := make(map[string]*indexSpec)
+		for n := range existing[c] {
+			s := &existing[c][n]
+			specs[s.Name] = s
+
+			if _, ok := byName[c][s.Name]; !ok && s.Name != "_id_" {
+				status = append(status, IndexStatus{
+					Collection: c,
+					Name:       s.Name,
+					Keys:       formatKeys(s.keys()),
+					Unique:     s.Unique,
+					Sparse:     s.Sparse,
+					State:      IndexUnknown,
+				})
+			}
+		}
+
+		for _, i := range declared {
+			if i.Collection != c {
+				continue
+			}
+
+			st := IndexStatus{
+				Collection: c,
+				Name:       i.Name,
+				Keys:       formatKeys(i.Keys),
+				Unique:     i.Unique,
+				Sparse:     i.Sparse,
+				State:      IndexPresent,
+			}
+
+			switch s, ok := specs[i.Name]; {
+			case !ok:
+				st.State = IndexMissing
+			case formatKeys(s.keys()) != st.Keys, s.Unique != i.Unique, s.Sparse != i.Sparse:
+				st.State = IndexChanged
+			}
+
+			status = append(status, st)
+		}
+	}
+
+	return status
+}
+
+// formatKeys returns the keys in the form "field: type, ...", where
+// adjacent text fields are sorted since their order is not kept
+func formatKeys(keys bson.D) string {
+	parts := make([]string, len(keys))
+	text := -1
+
+	for n, k := range keys {
+		v := fmt.Sprint(k.Value)
+		parts[n] = k.Key + ": " + v
+
+		switch {
+		case v == "text" && text < 0:
+			text = n
+		case v != "text" && text >= 0:
+			sort.Strings(parts[text:n])
+			text = -1
+		}
+	}
+	if text >= 0 {
+		sort.Strings(parts[text:])
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// indexName returns the name MongoDB derives from the keys of an index
+func indexName(keys bson.D) string {
+	parts := make([]string, 0, 2*len(keys))
+	for _, k := range keys {
+		parts = append(parts, k.Key, fmt.Sprint(k.Value))
+	}
+
+	return strings.Join(parts, "_")
+}
@@ -0,0 +1,71 @@
+package database
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestIndexName(t *testing.T) {
+	tests := []struct {
+		keys bson.D
+		name string
+	}{
+		{bson.D{{Key: "_kind", Value: 1}, {Key: "_modified", Value: -1}}, "_kind_1__modified_-1"},
+		{bson.D{{Key: "name", Value: "text"}, {Key: "description", Value: "text"}}, "name_text_description_text"},
+		{bson.D{{Key: "geometry", Value: "2dsphere"}}, "geometry_2dsphere"},
+	}
+
+	for _, tt := range tests {
+		if name := indexName(tt.keys); name != tt.name {
+			t.Errorf("Name of %v is %q, expected %q", tt.keys, name, tt.name)
+		}
+	}
+}
+
+func TestCompareIndexes(t *testing.T) {
+	declared := []Index{
+		{Collection: "items", Name: "_kind_1", Keys: bson.D{{Key: "_kind", Value: 1}}},
+		{Collection: "items", Name: "text", Keys: bson.D{
+			{Key: "name", Value: "text"},
+			{Key: "description", Value: "text"},
+		}},
+		{Collection: "items", Name: "missing", Keys: bson.D{{Key: "name", Value: 1}}},
+		{Collection: "users", Name: "email_1", Keys: bson.D{{Key: "email", Value: 1}}, Unique: true},
+	}
+
+	existing := map[string][]indexSpec{
+		"items": {
+			{Name: "_id_", Key: bson.D{{Key: "_id", Value: int32(1)}}},
+			{Name: "_kind_1", Key: bson.D{{Key: "_kind", Value: float64(1)}}},
+			{
+				Name:    "text",
+				Key:     bson.D{{Key: "_fts", Value: "text"}, {Key: "_ftsx", Value: int32(1)}},
+				Weights: bson.D{{Key: "description", Value: int32(1)}, {Key: "name", Value: int32(1)}},
+			},
+			{Name: "manual", Key: bson.D{{Key: "weight", Value: int32(-1)}}},
+		},
+		"users": {
+			{Name: "email_1", Key: bson.D{{Key: "email", Value: int32(1)}}},
+		},
+	}
+
+	expected := map[string]IndexState{
+		"items._kind_1": IndexPresent,
+		"items.text":    IndexPresent,
+		"items.missing": IndexMissing,
+		"items.manual":  IndexUnknown,
+		"users.email_1": IndexChanged,
+	}
+
+	status := compareIndexes(declared, existing)
+	if len(status) != len(expected) {
+		t.Fatalf("Comparison returned %v, expected %v indexes", status, len(expected))
+	}
+
+	for _, s := range status {
+		if state := expected[s.Collection+"."+s.Name]; s.State != state {
+			t.Errorf("State of %s.%s is %q, expected %q", s.Collection, s.Name, s.State, state)
+		}
+	}
+}
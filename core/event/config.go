@@ -0,0 +1,21 @@
+package event
+
+import (
+	"strings"
+
+	"github.com/tarkov-database/rest-api/core/config"
+)
+
+const (
+	brokerMemory = "memory"
+	brokerMongo  = "mongo"
+)
+
+var cfg = &config.Default().Event
+
+// Configure applies the configuration to the event broker. It has to be
+// called before Init.
+func Configure(c config.Event) {
+	c.Broker = strings.ToLower(c.Broker)
+	cfg = &c
+}
@@ -0,0 +1,209 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/model"
+)
+
+var (
+	// ErrInvalidEventID indicates that an event ID used for resuming is not valid
+	ErrInvalidEventID = errors.New("invalid event id")
+)
+
+// Operation represents the type of change of an event
+type Operation string
+
+const (
+	// OperationCreate is used when an entity was created
+	OperationCreate Operation = "create"
+
+	// OperationUpdate is used when an entity was replaced
+	OperationUpdate Operation = "update"
+
+	// OperationDelete is used when an entity was removed
+	OperationDelete Operation = "delete"
+)
+
+// Resource represents the resource group an event belongs to
+type Resource string
+
+const (
+	// ResourceItem represents item entities
+	ResourceItem Resource = "item"
+
+	// ResourceHideout represents hideout module and production entities
+	ResourceHideout Resource = "hideout"
+
+	// ResourceLocation represents location, feature and feature group entities
+	ResourceLocation Resource = "location"
+
+	// ResourceStatistic represents statistic entities
+	ResourceStatistic Resource = "statistic"
+)
+
+// ResourceList holds all resources
+var ResourceList = [...]Resource{
+	ResourceItem,
+	ResourceHideout,
+	ResourceLocation,
+	ResourceStatistic,
+}
+
+// IsValid checks if a resource is valid
+func (r Resource) IsValid() bool {
+	for _, v := range ResourceList {
+		if r == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Event describes a change of an entity
+type Event struct {
+	ID        string          `json:"-" bson:"id"`
+	Operation Operation       `json:"operation" bson:"operation"`
+	Resource  Resource        `json:"resource" bson:"resource"`
+	Kind      string          `json:"kind,omitempty" bson:"kind,omitempty"`
+	Document  string          `json:"id" bson:"document"`
+	Time      model.Timestamp `json:"time" bson:"time"`
+}
+
+// Filter describes which events are passed to a subscriber
+type Filter struct {
+	Resources []Resource
+	Kinds     []string
+}
+
+// Match checks if an event matches the filter.
+// Events without a kind are not excluded by the kind filter.
+func (f *Filter) Match(e *Event) bool {
+	if f == nil {
+		return true
+	}
+
+	if len(f.Resources) > 0 {
+		var ok bool
+		for _, r := range f.Resources {
+			if r == e.Resource {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	if len(f.Kinds) > 0 && e.Kind != "" {
+		var ok bool
+		for _, k := range f.Kinds {
+			if k == e.Kind {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Broker describes the interface of an event backend
+type Broker interface {
+	// Publish distributes an event to all subscribers
+	Publish(e *Event)
+
+	// Subscribe returns a channel of events matching the filter.
+	// If lastID is set, events after the given ID are delivered first.
+	// The channel is closed as soon as the context is done.
+	Subscribe(ctx context.Context, f *Filter, lastID string) (<-chan *Event, error)
+}
+
+// Handler is called for every event published by this instance.
+// Handlers must not block.
+type Handler func(e *Event)
+
+var (
+	broker   Broker
+	handlers []Handler
+	mu       sync.Mutex
+)
+
+// Init initiates the configured event broker
+func Init() error {
+	if cfg.Broker != brokerMongo {
+		return nil
+	}
+
+	logger.Info("Using MongoDB change streams as event source\n")
+
+	SetBroker(newMongoBroker())
+
+	return nil
+}
+
+// SetBroker replaces the active event broker
+func SetBroker(b Broker) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	broker = b
+}
+
+// AddHandler registers a handler for published events
+func AddHandler(h Handler) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	handlers = append(handlers, h)
+}
+
+func getHandlers() []Handler {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return handlers
+}
+
+func getBroker() Broker {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if broker == nil {
+		broker = newMemoryBroker(cfg.HistorySize)
+	}
+
+	return broker
+}
+
+// Publish creates a new event and passes it to the active broker
+// and all registered handlers
+func Publish(op Operation, res Resource, kind string, id model.ObjectID) {
+	e := &Event{
+		Operation: op,
+		Resource:  res,
+		Kind:      kind,
+		Document:  id.Hex(),
+		Time:      model.Timestamp{Time: time.Now()},
+	}
+
+	getBroker().Publish(e)
+
+	for _, h := range getHandlers() {
+		h(e)
+	}
+}
+
+// Subscribe subscribes to events of the active broker
+func Subscribe(ctx context.Context, f *Filter, lastID string) (<-chan *Event, error) {
+	return getBroker().Subscribe(ctx, f, lastID)
+}
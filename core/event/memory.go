@@ -0,0 +1,110 @@
+package event
+
+import (
+	"context"
+	"strconv"
+	"sync"
+)
+
+const subscriberBufferSize = 64
+
+type subscriber struct {
+	ch     chan *Event
+	filter *Filter
+}
+
+type memoryBroker struct {
+	seq     uint64
+	history []*Event
+	size    int
+	subs    map[*subscriber]struct{}
+	sync.Mutex
+}
+
+func newMemoryBroker(size int) *memoryBroker {
+	return &memoryBroker{
+		history: make([]*Event, 0, size),
+		size:    size,
+		subs:    make(map[*subscriber]struct{}),
+	}
+}
+
+// Publish implements the Broker interface
+func (b *memoryBroker) Publish(e *Event) {
+	b.Lock()
+	defer b.Unlock()
+
+	b.seq++
+	e.ID = strconv.FormatUint(b.seq, 10)
+
+	if b.size > 0 {
+		if len(b.history) == b.size {
+			copy(b.history, b.history[1:])
+			b.history = b.history[:b.size-1]
+		}
+		b.history = append(b.history, e)
+	}
+
+	for s := range b.subs {
+		if !s.filter.Match(e) {
+			continue
+		}
+
+		select {
+		case s.ch <- e:
+		default:
+			// Drop subscribers that can't keep up
+			delete(b.subs, s)
+			close(s.ch)
+		}
+	}
+}
+
+// Subscribe implements the Broker interface
+func (b *memoryBroker) Subscribe(ctx context.Context, f *Filter, lastID string) (<-chan *Event, error) {
+	var last uint64
+	if lastID != "" {
+		i, err := strconv.ParseUint(lastID, 10, 64)
+		if err != nil {
+			return nil, ErrInvalidEventID
+		}
+		last = i
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	var replay []*Event
+	if lastID != "" {
+		for _, e := range b.history {
+			if id, _ := strconv.ParseUint(e.ID, 10, 64); id > last && f.Match(e) {
+				replay = append(replay, e)
+			}
+		}
+	}
+
+	s := &subscriber{
+		ch:     make(chan *Event, len(replay)+subscriberBufferSize),
+		filter: f,
+	}
+
+	for _, e := range replay {
+		s.ch <- e
+	}
+
+	b.subs[s] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+
+		b.Lock()
+		defer b.Unlock()
+
+		if _, ok := b.subs[s]; ok {
+			delete(b.subs, s)
+			close(s.ch)
+		}
+	}()
+
+	return s.ch, nil
+}
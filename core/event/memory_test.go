@@ -0,0 +1,76 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func receive(t *testing.T, ch <-chan *Event) *Event {
+	t.Helper()
+
+	select {
+	case e := <-ch:
+		return e
+	case <-time.After(time.Second):
+		t.Fatal("Receiving event failed: timeout")
+	}
+
+	return nil
+}
+
+func TestMemoryBrokerFilter(t *testing.T) {
+	b := newMemoryBroker(10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	f := &Filter{Resources: []Resource{ResourceItem}, Kinds: []string{"ammunition"}}
+
+	ch, err := b.Subscribe(ctx, f, "")
+	if err != nil {
+		t.Fatalf("Subscribing failed: %s", err)
+	}
+
+	b.Publish(&Event{Resource: ResourceLocation, Kind: "location", Document: primitive.NewObjectID().Hex()})
+	b.Publish(&Event{Resource: ResourceItem, Kind: "armor", Document: primitive.NewObjectID().Hex()})
+	b.Publish(&Event{Resource: ResourceItem, Kind: "ammunition", Document: primitive.NewObjectID().Hex()})
+
+	if e := receive(t, ch); e.Kind != "ammunition" || e.ID != "3" {
+		t.Errorf("Filtering events failed: unexpected event %v", e)
+	}
+
+	cancel()
+
+	if _, ok := <-ch; ok {
+		t.Error("Unsubscribing failed: channel not closed")
+	}
+}
+
+func TestMemoryBrokerResume(t *testing.T) {
+	b := newMemoryBroker(2)
+
+	for i := 0; i < 3; i++ {
+		b.Publish(&Event{Resource: ResourceItem, Document: primitive.NewObjectID().Hex()})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := b.Subscribe(ctx, &Filter{}, "1")
+	if err != nil {
+		t.Fatalf("Subscribing failed: %s", err)
+	}
+
+	for _, id := range []string{"2", "3"} {
+		if e := receive(t, ch); e.ID != id {
+			t.Errorf("Resuming failed: expected event %s, got %s", id, e.ID)
+		}
+	}
+
+	if _, err := b.Subscribe(ctx, &Filter{}, "invalid"); err != ErrInvalidEventID {
+		t.Errorf("Resuming failed: expected error %v, got %v", ErrInvalidEventID, err)
+	}
+}
@@ -0,0 +1,183 @@
+package event
+
+import (
+	"context"
+	"encoding/base64"
+	"sync"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Source describes how changes of a collection are mapped to events
+type Source struct {
+	Collection string
+	Resource   Resource
+
+	// Kind is used as event kind if set
+	Kind string
+
+	// KindField is the document field which holds the event kind
+	KindField string
+}
+
+var (
+	sources   = make(map[string]Source)
+	sourcesMu sync.RWMutex
+)
+
+// RegisterSource registers a collection as event source for change streams
+func RegisterSource(s Source) {
+	sourcesMu.Lock()
+	defer sourcesMu.Unlock()
+
+	sources[s.Collection] = s
+}
+
+func getSource(coll string) (Source, bool) {
+	sourcesMu.RLock()
+	defer sourcesMu.RUnlock()
+
+	s, ok := sources[coll]
+
+	return s, ok
+}
+
+func getCollections(f *Filter) bson.A {
+	sourcesMu.RLock()
+	defer sourcesMu.RUnlock()
+
+	colls := bson.A{}
+	for _, s := range sources {
+		if f.Match(&Event{Resource: s.Resource}) {
+			colls = append(colls, s.Collection)
+		}
+	}
+
+	return colls
+}
+
+type changeDocument struct {
+	ID            bson.Raw            `bson:"_id"`
+	OperationType string              `bson:"operationType"`
+	ClusterTime   primitive.Timestamp `bson:"clusterTime"`
+	Namespace     struct {
+		Collection string `bson:"coll"`
+	} `bson:"ns"`
+	DocumentKey struct {
+		ID model.ObjectID `bson:"_id"`
+	} `bson:"documentKey"`
+	FullDocument bson.Raw `bson:"fullDocument"`
+}
+
+func (d *changeDocument) toEvent() (*Event, bool) {
+	src, ok := getSource(d.Namespace.Collection)
+	if !ok {
+		return nil, false
+	}
+
+	e := &Event{
+		ID:       base64.RawURLEncoding.EncodeToString(d.ID),
+		Resource: src.Resource,
+		Kind:     src.Kind,
+		Document: d.DocumentKey.ID.Hex(),
+		Time:     model.Timestamp{Time: time.Unix(int64(d.ClusterTime.T), 0)},
+	}
+
+	switch d.OperationType {
+	case "insert":
+		e.Operation = OperationCreate
+	case "replace", "update":
+		e.Operation = OperationUpdate
+	case "delete":
+		e.Operation = OperationDelete
+	default:
+		return nil, false
+	}
+
+	if src.KindField != "" && d.FullDocument != nil {
+		if v, ok := d.FullDocument.Lookup(src.KindField).StringValueOK(); ok {
+			e.Kind = v
+		}
+	}
+
+	return e, true
+}
+
+// mongoBroker uses MongoDB change streams as event source.
+// The database writes are the events itself, so publishing is a no-op.
+// The kind of deleted documents is not known, as no pre-images are used.
+type mongoBroker struct{}
+
+func newMongoBroker() *mongoBroker {
+	return &mongoBroker{}
+}
+
+// Publish implements the Broker interface
+func (b *mongoBroker) Publish(_ *Event) {}
+
+// Subscribe implements the Broker interface
+func (b *mongoBroker) Subscribe(ctx context.Context, f *Filter, lastID string) (<-chan *Event, error) {
+	opts := options.ChangeStream()
+	opts.SetFullDocument(options.UpdateLookup)
+
+	if lastID != "" {
+		token, err := base64.RawURLEncoding.DecodeString(lastID)
+		if err != nil {
+			return nil, ErrInvalidEventID
+		}
+		opts.SetResumeAfter(bson.Raw(token))
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"ns.coll":       bson.M{"$in": getCollections(f)},
+			"operationType": bson.M{"$in": bson.A{"insert", "replace", "update", "delete"}},
+		}}},
+	}
+
+	stream, err := database.GetDB().Watch(ctx, pipeline, opts)
+	if err != nil {
+		logger.Error(err)
+		return nil, model.MongoToAPIError(err)
+	}
+
+	ch := make(chan *Event, subscriberBufferSize)
+
+	go func() {
+		defer close(ch)
+		defer stream.Close(context.Background())
+
+		for stream.Next(ctx) {
+			doc := &changeDocument{}
+			if err := stream.Decode(doc); err != nil {
+				logger.Error(err)
+				return
+			}
+
+			e, ok := doc.toEvent()
+			if !ok || !f.Match(e) {
+				continue
+			}
+
+			select {
+			case ch <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if err := stream.Err(); err != nil && ctx.Err() == nil {
+			logger.Error(err)
+		}
+	}()
+
+	return ch, nil
+}
@@ -0,0 +1,191 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/model/hideout/module"
+	"github.com/tarkov-database/rest-api/model/hideout/production"
+	"github.com/tarkov-database/rest-api/model/item"
+	"github.com/tarkov-database/rest-api/model/location/feature"
+	"github.com/tarkov-database/rest-api/model/location/featuregroup"
+	"github.com/tarkov-database/rest-api/model/statistic/ammunition/armor"
+	"github.com/tarkov-database/rest-api/model/statistic/ammunition/distance"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+)
+
+var (
+	// ErrUnauthorized indicates that the request has no valid token
+	ErrUnauthorized = errors.New("no valid token")
+
+	// ErrNotInitialized indicates that the schema has not been built
+	ErrNotInitialized = errors.New("schema is not initialized")
+)
+
+var schema *graphql.Schema
+
+// Request represents a GraphQL request
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// Init builds the GraphQL schema
+func Init() error {
+	s, err := NewSchema()
+	if err != nil {
+		return err
+	}
+
+	schema = s
+
+	return nil
+}
+
+// Do executes the request with the claims of the given context
+func Do(ctx context.Context, req *Request) *graphql.Result {
+	if schema == nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(ErrNotInitialized)}
+	}
+
+	return graphql.Do(graphql.Params{
+		Schema:         *schema,
+		RequestString:  req.Query,
+		OperationName:  req.OperationName,
+		VariableValues: req.Variables,
+		Context:        withLoader(ctx),
+	})
+}
+
+// NewSchema builds the GraphQL schema of all models
+func NewSchema() (*graphql.Schema, error) {
+	b := newBuilder()
+
+	b.scalar(model.ObjectID{}, ObjectID)
+	b.scalar(model.Timestamp{}, Timestamp)
+	b.scalar(feature.GeometryType(0), graphql.String)
+	b.scalar(feature.Coordinates{}, JSON)
+	b.scalar(map[string]interface{}{}, JSON)
+
+	b.name(item.Item{}, "CommonItem")
+	b.name(module.Ref{}, "ModuleRef")
+	b.name(module.ItemRef{}, "ModuleItemRef")
+	b.name(production.ModuleRef{}, "ProductionModuleRef")
+	b.name(production.ItemRef{}, "ProductionItemRef")
+	b.name(featuregroup.Group{}, "FeatureGroup")
+	b.name(armor.ItemRef{}, "ArmorItemRef")
+	b.name(armor.Statistics{}, "ArmorStatistics")
+
+	itemInterface := graphql.NewInterface(graphql.InterfaceConfig{
+		Name:        "Item",
+		Description: "Common fields of all item kinds",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return b.fields(typeOf(item.Item{}))
+		}),
+		ResolveType: func(p graphql.ResolveTypeParams) *graphql.Object {
+			return b.objects[typeOf(p.Value)]
+		},
+	})
+
+	b.convert(item.List{}, &converter{
+		output: graphql.NewList(itemInterface),
+		resolve: func(p graphql.ResolveParams, v interface{}) (interface{}, error) {
+			return loaderFromContext(p.Context).loadList(v.(item.List)), nil
+		},
+	})
+
+	for _, ref := range []interface{}{module.ItemRef{}, production.ItemRef{}, armor.ItemRef{}} {
+		b.extend(ref, graphql.Fields{"item": itemRefField(itemInterface)})
+	}
+
+	b.extend(distance.AmmoDistanceStatistics{}, graphql.Fields{
+		"ammunition": ammunitionField(itemInterface, func(src interface{}) model.ObjectID {
+			return src.(*distance.AmmoDistanceStatistics).Reference
+		}),
+	})
+	b.extend(armor.AmmoArmorStatistics{}, graphql.Fields{
+		"ammunition": ammunitionField(itemInterface, func(src interface{}) model.ObjectID {
+			return src.(*armor.AmmoArmorStatistics).Ammo
+		}),
+	})
+
+	var kinds []graphql.Type
+	for _, k := range item.KindList {
+		e, err := k.GetEntity()
+		if err != nil {
+			return nil, err
+		}
+
+		b.implement(e, itemInterface)
+		kinds = append(kinds, b.object(e))
+	}
+
+	query := newQuery(b, itemInterface)
+
+	s, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: query,
+		Types: kinds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	return &s, nil
+}
+
+// itemRefField returns a field resolving an item reference with an ID and kind to the item
+func itemRefField(itemInterface *graphql.Interface) *graphql.Field {
+	return &graphql.Field{
+		Type: itemInterface,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			v := reflect.Indirect(reflect.ValueOf(p.Source))
+
+			id, ok := v.FieldByName("ID").Interface().(model.ObjectID)
+			if !ok {
+				return nil, nil
+			}
+
+			kind, ok := v.FieldByName("Kind").Interface().(item.Kind)
+			if !ok {
+				return nil, nil
+			}
+
+			return loaderFromContext(p.Context).load(kind, id), nil
+		},
+	}
+}
+
+// ammunitionField returns a field resolving the referred ammunition
+func ammunitionField(itemInterface *graphql.Interface, id func(src interface{}) model.ObjectID) *graphql.Field {
+	return &graphql.Field{
+		Type: itemInterface,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return loaderFromContext(p.Context).load(item.KindAmmunition, id(p.Source)), nil
+		},
+	}
+}
+
+// checkScope checks if the claims of the context contain the scope
+func checkScope(ctx context.Context, scope string) error {
+	clm, ok := jwt.FromContext(ctx)
+	if !ok {
+		return ErrUnauthorized
+	}
+
+	if !clm.HasScope(scope) {
+		return fmt.Errorf("%w: %s required", jwt.ErrInvalidScope, scope)
+	}
+
+	return nil
+}
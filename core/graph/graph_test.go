@@ -0,0 +1,70 @@
+package graph
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+)
+
+func TestNewSchema(t *testing.T) {
+	if err := Init(); err != nil {
+		t.Fatalf("Building schema failed: %s", err)
+	}
+
+	res := Do(context.Background(), &Request{
+		Query: `{ __type(name: "Ammunition") { interfaces { name } fields { name } } }`,
+	})
+	if len(res.Errors) > 0 {
+		t.Fatalf("Introspection failed: %v", res.Errors)
+	}
+
+	typ := res.Data.(map[string]interface{})["__type"].(map[string]interface{})
+
+	ifaces := typ["interfaces"].([]interface{})
+	if len(ifaces) != 1 || ifaces[0].(map[string]interface{})["name"] != "Item" {
+		t.Errorf("Introspection failed: unexpected interfaces %v", ifaces)
+	}
+
+	fields := make(map[string]bool)
+	for _, f := range typ["fields"].([]interface{}) {
+		fields[f.(map[string]interface{})["name"].(string)] = true
+	}
+
+	for _, name := range []string{"_id", "_kind", "name", "caliber", "effects"} {
+		if !fields[name] {
+			t.Errorf("Introspection failed: field %s missing", name)
+		}
+	}
+}
+
+func TestScope(t *testing.T) {
+	if err := Init(); err != nil {
+		t.Fatalf("Building schema failed: %s", err)
+	}
+
+	query := `{ item(kind: "ammunition", id: "5c0d56a986f774449d5de529") { _id } }`
+
+	res := Do(context.Background(), &Request{Query: query})
+	if len(res.Errors) != 1 || !strings.Contains(res.Errors[0].Message, ErrUnauthorized.Error()) {
+		t.Errorf("Executing query failed: expected unauthorized error, got %v", res.Errors)
+	}
+
+	clm := &jwt.Claims{Scope: []string{jwt.ScopeLocationRead}}
+	ctx := jwt.NewContext(context.Background(), clm)
+
+	res = Do(ctx, &Request{Query: query})
+	if len(res.Errors) != 1 || !strings.Contains(res.Errors[0].Message, jwt.ScopeItemRead) {
+		t.Errorf("Executing query failed: expected scope error, got %v", res.Errors)
+	}
+
+	// A scope qualified by another kind doesn't grant access
+	clm = &jwt.Claims{Scope: []string{jwt.QualifyScope(jwt.ScopeItemRead, "armor")}}
+	ctx = jwt.NewContext(context.Background(), clm)
+
+	res = Do(ctx, &Request{Query: query})
+	if len(res.Errors) != 1 || !strings.Contains(res.Errors[0].Message, jwt.QualifyScope(jwt.ScopeItemRead, "ammunition")) {
+		t.Errorf("Executing query failed: expected scope error, got %v", res.Errors)
+	}
+}
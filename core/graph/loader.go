@@ -0,0 +1,156 @@
+package graph
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/model/item"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// maxBatchSize is the maximum number of IDs loaded with one query
+const maxBatchSize = 100
+
+// itemLoader loads items referenced during the execution of a query in batches.
+// References are collected until the first thunk of a batch is called, at which
+// point all pending references are loaded with one query per kind.
+type itemLoader struct {
+	mu      sync.Mutex
+	ctx     context.Context
+	pending map[item.Kind][]string
+	items   map[string]item.Entity
+	errs    map[item.Kind]error
+}
+
+type loaderKey struct{}
+
+func newItemLoader(ctx context.Context) *itemLoader {
+	return &itemLoader{
+		ctx:     ctx,
+		pending: make(map[item.Kind][]string),
+		items:   make(map[string]item.Entity),
+		errs:    make(map[item.Kind]error),
+	}
+}
+
+func withLoader(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loaderKey{}, newItemLoader(ctx))
+}
+
+func loaderFromContext(ctx context.Context) *itemLoader {
+	if l, ok := ctx.Value(loaderKey{}).(*itemLoader); ok {
+		return l
+	}
+
+	return newItemLoader(ctx)
+}
+
+// load schedules the item for loading and returns a thunk which resolves to the item
+func (l *itemLoader) load(k item.Kind, id model.ObjectID) func() (interface{}, error) {
+	if err := checkScope(l.ctx, jwt.QualifyScopeValue(jwt.ScopeItemRead, k.String())); err != nil {
+		return func() (interface{}, error) { return nil, err }
+	}
+
+	key := id.Hex()
+
+	l.mu.Lock()
+	if _, ok := l.items[key]; !ok && k.IsValid() {
+		l.pending[k] = append(l.pending[k], key)
+	}
+	l.mu.Unlock()
+
+	return func() (interface{}, error) {
+		l.dispatch()
+
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if err := l.errs[k]; err != nil {
+			return nil, err
+		}
+
+		if e, ok := l.items[key]; ok && e != nil {
+			return e, nil
+		}
+
+		return nil, nil
+	}
+}
+
+// loadList schedules all items of the list and returns a thunk which resolves to the found items
+func (l *itemLoader) loadList(list item.List) func() (interface{}, error) {
+	kinds := make([]string, 0, len(list))
+	for k := range list {
+		kinds = append(kinds, k.String())
+	}
+	sort.Strings(kinds)
+
+	var thunks []func() (interface{}, error)
+	for _, k := range kinds {
+		for _, id := range list[item.Kind(k)] {
+			thunks = append(thunks, l.load(item.Kind(k), id))
+		}
+	}
+
+	return func() (interface{}, error) {
+		items := make([]interface{}, 0, len(thunks))
+
+		for _, t := range thunks {
+			e, err := t()
+			if err != nil {
+				return nil, err
+			}
+			if e != nil {
+				items = append(items, e)
+			}
+		}
+
+		return items, nil
+	}
+}
+
+func (l *itemLoader) dispatch() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for k, keys := range l.pending {
+		delete(l.pending, k)
+
+		ids := make([]string, 0, len(keys))
+		for _, key := range keys {
+			if _, ok := l.items[key]; !ok {
+				l.items[key] = nil
+				ids = append(ids, key)
+			}
+		}
+
+		for len(ids) > 0 {
+			n := len(ids)
+			if n > maxBatchSize {
+				n = maxBatchSize
+			}
+
+			opts := &item.Options{
+				Sort:  bson.D{{Key: "_id", Value: 1}},
+				Limit: int64(n),
+			}
+
+			result, err := item.GetByIDs(l.ctx, ids[:n], k, opts)
+			if err != nil && err != model.ErrNoResult {
+				l.errs[k] = err
+				break
+			}
+
+			for _, v := range result.Items {
+				e := v.(item.Entity)
+				l.items[e.GetID().Hex()] = e
+			}
+
+			ids = ids[n:]
+		}
+	}
+}
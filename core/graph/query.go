@@ -0,0 +1,541 @@
+package graph
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/model/hideout/module"
+	"github.com/tarkov-database/rest-api/model/hideout/production"
+	"github.com/tarkov-database/rest-api/model/item"
+	"github.com/tarkov-database/rest-api/model/location"
+	"github.com/tarkov-database/rest-api/model/location/feature"
+	"github.com/tarkov-database/rest-api/model/location/featuregroup"
+	"github.com/tarkov-database/rest-api/model/statistic/ammunition/armor"
+	"github.com/tarkov-database/rest-api/model/statistic/ammunition/distance"
+
+	"github.com/graphql-go/graphql"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+var (
+	// ErrInvalidText indicates that a search text is not valid
+	ErrInvalidText = errors.New("text has an invalid length")
+
+	// ErrInvalidKind indicates that an item kind is not valid
+	ErrInvalidKind = errors.New("kind is not valid")
+)
+
+// listOptions holds the pagination arguments of a list field
+type listOptions struct {
+	Sort   bson.D
+	Limit  int64
+	Offset int64
+}
+
+func listArgs(args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	if args == nil {
+		args = make(graphql.FieldConfigArgument)
+	}
+
+	args["limit"] = &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: defaultLimit}
+	args["offset"] = &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0}
+	args["sort"] = &graphql.ArgumentConfig{
+		Type:        graphql.String,
+		Description: "Field to sort by, prefixed with \"-\" for descending order",
+	}
+
+	return args
+}
+
+func getListOptions(p graphql.ResolveParams, defSort string) *listOptions {
+	opts := &listOptions{Limit: defaultLimit}
+
+	if l, ok := p.Args["limit"].(int); ok && l >= 0 {
+		opts.Limit = int64(l)
+		if opts.Limit > maxLimit {
+			opts.Limit = maxLimit
+		}
+	}
+
+	if o, ok := p.Args["offset"].(int); ok && o > 0 {
+		opts.Offset = int64(o)
+	}
+
+	sort := defSort
+	if s, ok := p.Args["sort"].(string); ok && len(s) > 1 {
+		sort = s
+	}
+
+	if strings.HasPrefix(sort, "-") {
+		opts.Sort = bson.D{{Key: strings.TrimPrefix(sort, "-"), Value: -1}}
+	} else {
+		opts.Sort = bson.D{{Key: sort, Value: 1}}
+	}
+
+	return opts
+}
+
+func getText(p graphql.ResolveParams) (string, bool, error) {
+	txt, ok := p.Args["text"].(string)
+	if !ok {
+		return "", false, nil
+	}
+
+	if l := len(txt); l < 3 || l > 32 {
+		return "", true, ErrInvalidText
+	}
+
+	return txt, true, nil
+}
+
+func getID(p graphql.ResolveParams, name string) (string, bool) {
+	if id, ok := p.Args[name].(model.ObjectID); ok {
+		return id.Hex(), true
+	}
+
+	return "", false
+}
+
+func getIDs(p graphql.ResolveParams, name string) []string {
+	list, ok := p.Args[name].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	ids := make([]string, 0, len(list))
+	for _, v := range list {
+		if id, ok := v.(model.ObjectID); ok {
+			ids = append(ids, id.Hex())
+		}
+	}
+
+	return ids
+}
+
+// nullable converts a missing document into a null value
+func nullable(v interface{}, err error) (interface{}, error) {
+	if errors.Is(err, model.ErrNoResult) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// scoped wraps the resolver with a scope check
+func scoped(scope string, fn graphql.FieldResolveFn) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		if err := checkScope(p.Context, scope); err != nil {
+			return nil, err
+		}
+
+		return fn(p)
+	}
+}
+
+// qualified wraps the resolver with a check of the scope restricted to the
+// kind or ID returned by the qualifier, like the scope of a route by its
+// parameter
+func qualified(scope string, qualifier func(p graphql.ResolveParams) string, fn graphql.FieldResolveFn) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		if err := checkScope(p.Context, jwt.QualifyScopeValue(scope, qualifier(p))); err != nil {
+			return nil, err
+		}
+
+		return fn(p)
+	}
+}
+
+// byArg returns the qualifier of the argument of the given name
+func byArg(name string) func(p graphql.ResolveParams) string {
+	return func(p graphql.ResolveParams) string {
+		if id, ok := getID(p, name); ok {
+			return id
+		}
+
+		v, _ := p.Args[name].(string)
+
+		return v
+	}
+}
+
+func byLocation(p graphql.ResolveParams) string {
+	return p.Source.(*location.Location).ID.Hex()
+}
+
+func byFeatureLocation(p graphql.ResolveParams) string {
+	return p.Source.(*feature.Feature).Location.Hex()
+}
+
+// listObject returns the object type of a result of the given type
+func listObject(name string, t graphql.Output) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: name,
+		Fields: graphql.Fields{
+			"total": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*model.Result).Count, nil
+				},
+			},
+			"items": &graphql.Field{
+				Type: graphql.NewList(t),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*model.Result).Items, nil
+				},
+			},
+		},
+	})
+}
+
+var idArg = &graphql.ArgumentConfig{Type: graphql.NewNonNull(ObjectID)}
+
+func newQuery(b *builder, itemInterface *graphql.Interface) *graphql.Object {
+	itemList := listObject("ItemList", itemInterface)
+
+	moduleType := b.object(module.Module{})
+	moduleList := listObject("ModuleList", moduleType)
+
+	productionType := b.object(production.Production{})
+	productionList := listObject("ProductionList", productionType)
+
+	locationType := b.object(location.Location{})
+	locationList := listObject("LocationList", locationType)
+
+	featureType := b.object(feature.Feature{})
+	featureList := listObject("FeatureList", featureType)
+
+	groupType := b.object(featuregroup.Group{})
+	groupList := listObject("FeatureGroupList", groupType)
+
+	distanceType := b.object(distance.AmmoDistanceStatistics{})
+	distanceList := listObject("AmmoDistanceStatisticsList", distanceType)
+
+	armorType := b.object(armor.AmmoArmorStatistics{})
+	armorList := listObject("AmmoArmorStatisticsList", armorType)
+
+	b.extend(location.Location{}, graphql.Fields{
+		"features": &graphql.Field{
+			Type: featureList,
+			Args: listArgs(graphql.FieldConfigArgument{
+				"text":  {Type: graphql.String},
+				"group": {Type: ObjectID},
+			}),
+			Resolve: qualified(jwt.ScopeLocationRead, byLocation, resolveFeatures),
+		},
+		"featureGroups": &graphql.Field{
+			Type: groupList,
+			Args: listArgs(graphql.FieldConfigArgument{
+				"text": {Type: graphql.String},
+				"tags": {Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+			}),
+			Resolve: qualified(jwt.ScopeLocationRead, byLocation, resolveFeatureGroups),
+		},
+	})
+
+	b.extend(feature.Feature{}, graphql.Fields{
+		"featureGroup": &graphql.Field{
+			Type: groupType,
+			Resolve: qualified(jwt.ScopeLocationRead, byFeatureLocation, func(p graphql.ResolveParams) (interface{}, error) {
+				f := p.Source.(*feature.Feature)
+				if f.Group.IsZero() {
+					return nil, nil
+				}
+				return nullable(featuregroup.GetByID(p.Context, f.Group.Hex(), f.Location.Hex()))
+			}),
+		},
+	})
+
+	b.extend(production.Production{}, graphql.Fields{
+		"hideoutModule": &graphql.Field{
+			Type: moduleType,
+			Resolve: scoped(jwt.ScopeHideoutRead, func(p graphql.ResolveParams) (interface{}, error) {
+				return nullable(module.GetByID(p.Context, p.Source.(*production.Production).Module.Hex()))
+			}),
+		},
+	})
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"item": &graphql.Field{
+				Type: itemInterface,
+				Args: graphql.FieldConfigArgument{
+					"kind": {Type: graphql.NewNonNull(graphql.String)},
+					"id":   idArg,
+				},
+				Resolve: qualified(jwt.ScopeItemRead, byArg("kind"), resolveItem),
+			},
+			"items": &graphql.Field{
+				Type: itemList,
+				Args: listArgs(graphql.FieldConfigArgument{
+					"kind": {Type: graphql.NewNonNull(graphql.String)},
+					"ids":  {Type: graphql.NewList(graphql.NewNonNull(ObjectID))},
+					"text": {Type: graphql.String},
+				}),
+				Resolve: qualified(jwt.ScopeItemRead, byArg("kind"), resolveItems),
+			},
+			"hideoutModule": &graphql.Field{
+				Type: moduleType,
+				Args: graphql.FieldConfigArgument{"id": idArg},
+				Resolve: scoped(jwt.ScopeHideoutRead, func(p graphql.ResolveParams) (interface{}, error) {
+					id, _ := getID(p, "id")
+					return nullable(module.GetByID(p.Context, id))
+				}),
+			},
+			"hideoutModules": &graphql.Field{
+				Type: moduleList,
+				Args: listArgs(graphql.FieldConfigArgument{
+					"ids":      {Type: graphql.NewList(graphql.NewNonNull(ObjectID))},
+					"text":     {Type: graphql.String},
+					"material": {Type: ObjectID},
+				}),
+				Resolve: scoped(jwt.ScopeHideoutRead, resolveModules),
+			},
+			"hideoutProduction": &graphql.Field{
+				Type: productionType,
+				Args: graphql.FieldConfigArgument{"id": idArg},
+				Resolve: scoped(jwt.ScopeHideoutRead, func(p graphql.ResolveParams) (interface{}, error) {
+					id, _ := getID(p, "id")
+					return nullable(production.GetByID(p.Context, id))
+				}),
+			},
+			"hideoutProductions": &graphql.Field{
+				Type: productionList,
+				Args: listArgs(graphql.FieldConfigArgument{
+					"ids":      {Type: graphql.NewList(graphql.NewNonNull(ObjectID))},
+					"module":   {Type: ObjectID},
+					"material": {Type: ObjectID},
+					"outcome":  {Type: ObjectID},
+				}),
+				Resolve: scoped(jwt.ScopeHideoutRead, resolveProductions),
+			},
+			"location": &graphql.Field{
+				Type: locationType,
+				Args: graphql.FieldConfigArgument{"id": idArg},
+				Resolve: qualified(jwt.ScopeLocationRead, byArg("id"), func(p graphql.ResolveParams) (interface{}, error) {
+					id, _ := getID(p, "id")
+					return nullable(location.GetByID(p.Context, id))
+				}),
+			},
+			"locations": &graphql.Field{
+				Type: locationList,
+				Args: listArgs(graphql.FieldConfigArgument{
+					"text":      {Type: graphql.String},
+					"available": {Type: graphql.Boolean},
+				}),
+				Resolve: scoped(jwt.ScopeLocationRead, resolveLocations),
+			},
+			"ammoDistanceStatistics": &graphql.Field{
+				Type: distanceList,
+				Args: listArgs(graphql.FieldConfigArgument{
+					"ammo":     {Type: graphql.NewList(graphql.NewNonNull(ObjectID))},
+					"rangeMin": {Type: graphql.Int},
+					"rangeMax": {Type: graphql.Int},
+				}),
+				Resolve: scoped(jwt.ScopeStatisticRead, resolveDistanceStats),
+			},
+			"ammoArmorStatistics": &graphql.Field{
+				Type: armorList,
+				Args: listArgs(graphql.FieldConfigArgument{
+					"ammo":     {Type: graphql.NewList(graphql.NewNonNull(ObjectID))},
+					"armor":    {Type: graphql.NewList(graphql.NewNonNull(ObjectID))},
+					"rangeMin": {Type: graphql.Int},
+					"rangeMax": {Type: graphql.Int},
+				}),
+				Resolve: scoped(jwt.ScopeStatisticRead, resolveArmorStats),
+			},
+		},
+	})
+}
+
+func getKind(p graphql.ResolveParams) (item.Kind, error) {
+	k := item.Kind(p.Args["kind"].(string))
+	if !k.IsValid() {
+		return k, ErrInvalidKind
+	}
+
+	return k, nil
+}
+
+func resolveItem(p graphql.ResolveParams) (interface{}, error) {
+	k, err := getKind(p)
+	if err != nil {
+		return nil, err
+	}
+
+	id, _ := getID(p, "id")
+
+	return nullable(item.GetByID(p.Context, id, k))
+}
+
+func resolveItems(p graphql.ResolveParams) (interface{}, error) {
+	k, err := getKind(p)
+	if err != nil {
+		return nil, err
+	}
+
+	lo := getListOptions(p, "-_modified")
+	opts := &item.Options{Sort: lo.Sort, Limit: lo.Limit, Offset: lo.Offset}
+
+	if ids := getIDs(p, "ids"); ids != nil {
+		return item.GetByIDs(p.Context, ids, k, opts)
+	}
+
+	if txt, ok, err := getText(p); ok {
+		if err != nil {
+			return nil, err
+		}
+		return item.GetByText(p.Context, txt, opts, k)
+	}
+
+	return item.GetAll(p.Context, nil, k, opts)
+}
+
+func resolveModules(p graphql.ResolveParams) (interface{}, error) {
+	lo := getListOptions(p, "-_modified")
+	opts := &module.Options{Sort: lo.Sort, Limit: lo.Limit, Offset: lo.Offset}
+
+	if ids := getIDs(p, "ids"); ids != nil {
+		return module.GetByIDs(p.Context, ids, opts)
+	}
+
+	if txt, ok, err := getText(p); ok {
+		if err != nil {
+			return nil, err
+		}
+		return module.GetByText(p.Context, txt, opts)
+	}
+
+	if id, ok := getID(p, "material"); ok {
+		return module.GetByMaterial(p.Context, id, opts)
+	}
+
+	return module.GetAll(p.Context, opts)
+}
+
+func resolveProductions(p graphql.ResolveParams) (interface{}, error) {
+	lo := getListOptions(p, "-_modified")
+	opts := &production.Options{Sort: lo.Sort, Limit: lo.Limit, Offset: lo.Offset}
+
+	if ids := getIDs(p, "ids"); ids != nil {
+		return production.GetByIDs(p.Context, ids, opts)
+	}
+
+	if id, ok := getID(p, "module"); ok {
+		return production.GetByModule(p.Context, id, opts)
+	}
+
+	if id, ok := getID(p, "material"); ok {
+		return production.GetByMaterial(p.Context, id, opts)
+	}
+
+	if id, ok := getID(p, "outcome"); ok {
+		return production.GetByOutcome(p.Context, id, opts)
+	}
+
+	return production.GetAll(p.Context, opts)
+}
+
+func resolveLocations(p graphql.ResolveParams) (interface{}, error) {
+	lo := getListOptions(p, "-_modified")
+	opts := &location.Options{Sort: lo.Sort, Limit: lo.Limit, Offset: lo.Offset}
+
+	if txt, ok, err := getText(p); ok {
+		if err != nil {
+			return nil, err
+		}
+		return location.GetByText(p.Context, txt, opts)
+	}
+
+	if a, ok := p.Args["available"].(bool); ok {
+		return location.GetByAvailability(p.Context, a, opts)
+	}
+
+	return location.GetAll(p.Context, opts)
+}
+
+func resolveFeatures(p graphql.ResolveParams) (interface{}, error) {
+	loc := p.Source.(*location.Location).ID.Hex()
+
+	lo := getListOptions(p, "-_modified")
+	opts := &feature.Options{Sort: lo.Sort, Limit: lo.Limit, Offset: lo.Offset}
+
+	if txt, ok, err := getText(p); ok {
+		if err != nil {
+			return nil, err
+		}
+		return feature.GetByText(p.Context, txt, loc, opts)
+	}
+
+	if id, ok := getID(p, "group"); ok {
+		return feature.GetByGroup(p.Context, id, loc, opts)
+	}
+
+	return feature.GetAll(p.Context, loc, opts)
+}
+
+func resolveFeatureGroups(p graphql.ResolveParams) (interface{}, error) {
+	loc := p.Source.(*location.Location).ID.Hex()
+
+	lo := getListOptions(p, "-_modified")
+	opts := &featuregroup.Options{Sort: lo.Sort, Limit: lo.Limit, Offset: lo.Offset}
+
+	if txt, ok, err := getText(p); ok {
+		if err != nil {
+			return nil, err
+		}
+		return featuregroup.GetByText(p.Context, txt, loc, opts)
+	}
+
+	if list, ok := p.Args["tags"].([]interface{}); ok {
+		tags := make([]string, 0, len(list))
+		for _, v := range list {
+			tags = append(tags, v.(string))
+		}
+		return featuregroup.GetByTags(p.Context, tags, loc, opts)
+	}
+
+	return featuregroup.GetAll(p.Context, loc, opts)
+}
+
+func getRange(p graphql.ResolveParams) (gte, lte *uint64) {
+	if v, ok := p.Args["rangeMin"].(int); ok && v >= 0 {
+		u := uint64(v)
+		gte = &u
+	}
+
+	if v, ok := p.Args["rangeMax"].(int); ok && v >= 0 {
+		u := uint64(v)
+		lte = &u
+	}
+
+	return
+}
+
+func resolveDistanceStats(p graphql.ResolveParams) (interface{}, error) {
+	lo := getListOptions(p, "distance")
+	opts := &distance.Options{Sort: lo.Sort, Limit: lo.Limit, Offset: lo.Offset}
+
+	gte, lte := getRange(p)
+
+	return distance.GetByRefsAndRange(p.Context, getIDs(p, "ammo"), gte, lte, opts)
+}
+
+func resolveArmorStats(p graphql.ResolveParams) (interface{}, error) {
+	lo := getListOptions(p, "distance")
+	opts := &armor.Options{Sort: lo.Sort, Limit: lo.Limit, Offset: lo.Offset}
+
+	gte, lte := getRange(p)
+
+	return armor.GetByRefs(p.Context, getIDs(p, "ammo"), getIDs(p, "armor"), &armor.RangeOptions{GTE: gte, LTE: lte}, opts)
+}
@@ -0,0 +1,384 @@
+package graph
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/tarkov-database/rest-api/model"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ObjectID is the scalar of a MongoDB object ID
+var ObjectID = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "ObjectID",
+	Description: "Hexadecimal representation of an object ID",
+	Serialize: func(v interface{}) interface{} {
+		switch v := v.(type) {
+		case model.ObjectID:
+			return v.Hex()
+		case *model.ObjectID:
+			return v.Hex()
+		}
+		return nil
+	},
+	ParseValue: func(v interface{}) interface{} {
+		if s, ok := v.(string); ok {
+			if id, err := primitive.ObjectIDFromHex(s); err == nil {
+				return id
+			}
+		}
+		return nil
+	},
+	ParseLiteral: func(v ast.Value) interface{} {
+		if s, ok := v.(*ast.StringValue); ok {
+			if id, err := primitive.ObjectIDFromHex(s.Value); err == nil {
+				return id
+			}
+		}
+		return nil
+	},
+})
+
+// Timestamp is the scalar of a Unix timestamp
+var Timestamp = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "Timestamp",
+	Description: "Unix timestamp in seconds",
+	Serialize: func(v interface{}) interface{} {
+		switch v := v.(type) {
+		case model.Timestamp:
+			return v.Unix()
+		case *model.Timestamp:
+			return v.Unix()
+		}
+		return nil
+	},
+	ParseValue: func(v interface{}) interface{} {
+		return nil
+	},
+	ParseLiteral: func(v ast.Value) interface{} {
+		return nil
+	},
+})
+
+// JSON is the scalar of arbitrary JSON values
+var JSON = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "JSON",
+	Description: "Arbitrary JSON value",
+	Serialize: func(v interface{}) interface{} {
+		return v
+	},
+	ParseValue: func(v interface{}) interface{} {
+		return v
+	},
+	ParseLiteral: func(v ast.Value) interface{} {
+		return v.GetValue()
+	},
+})
+
+// converter converts the value of a field to its GraphQL representation
+type converter struct {
+	output  graphql.Output
+	resolve func(p graphql.ResolveParams, v interface{}) (interface{}, error)
+}
+
+// entry represents a key-value pair of a map
+type entry struct {
+	Key   string
+	Value interface{}
+}
+
+// builder builds GraphQL object types from Go types based on their JSON encoding
+type builder struct {
+	names      map[reflect.Type]string
+	objects    map[reflect.Type]*graphql.Object
+	types      map[string]reflect.Type
+	scalars    map[reflect.Type]graphql.Output
+	converters map[reflect.Type]*converter
+	extensions map[reflect.Type]graphql.Fields
+	interfaces map[reflect.Type][]*graphql.Interface
+	err        error
+}
+
+func newBuilder() *builder {
+	return &builder{
+		names:      make(map[reflect.Type]string),
+		objects:    make(map[reflect.Type]*graphql.Object),
+		types:      make(map[string]reflect.Type),
+		scalars:    make(map[reflect.Type]graphql.Output),
+		converters: make(map[reflect.Type]*converter),
+		extensions: make(map[reflect.Type]graphql.Fields),
+		interfaces: make(map[reflect.Type][]*graphql.Interface),
+	}
+}
+
+func typeOf(v interface{}) reflect.Type {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t
+}
+
+// name sets the name of the object type of v
+func (b *builder) name(v interface{}, name string) {
+	b.names[typeOf(v)] = name
+}
+
+// scalar sets the output type used for the type of v
+func (b *builder) scalar(v interface{}, s graphql.Output) {
+	b.scalars[reflect.TypeOf(v)] = s
+}
+
+// convert sets the converter used for fields of the type of v
+func (b *builder) convert(v interface{}, c *converter) {
+	b.converters[reflect.TypeOf(v)] = c
+}
+
+// extend adds fields to the object type of v
+func (b *builder) extend(v interface{}, fields graphql.Fields) {
+	t := typeOf(v)
+
+	if b.extensions[t] == nil {
+		b.extensions[t] = make(graphql.Fields)
+	}
+
+	for k, f := range fields {
+		b.extensions[t][k] = f
+	}
+}
+
+// implement declares that the object type of v implements the interface
+func (b *builder) implement(v interface{}, i *graphql.Interface) {
+	t := typeOf(v)
+	b.interfaces[t] = append(b.interfaces[t], i)
+}
+
+// object returns the object type of v
+func (b *builder) object(v interface{}) *graphql.Object {
+	return b.objectOf(typeOf(v))
+}
+
+func (b *builder) objectOf(t reflect.Type) *graphql.Object {
+	if o, ok := b.objects[t]; ok {
+		return o
+	}
+
+	name, ok := b.names[t]
+	if !ok {
+		name = t.Name()
+	}
+
+	if other, ok := b.types[name]; ok && other != t {
+		b.err = fmt.Errorf("type name %s of %s is already used by %s", name, t, other)
+	}
+	b.types[name] = t
+
+	o := graphql.NewObject(graphql.ObjectConfig{
+		Name:       name,
+		Interfaces: b.interfaces[t],
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return b.fields(t)
+		}),
+	})
+
+	b.objects[t] = o
+
+	return o
+}
+
+// entryObject returns the object type of a key-value pair of the map type
+func (b *builder) entryObject(t reflect.Type) *graphql.Object {
+	if o, ok := b.objects[t]; ok {
+		return o
+	}
+
+	elem := t.Elem()
+	for elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+
+	name := elem.Name() + "Entry"
+	if n, ok := b.names[t]; ok {
+		name = n
+	}
+
+	o := graphql.NewObject(graphql.ObjectConfig{
+		Name: name,
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"key": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(entry).Key, nil
+					},
+				},
+				"value": &graphql.Field{
+					Type: b.output(t.Elem()),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return b.value(p, t.Elem(), p.Source.(entry).Value)
+					},
+				},
+			}
+		}),
+	})
+
+	b.objects[t] = o
+
+	return o
+}
+
+// output returns the output type of the given type
+func (b *builder) output(t reflect.Type) graphql.Output {
+	if c, ok := b.converters[t]; ok {
+		return c.output
+	}
+
+	if s, ok := b.scalars[t]; ok {
+		return s
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return b.output(t.Elem())
+	case reflect.Bool:
+		return graphql.Boolean
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return graphql.Int
+	case reflect.Float32, reflect.Float64:
+		return graphql.Float
+	case reflect.String:
+		return graphql.String
+	case reflect.Slice, reflect.Array:
+		return graphql.NewList(b.output(t.Elem()))
+	case reflect.Map:
+		return graphql.NewList(b.entryObject(t))
+	case reflect.Struct:
+		return b.objectOf(t)
+	default:
+		return JSON
+	}
+}
+
+// value converts a field value of the given type for the executor
+func (b *builder) value(p graphql.ResolveParams, t reflect.Type, v interface{}) (interface{}, error) {
+	if c, ok := b.converters[t]; ok {
+		return c.resolve(p, v)
+	}
+
+	if _, ok := b.scalars[t]; ok {
+		return v, nil
+	}
+
+	rv := reflect.ValueOf(v)
+
+	switch t.Kind() {
+	case reflect.Map:
+		if rv.IsNil() {
+			return nil, nil
+		}
+
+		entries := make([]entry, 0, rv.Len())
+		for _, k := range rv.MapKeys() {
+			entries = append(entries, entry{
+				Key:   fmt.Sprint(k.Interface()),
+				Value: rv.MapIndex(k).Interface(),
+			})
+		}
+		sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+
+		return entries, nil
+	case reflect.Slice, reflect.Array:
+		if _, ok := b.converters[t.Elem()]; !ok && t.Elem().Kind() != reflect.Map {
+			return v, nil
+		}
+		if t.Kind() == reflect.Slice && rv.IsNil() {
+			return nil, nil
+		}
+
+		list := make([]interface{}, rv.Len())
+		for i := range list {
+			e, err := b.value(p, t.Elem(), rv.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			list[i] = e
+		}
+
+		return list, nil
+	}
+
+	return v, nil
+}
+
+// fields returns the fields of the struct type including its extensions
+func (b *builder) fields(t reflect.Type) graphql.Fields {
+	fields := make(graphql.Fields)
+
+	b.structFields(fields, t, nil)
+
+	for k, f := range b.extensions[t] {
+		fields[k] = f
+	}
+
+	return fields
+}
+
+func (b *builder) structFields(fields graphql.Fields, t reflect.Type, index []int) {
+	var embedded []reflect.StructField
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.SplitN(tag, ",", 2)[0]
+
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			embedded = append(embedded, f)
+			continue
+		}
+
+		if !f.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		if _, ok := fields[name]; ok {
+			continue
+		}
+
+		fields[name] = b.field(f.Type, append(append([]int{}, index...), i))
+	}
+
+	// Fields of embedded structs are promoted unless they are shadowed
+	for _, f := range embedded {
+		b.structFields(fields, f.Type, append(append([]int{}, index...), f.Index...))
+	}
+}
+
+func (b *builder) field(t reflect.Type, index []int) *graphql.Field {
+	return &graphql.Field{
+		Type: b.output(t),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			src := reflect.Indirect(reflect.ValueOf(p.Source))
+			if !src.IsValid() {
+				return nil, nil
+			}
+
+			return b.value(p, t, src.FieldByIndex(index).Interface())
+		},
+	}
+}
@@ -0,0 +1,89 @@
+package graph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+)
+
+type testBase struct {
+	Name string `json:"name"`
+}
+
+type testSlot struct {
+	Required bool `json:"required"`
+}
+
+type testEntity struct {
+	testBase
+
+	Count  int                 `json:"count"`
+	Tags   []string            `json:"tags"`
+	Slots  map[string]testSlot `json:"slots"`
+	Secret string              `json:"-"`
+}
+
+func TestBuilder(t *testing.T) {
+	b := newBuilder()
+
+	entity := &testEntity{
+		testBase: testBase{Name: "test"},
+		Count:    3,
+		Tags:     []string{"a", "b"},
+		Slots:    map[string]testSlot{"mod_b": {Required: true}, "mod_a": {}},
+		Secret:   "secret",
+	}
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"entity": &graphql.Field{
+				Type: b.object(testEntity{}),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return entity, nil
+				},
+			},
+		},
+	})
+
+	s, err := graphql.NewSchema(graphql.SchemaConfig{Query: query})
+	if err != nil {
+		t.Fatalf("Building schema failed: %s", err)
+	}
+
+	res := graphql.Do(graphql.Params{
+		Schema:        s,
+		RequestString: `{ entity { name count tags slots { key value { required } } } }`,
+		Context:       context.Background(),
+	})
+	if len(res.Errors) > 0 {
+		t.Fatalf("Executing query failed: %v", res.Errors)
+	}
+
+	e := res.Data.(map[string]interface{})["entity"].(map[string]interface{})
+
+	if e["name"] != "test" {
+		t.Errorf("Executing query failed: unexpected name %v", e["name"])
+	}
+	if e["count"] != 3 {
+		t.Errorf("Executing query failed: unexpected count %v", e["count"])
+	}
+	if len(e["tags"].([]interface{})) != 2 {
+		t.Errorf("Executing query failed: unexpected tags %v", e["tags"])
+	}
+
+	slots := e["slots"].([]interface{})
+	if len(slots) != 2 {
+		t.Fatalf("Executing query failed: unexpected slots %v", slots)
+	}
+
+	first := slots[0].(map[string]interface{})
+	if first["key"] != "mod_a" || first["value"].(map[string]interface{})["required"] != false {
+		t.Errorf("Executing query failed: unexpected slot %v", first)
+	}
+
+	if b.err != nil {
+		t.Errorf("Building schema failed: %s", b.err)
+	}
+}
@@ -1,66 +1,37 @@
 package health
 
 import (
-	"errors"
-	"fmt"
-	"log"
-	"os"
 	"time"
+
+	"github.com/tarkov-database/rest-api/core/config"
 )
 
 var (
-	cfg *config
+	cfg = newConfig(config.Default().Health)
 
 	minDuration = 10 * time.Second
 )
 
-func init() {
-	var err error
-
-	cfg, err = newConfig()
-	if err != nil {
-		log.Printf("Configuration error: %s\n", err)
-		os.Exit(2)
-	}
-}
-
-type config struct {
+type settings struct {
 	updateInterval   time.Duration
 	latencyThreshold time.Duration
 }
 
-func newConfig() (*config, error) {
-	c := &config{}
-
-	if env := os.Getenv("HEALTHCHECK_INTERVAL"); len(env) > 0 {
-		d, err := time.ParseDuration(env)
-		if err != nil {
-			return c, fmt.Errorf("HEALTHCHECK_INTERVAL is not valid: %s", err)
-		}
+// Configure applies the configuration to the health checks. It has to be
+// called before InitChecks.
+func Configure(c config.Health) {
+	cfg = newConfig(c)
+}
 
-		if d < minDuration {
-			c.updateInterval = minDuration
-		} else {
-			c.updateInterval = d
-		}
-	} else {
-		c.updateInterval = 30 * time.Second
+func newConfig(c config.Health) *settings {
+	s := &settings{
+		updateInterval:   c.Interval.Duration,
+		latencyThreshold: c.UnhealthyLatency.Duration,
 	}
 
-	if env := os.Getenv("UNHEALTHY_LATENCY"); len(env) > 0 {
-		d, err := time.ParseDuration(env)
-		if err != nil {
-			return c, fmt.Errorf("UNHEALTHY_LATENCY is not valid: %s", err)
-		}
-
-		if d > c.updateInterval {
-			return c, errors.New("UNHEALTHY_LATENCY can't be higher than HEALTHCHECK_INTERVAL")
-		}
-
-		c.latencyThreshold = d
-	} else {
-		c.latencyThreshold = 300 * time.Millisecond
+	if s.updateInterval < minDuration {
+		s.updateInterval = minDuration
 	}
 
-	return c, nil
+	return s
 }
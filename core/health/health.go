@@ -3,8 +3,11 @@ package health
 import (
 	"os"
 	"os/signal"
+	"sync/atomic"
 	"syscall"
 	"time"
+
+	"github.com/tarkov-database/rest-api/core/database"
 )
 
 // Status represents the status code of a service
@@ -21,8 +24,33 @@ const (
 	Failure
 )
 
-// InitChecks initiates health check jobs
+var ready atomic.Bool
+
+// SetReady marks the service as ready or not ready to serve requests
+func SetReady(b bool) {
+	ready.Store(b)
+}
+
+// Ready reports whether the service is ready to serve requests, which it is
+// not during startup, shutdown and while a critical check fails
+func Ready() bool {
+	if !ready.Load() {
+		return false
+	}
+
+	for _, r := range Results() {
+		if r.Critical && r.Status == Failure {
+			return false
+		}
+	}
+
+	return true
+}
+
+// InitChecks registers the database check and initiates health check jobs
 func InitChecks() {
+	Register("database", true, cfg.latencyThreshold, database.Ping)
+
 	updateStatus()
 
 	sig := make(chan os.Signal, 1)
@@ -0,0 +1,72 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestChecks(t *testing.T) {
+	prev := checks.list
+	t.Cleanup(func() {
+		checks.list = prev
+		SetReady(false)
+	})
+	checks.list = nil
+
+	var dbErr error
+	Register("database", true, 0, func(context.Context) error { return dbErr })
+	Register("mail", false, time.Nanosecond, func(context.Context) error {
+		time.Sleep(time.Millisecond)
+		return nil
+	})
+
+	if Ready() {
+		t.Error("Service is ready before startup")
+	}
+
+	SetReady(true)
+	updateStatus()
+
+	if !Ready() {
+		t.Error("Service is not ready with a slow non-critical check")
+	}
+
+	results := Results()
+	if len(results) != 2 {
+		t.Fatalf("Number of results is %v, expected 2", len(results))
+	}
+	if results[0].Status != OK || results[1].Status != Warning {
+		t.Errorf("Statuses are %v and %v, expected %v and %v", results[0].Status, results[1].Status, OK, Warning)
+	}
+
+	dbErr = errors.New("connection refused")
+	updateStatus()
+
+	if Ready() {
+		t.Error("Service is ready with a failing critical check")
+	}
+	if s := DatabaseStatus(); s != Failure {
+		t.Errorf("Database status is %v, expected %v", s, Failure)
+	}
+
+	dbErr = nil
+	for i := 0; i < historySize; i++ {
+		updateStatus()
+	}
+
+	r := Results()[0]
+	if r.Status != OK || r.LastError != "connection refused" || r.LastErrorTime == nil {
+		t.Errorf("Result %+v does not keep the last error", r)
+	}
+	if len(r.History) != historySize {
+		t.Errorf("Length of history is %v, expected %v", len(r.History), historySize)
+	}
+
+	SetReady(false)
+
+	if Ready() {
+		t.Error("Service is ready during shutdown")
+	}
+}
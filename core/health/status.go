@@ -3,20 +3,82 @@ package health
 import (
 	"context"
 	"os"
+	"sync"
 	"time"
 
-	"github.com/tarkov-database/rest-api/core/database"
-
-	"github.com/google/logger"
+	"github.com/tarkov-database/rest-api/core/logger"
 )
 
-var (
-	databaseStatus = OK
-)
+// historySize is the number of latencies kept of each check
+const historySize = 20
+
+// CheckFunc checks a dependency and returns an error if it is not available
+type CheckFunc func(ctx context.Context) error
+
+// Result represents the outcome of the recent runs of a check
+type Result struct {
+	Name          string     `json:"name"`
+	Status        Status     `json:"status"`
+	Critical      bool       `json:"critical"`
+	LastCheck     time.Time  `json:"lastCheck"`
+	Latency       float64    `json:"latency"`
+	History       []float64  `json:"history"`
+	LastError     string     `json:"lastError,omitempty"`
+	LastErrorTime *time.Time `json:"lastErrorTime,omitempty"`
+}
+
+type check struct {
+	name      string
+	critical  bool
+	threshold time.Duration
+	fn        CheckFunc
+	result    Result
+}
+
+var checks = struct {
+	sync.RWMutex
+	list []*check
+}{}
+
+// Register adds a check run by the health check jobs. A critical check
+// failing makes the service not ready, and a check exceeding the latency
+// threshold gets a warning status. A zero threshold disables the latter.
+func Register(name string, critical bool, threshold time.Duration, fn CheckFunc) {
+	checks.Lock()
+	defer checks.Unlock()
+
+	checks.list = append(checks.list, &check{
+		name:      name,
+		critical:  critical,
+		threshold: threshold,
+		fn:        fn,
+		result:    Result{Name: name, Critical: critical, History: []float64{}},
+	})
+}
+
+// Results returns the results of all registered checks
+func Results() []Result {
+	checks.RLock()
+	defer checks.RUnlock()
+
+	results := make([]Result, len(checks.list))
+	for i, c := range checks.list {
+		results[i] = c.result
+		results[i].History = append([]float64{}, c.result.History...)
+	}
+
+	return results
+}
 
 // DatabaseStatus returns the database status of the last health check
 func DatabaseStatus() Status {
-	return databaseStatus
+	for _, r := range Results() {
+		if r.Name == "database" {
+			return r.Status
+		}
+	}
+
+	return OK
 }
 
 func scheduler(t *time.Ticker, c chan os.Signal) {
@@ -32,10 +94,16 @@ func scheduler(t *time.Ticker, c chan os.Signal) {
 }
 
 func updateStatus() {
-	databaseStatus = getDatabaseStatus()
+	checks.RLock()
+	list := append([]*check{}, checks.list...)
+	checks.RUnlock()
+
+	for _, c := range list {
+		run(c)
+	}
 }
 
-func getDatabaseStatus() Status {
+func run(c *check) {
 	timeout := 30 * time.Second
 	if cfg.updateInterval < timeout {
 		timeout = cfg.updateInterval
@@ -45,17 +113,30 @@ func getDatabaseStatus() Status {
 	defer cancel()
 
 	start := time.Now()
+	err := c.fn(ctx)
+	latency := time.Since(start)
 
-	if err := database.Ping(ctx); err != nil {
-		logger.Errorf("Error while checking database connection: %s", err)
-		return Failure
-	}
+	checks.Lock()
+	defer checks.Unlock()
 
-	latency := time.Since(start)
-	if latency > cfg.latencyThreshold {
-		logger.Warningf("Database latency exceeds threshold with %s", latency)
-		return Warning
+	r := &c.result
+	r.LastCheck = start
+	r.Latency = latency.Seconds()
+
+	r.History = append(r.History, r.Latency)
+	if len(r.History) > historySize {
+		r.History = r.History[len(r.History)-historySize:]
 	}
 
-	return OK
+	switch {
+	case err != nil:
+		logger.Errorf("Error while checking %s: %s", c.name, err)
+		r.Status = Failure
+		r.LastError, r.LastErrorTime = err.Error(), &start
+	case c.threshold > 0 && latency > c.threshold:
+		logger.Warningf("Latency of %s exceeds threshold with %s", c.name, latency)
+		r.Status = Warning
+	default:
+		r.Status = OK
+	}
 }
@@ -0,0 +1,67 @@
+package logger
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/tarkov-database/rest-api/core/config"
+)
+
+// Formats of the log output
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+var cfg = &settings{Level: slog.LevelInfo, Format: FormatText}
+
+func init() {
+	SetOutput(os.Stdout)
+}
+
+type settings struct {
+	Level  slog.Level
+	Format string
+}
+
+// Configure applies the configuration to the log output
+func Configure(c config.Log) error {
+	n, err := newConfig(c)
+	if err != nil {
+		return err
+	}
+
+	cfg = n
+	SetOutput(writer)
+
+	return nil
+}
+
+func newConfig(s config.Log) (*settings, error) {
+	c := &settings{Level: slog.LevelInfo, Format: FormatText}
+
+	switch strings.ToLower(s.Level) {
+	case "debug":
+		c.Level = slog.LevelDebug
+	case "", "info":
+		c.Level = slog.LevelInfo
+	case "warning", "warn":
+		c.Level = slog.LevelWarn
+	case "error":
+		c.Level = slog.LevelError
+	default:
+		return c, fmt.Errorf("log level %q is not one of debug, info, warning or error", s.Level)
+	}
+
+	switch f := strings.ToLower(s.Format); f {
+	case "":
+	case FormatText, FormatJSON:
+		c.Format = f
+	default:
+		return c, fmt.Errorf("log format %q is not one of text or json", s.Format)
+	}
+
+	return c, nil
+}
@@ -0,0 +1,125 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+)
+
+var (
+	std *slog.Logger
+
+	// writer is the writer set last, which is kept when reconfigured
+	writer io.Writer
+)
+
+// SetOutput sets the writer of the log output in the configured format
+func SetOutput(w io.Writer) {
+	writer = w
+
+	opts := &slog.HandlerOptions{
+		AddSource: true,
+		Level:     cfg.Level,
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			// Only the file name of the source is kept, as with the standard logger
+			if src, ok := a.Value.Any().(*slog.Source); ok {
+				a.Value = slog.StringValue(fmt.Sprintf("%s:%d", shortFile(src.File), src.Line))
+			}
+			return a
+		},
+	}
+
+	var h slog.Handler
+	if cfg.Format == FormatJSON {
+		h = slog.NewJSONHandler(w, opts)
+	} else {
+		h = slog.NewTextHandler(w, opts)
+	}
+
+	std = slog.New(h)
+}
+
+func shortFile(file string) string {
+	if i := strings.LastIndexByte(file, '/'); i >= 0 {
+		return file[i+1:]
+	}
+
+	return file
+}
+
+// Log emits a structured entry with the given attributes
+func Log(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr) {
+	output(ctx, level, msg, attrs...)
+}
+
+func output(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr) {
+	if !std.Enabled(ctx, level) {
+		return
+	}
+
+	// Skips runtime.Callers, output and the exported function
+	var pcs [1]uintptr
+	runtime.Callers(3, pcs[:])
+
+	r := slog.NewRecord(time.Now(), level, strings.TrimSuffix(msg, "\n"), pcs[0])
+	r.AddAttrs(attrs...)
+
+	_ = std.Handler().Handle(ctx, r)
+}
+
+// Debug logs with the debug level
+func Debug(v ...interface{}) {
+	output(context.Background(), slog.LevelDebug, fmt.Sprint(v...))
+}
+
+// Debugf logs with the debug level
+func Debugf(format string, v ...interface{}) {
+	output(context.Background(), slog.LevelDebug, fmt.Sprintf(format, v...))
+}
+
+// Info logs with the info level
+func Info(v ...interface{}) {
+	output(context.Background(), slog.LevelInfo, fmt.Sprint(v...))
+}
+
+// Infof logs with the info level
+func Infof(format string, v ...interface{}) {
+	output(context.Background(), slog.LevelInfo, fmt.Sprintf(format, v...))
+}
+
+// Warning logs with the warning level
+func Warning(v ...interface{}) {
+	output(context.Background(), slog.LevelWarn, fmt.Sprint(v...))
+}
+
+// Warningf logs with the warning level
+func Warningf(format string, v ...interface{}) {
+	output(context.Background(), slog.LevelWarn, fmt.Sprintf(format, v...))
+}
+
+// Error logs with the error level
+func Error(v ...interface{}) {
+	output(context.Background(), slog.LevelError, fmt.Sprint(v...))
+}
+
+// Errorf logs with the error level
+func Errorf(format string, v ...interface{}) {
+	output(context.Background(), slog.LevelError, fmt.Sprintf(format, v...))
+}
+
+// Fatal logs with the error level and exits
+func Fatal(v ...interface{}) {
+	output(context.Background(), slog.LevelError, fmt.Sprint(v...))
+	os.Exit(1)
+}
+
+// Fatalf logs with the error level and exits
+func Fatalf(format string, v ...interface{}) {
+	output(context.Background(), slog.LevelError, fmt.Sprintf(format, v...))
+	os.Exit(1)
+}
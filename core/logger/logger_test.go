@@ -0,0 +1,75 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/tarkov-database/rest-api/core/config"
+)
+
+func TestLogger(t *testing.T) {
+	prev := *cfg
+	t.Cleanup(func() {
+		*cfg = prev
+		SetOutput(os.Stdout)
+	})
+
+	cfg.Level, cfg.Format = slog.LevelInfo, FormatJSON
+
+	buf := &bytes.Buffer{}
+	SetOutput(buf)
+
+	Debugf("Hidden %d", 1)
+	Infof("Item %s created\n", "abc")
+	Errorf("Failed: %s", "reason")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Number of entries is %v, expected 2: %s", len(lines), buf)
+	}
+
+	tests := []struct {
+		level string
+		msg   string
+	}{
+		{level: "INFO", msg: "Item abc created"},
+		{level: "ERROR", msg: "Failed: reason"},
+	}
+
+	for i, tt := range tests {
+		e := struct {
+			Level  string `json:"level"`
+			Msg    string `json:"msg"`
+			Source string `json:"source"`
+		}{}
+
+		if err := json.Unmarshal([]byte(lines[i]), &e); err != nil {
+			t.Fatalf("Entry is not JSON: %v", err)
+		}
+
+		if e.Level != tt.level || e.Msg != tt.msg {
+			t.Errorf("Entry is %s %q, expected %s %q", e.Level, e.Msg, tt.level, tt.msg)
+		}
+		if !strings.HasPrefix(e.Source, "logger_test.go:") {
+			t.Errorf("Source is %q, expected the caller", e.Source)
+		}
+	}
+}
+
+func TestConfig(t *testing.T) {
+	c, err := newConfig(config.Log{Level: "warning", Format: "JSON"})
+	if err != nil {
+		t.Fatalf("Configuration failed: %v", err)
+	}
+	if c.Level != slog.LevelWarn || c.Format != FormatJSON {
+		t.Errorf("Configuration is %v %s, expected %v %s", c.Level, c.Format, slog.LevelWarn, FormatJSON)
+	}
+
+	if _, err := newConfig(config.Log{Level: "verbose"}); err == nil {
+		t.Error("Configuration with invalid level succeeded")
+	}
+}
@@ -0,0 +1,56 @@
+package mail
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/config"
+)
+
+var cfg, _ = newConfig(config.Default().Mail)
+
+type settings struct {
+	Driver       string
+	From         string
+	File         string
+	SMTPAddr     string
+	SMTPUser     string
+	SMTPPassword string
+	LinkURL      *url.URL
+	LinkTTL      time.Duration
+}
+
+// Configure applies the configuration to the mail delivery. It has to be
+// called before Init.
+func Configure(c config.Mail) error {
+	s, err := newConfig(c)
+	if err != nil {
+		return err
+	}
+
+	cfg = s
+
+	return nil
+}
+
+func newConfig(c config.Mail) (*settings, error) {
+	s := &settings{
+		Driver:       c.Driver,
+		From:         c.From,
+		File:         c.File,
+		SMTPAddr:     c.SMTPAddr,
+		SMTPUser:     c.SMTPUser,
+		SMTPPassword: c.SMTPPassword,
+		LinkTTL:      c.LinkExpiration.Duration,
+	}
+
+	// One-time links point to a page of the client which submits the token
+	u, err := url.Parse(c.LinkURL)
+	if err != nil || !u.IsAbs() {
+		return s, fmt.Errorf("mail link url %q is not valid", c.LinkURL)
+	}
+	s.LinkURL = u
+
+	return s, nil
+}
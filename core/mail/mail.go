@@ -0,0 +1,143 @@
+package mail
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/smtp"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/logger"
+)
+
+// Message describes an e-mail
+type Message struct {
+	To      string    `json:"to"`
+	Subject string    `json:"subject"`
+	Body    string    `json:"body"`
+	Date    time.Time `json:"date"`
+}
+
+// Mailer delivers messages
+type Mailer interface {
+	Send(m *Message) error
+}
+
+var mailer Mailer
+
+// Init creates the mailer of the configured driver
+func Init() {
+	switch cfg.Driver {
+	case "file":
+		mailer = NewFileMailer(cfg.File)
+	case "smtp":
+		mailer = &smtpMailer{addr: cfg.SMTPAddr, user: cfg.SMTPUser, password: cfg.SMTPPassword}
+	default:
+		mailer = logMailer{}
+	}
+}
+
+// SetMailer sets the mailer used to deliver messages
+func SetMailer(m Mailer) {
+	mailer = m
+}
+
+// Send delivers a message
+func Send(m *Message) error {
+	if mailer == nil {
+		return fmt.Errorf("mailer is not initialized")
+	}
+
+	if m.Date.IsZero() {
+		m.Date = time.Now()
+	}
+
+	return mailer.Send(m)
+}
+
+// Link returns the URL of a one-time link for the purpose and token
+func Link(purpose, token string) string {
+	u := *cfg.LinkURL
+
+	q := u.Query()
+	q.Set("purpose", purpose)
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
+
+// LinkExpirationTime returns the lifetime of one-time links
+func LinkExpirationTime() time.Duration {
+	return cfg.LinkTTL
+}
+
+// logMailer writes messages to the log
+type logMailer struct{}
+
+func (logMailer) Send(m *Message) error {
+	logger.Infof("Mail to %s: %s\n%s", m.To, m.Subject, m.Body)
+	return nil
+}
+
+// FileMailer appends messages as JSON lines to a file
+type FileMailer struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileMailer returns a mailer writing to the file of the given path
+func NewFileMailer(path string) *FileMailer {
+	return &FileMailer{path: path}
+}
+
+// Send implements the Mailer interface
+func (f *FileMailer) Send(m *Message) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return json.NewEncoder(file).Encode(m)
+}
+
+// smtpMailer delivers messages through an SMTP server
+type smtpMailer struct {
+	addr     string
+	user     string
+	password string
+}
+
+func (s *smtpMailer) Send(m *Message) error {
+	var auth smtp.Auth
+	if s.user != "" {
+		host, _, err := net.SplitHostPort(s.addr)
+		if err != nil {
+			return err
+		}
+		auth = smtp.PlainAuth("", s.user, s.password, host)
+	}
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "From: %s\r\n", cfg.From)
+	fmt.Fprintf(buf, "To: %s\r\n", headerValue(m.To))
+	fmt.Fprintf(buf, "Subject: %s\r\n", headerValue(m.Subject))
+	fmt.Fprintf(buf, "Date: %s\r\n", m.Date.Format(time.RFC1123Z))
+	fmt.Fprint(buf, "Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	buf.WriteString(strings.ReplaceAll(m.Body, "\n", "\r\n"))
+
+	return smtp.SendMail(s.addr, auth, cfg.From, []string{m.To}, buf.Bytes())
+}
+
+// headerValue strips line breaks from a header value
+func headerValue(s string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(s)
+}
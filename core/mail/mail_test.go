@@ -0,0 +1,55 @@
+package mail
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileMailer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mail.jsonl")
+
+	SetMailer(NewFileMailer(path))
+	t.Cleanup(func() { SetMailer(nil) })
+
+	for _, subject := range []string{"First", "Second"} {
+		if err := Send(&Message{To: "test@testing.dev", Subject: subject, Body: "Test"}); err != nil {
+			t.Fatalf("Sending mail failed: %s", err)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Opening mail file failed: %s", err)
+	}
+	defer f.Close()
+
+	var msgs []*Message
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		m := &Message{}
+		if err := json.Unmarshal(scanner.Bytes(), m); err != nil {
+			t.Fatalf("Decoding mail failed: %s", err)
+		}
+		msgs = append(msgs, m)
+	}
+
+	if len(msgs) != 2 || msgs[1].Subject != "Second" || msgs[0].Date.IsZero() {
+		t.Errorf("Sending mail failed: unexpected messages %v", msgs)
+	}
+}
+
+func TestLink(t *testing.T) {
+	u, err := url.Parse(Link("reset", "abc"))
+	if err != nil {
+		t.Fatalf("Parsing link failed: %s", err)
+	}
+
+	if q := u.Query(); q.Get("purpose") != "reset" || q.Get("token") != "abc" {
+		t.Errorf("Creating link failed: unexpected query %s", u.RawQuery)
+	}
+}
@@ -0,0 +1,10 @@
+package metrics
+
+import "github.com/tarkov-database/rest-api/core/config"
+
+var cfg = &config.Default().Metrics
+
+// Configure applies the configuration to the metrics
+func Configure(c config.Metrics) {
+	cfg = &c
+}
@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+var (
+	operationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "mongodb",
+		Name:      "operation_duration_seconds",
+		Help:      "Duration of MongoDB operations by command and collection.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+	}, []string{"command", "collection"})
+
+	operationErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "mongodb",
+		Name:      "operation_errors_total",
+		Help:      "Number of failed MongoDB operations by command and collection.",
+	}, []string{"command", "collection"})
+)
+
+// monitoredCommands are the commands issued by the model packages
+var monitoredCommands = map[string]bool{
+	"find":          true,
+	"insert":        true,
+	"update":        true,
+	"delete":        true,
+	"aggregate":     true,
+	"count":         true,
+	"distinct":      true,
+	"findAndModify": true,
+	"getMore":       true,
+}
+
+// commandMonitor keeps the collection of started commands until they finish,
+// as it is only part of the started event
+type commandMonitor struct {
+	started sync.Map
+}
+
+// CommandMonitor returns a monitor recording the latency and errors of the
+// operations of the database client
+func CommandMonitor() *event.CommandMonitor {
+	m := &commandMonitor{}
+
+	return &event.CommandMonitor{
+		Started:   m.start,
+		Succeeded: m.succeed,
+		Failed:    m.fail,
+	}
+}
+
+func (m *commandMonitor) start(_ context.Context, e *event.CommandStartedEvent) {
+	if !cfg.Enabled || !monitoredCommands[e.CommandName] {
+		return
+	}
+
+	collection, _ := e.Command.Lookup(e.CommandName).StringValueOK()
+	if e.CommandName == "getMore" {
+		collection, _ = e.Command.Lookup("collection").StringValueOK()
+	}
+
+	m.started.Store(e.RequestID, collection)
+}
+
+func (m *commandMonitor) finish(name string, id int64, d time.Duration, failed bool) {
+	v, ok := m.started.LoadAndDelete(id)
+	if !ok {
+		return
+	}
+
+	collection := v.(string)
+
+	operationDuration.WithLabelValues(name, collection).Observe(d.Seconds())
+
+	if failed {
+		operationErrors.WithLabelValues(name, collection).Inc()
+	}
+}
+
+func (m *commandMonitor) succeed(_ context.Context, e *event.CommandSucceededEvent) {
+	// Write errors are reported as part of a successful reply
+	failed := false
+	if errs, ok := e.Reply.Lookup("writeErrors").ArrayOK(); ok {
+		vals, _ := errs.Values()
+		failed = len(vals) > 0
+	}
+
+	m.finish(e.CommandName, e.RequestID, e.Duration, failed)
+}
+
+func (m *commandMonitor) fail(_ context.Context, e *event.CommandFailedEvent) {
+	m.finish(e.CommandName, e.RequestID, e.Duration, true)
+}
@@ -0,0 +1,121 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/health"
+	"github.com/tarkov-database/rest-api/internal/httputil"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "tarkov_api"
+
+var registry = prometheus.NewRegistry()
+
+var (
+	requestsInFlight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_in_flight",
+		Help:      "Number of requests currently being served.",
+	})
+
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of HTTP requests by route pattern, method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method", "status"})
+
+	authorizations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "auth",
+		Name:      "authorizations_total",
+		Help:      "Number of request authorizations by outcome.",
+	}, []string{"outcome"})
+
+	cacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "lookups_total",
+		Help:      "Number of response cache lookups by route pattern and result.",
+	}, []string{"route", "result"})
+
+	databaseStatus = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "database",
+		Name:      "status",
+		Help:      "Database status of the last health check, where 0 is OK, 1 is warning and 2 is failure.",
+	}, func() float64 {
+		return float64(health.DatabaseStatus())
+	})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		requestsInFlight,
+		requestDuration,
+		authorizations,
+		cacheLookups,
+		databaseStatus,
+		operationDuration,
+		operationErrors,
+	)
+}
+
+// Enabled checks if metrics are collected
+func Enabled() bool {
+	return cfg.Enabled
+}
+
+// Handler returns a handler recording the duration of the requests of the
+// route. The route is the pattern of the path, so that the number of label
+// values is bounded.
+func Handler(route string, h httprouter.Handle) httprouter.Handle {
+	if !cfg.Enabled {
+		return h
+	}
+
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		start := time.Now()
+
+		requestsInFlight.Inc()
+		defer requestsInFlight.Dec()
+
+		rec := httputil.NewRecorder(w)
+
+		h(rec, r, ps)
+
+		requestDuration.WithLabelValues(route, r.Method, strconv.Itoa(rec.Status)).Observe(time.Since(start).Seconds())
+	}
+}
+
+// ObserveAuthorization counts the outcome of a request authorization
+func ObserveAuthorization(outcome string) {
+	authorizations.WithLabelValues(outcome).Inc()
+}
+
+// ObserveCache counts the result of a response cache lookup of the route
+func ObserveCache(route string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+
+	cacheLookups.WithLabelValues(route, result).Inc()
+}
+
+// Exporter returns a handler exposing the collected metrics in the
+// Prometheus exposition format
+func Exporter() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
@@ -0,0 +1,129 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+func TestHandler(t *testing.T) {
+	route := "/v2/item/:id"
+
+	h := Handler(route, func(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
+		if v := testutil.ToFloat64(requestsInFlight); v != 1 {
+			t.Errorf("Requests in flight are %v, expected 1", v)
+		}
+		if ps.ByName("id") == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte("{}"))
+	})
+
+	for _, id := range []string{"a", "b", "missing"} {
+		ps := httprouter.Params{{Key: "id", Value: id}}
+		h(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v2/item/"+id, nil), ps)
+	}
+
+	if v := testutil.ToFloat64(requestsInFlight); v != 0 {
+		t.Errorf("Requests in flight are %v, expected 0", v)
+	}
+
+	if n := testutil.CollectAndCount(requestDuration); n != 2 {
+		t.Errorf("Number of label combinations is %v, expected 2", n)
+	}
+}
+
+func TestCommandMonitor(t *testing.T) {
+	m := CommandMonitor()
+	ctx := context.Background()
+
+	cmd := func(name, coll string) bson.Raw {
+		b, _ := bson.Marshal(bson.D{{Key: name, Value: coll}})
+		return b
+	}
+	reply := func(writeErrors bool) bson.Raw {
+		d := bson.D{{Key: "ok", Value: 1}}
+		if writeErrors {
+			d = append(d, bson.E{Key: "writeErrors", Value: bson.A{bson.D{{Key: "code", Value: 11000}}}})
+		}
+		b, _ := bson.Marshal(d)
+		return b
+	}
+
+	m.Started(ctx, &event.CommandStartedEvent{Command: cmd("find", "items"), CommandName: "find", RequestID: 1})
+	m.Succeeded(ctx, &event.CommandSucceededEvent{
+		CommandFinishedEvent: event.CommandFinishedEvent{CommandName: "find", RequestID: 1, Duration: time.Millisecond},
+		Reply:                reply(false),
+	})
+
+	m.Started(ctx, &event.CommandStartedEvent{Command: cmd("insert", "items"), CommandName: "insert", RequestID: 2})
+	m.Succeeded(ctx, &event.CommandSucceededEvent{
+		CommandFinishedEvent: event.CommandFinishedEvent{CommandName: "insert", RequestID: 2, Duration: time.Millisecond},
+		Reply:                reply(true),
+	})
+
+	m.Started(ctx, &event.CommandStartedEvent{Command: cmd("delete", "items"), CommandName: "delete", RequestID: 3})
+	m.Failed(ctx, &event.CommandFailedEvent{
+		CommandFinishedEvent: event.CommandFinishedEvent{CommandName: "delete", RequestID: 3, Duration: time.Millisecond},
+	})
+
+	m.Started(ctx, &event.CommandStartedEvent{Command: cmd("ping", ""), CommandName: "ping", RequestID: 4})
+
+	if n := testutil.CollectAndCount(operationDuration); n != 3 {
+		t.Errorf("Number of operation label combinations is %v, expected 3", n)
+	}
+
+	if v := testutil.ToFloat64(operationErrors.WithLabelValues("find", "items")); v != 0 {
+		t.Errorf("Errors of find are %v, expected 0", v)
+	}
+	if v := testutil.ToFloat64(operationErrors.WithLabelValues("insert", "items")); v != 1 {
+		t.Errorf("Errors of insert are %v, expected 1", v)
+	}
+	if v := testutil.ToFloat64(operationErrors.WithLabelValues("delete", "items")); v != 1 {
+		t.Errorf("Errors of delete are %v, expected 1", v)
+	}
+}
+
+func TestExporter(t *testing.T) {
+	ObserveAuthorization("expired")
+
+	rec := httptest.NewRecorder()
+	Exporter().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status code is %v, expected %v", rec.Code, http.StatusOK)
+	}
+
+	body := rec.Body.String()
+	for _, name := range []string{
+		`tarkov_api_auth_authorizations_total{outcome="expired"} 1`,
+		"tarkov_api_database_status",
+		"go_goroutines",
+	} {
+		if !strings.Contains(body, name) {
+			t.Errorf("Metric %q is missing", name)
+		}
+	}
+}
+
+func TestObserveCache(t *testing.T) {
+	ObserveCache("/v2/item", true)
+	ObserveCache("/v2/item", false)
+	ObserveCache("/v2/item", false)
+
+	if v := testutil.ToFloat64(cacheLookups.WithLabelValues("/v2/item", "hit")); v != 1 {
+		t.Errorf("Hits are %v, expected 1", v)
+	}
+	if v := testutil.ToFloat64(cacheLookups.WithLabelValues("/v2/item", "miss")); v != 2 {
+		t.Errorf("Misses are %v, expected 2", v)
+	}
+}
@@ -0,0 +1,198 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collection is the name of the collection which records the applied
+// migrations
+const Collection = "migrations"
+
+// Migration is a versioned forward change of stored documents. Its steps
+// have to be idempotent, since a migration which is interrupted before it is
+// recorded is applied again.
+type Migration struct {
+	Version     int
+	Description string
+	Steps       []Step
+}
+
+// Step updates all documents of a collection matching the filter
+type Step struct {
+	Collection string
+	Filter     bson.D
+
+	// Update is an update document or an aggregation pipeline
+	Update interface{}
+}
+
+// Record describes an applied migration
+type Record struct {
+	Version     int       `json:"version" bson:"_id"`
+	Description string    `json:"description" bson:"description"`
+	Affected    int64     `json:"affected" bson:"affected"`
+	Applied     time.Time `json:"applied" bson:"applied"`
+}
+
+// Status describes a migration and its record, which is nil if the
+// migration is pending
+type Status struct {
+	Version     int
+	Description string
+	Record      *Record
+}
+
+// Result describes a migration which was applied or, in a dry run, would be
+// applied
+type Result struct {
+	Version     int
+	Description string
+
+	// Affected is the number of modified documents or, in a dry run, the
+	// number of matching documents
+	Affected int64
+}
+
+// GetStatus returns the status of all migrations in order of their version
+func GetStatus(ctx context.Context) ([]Status, error) {
+	records, err := getRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := make([]Status, len(migrations))
+	for i, m := range migrations {
+		status[i] = Status{
+			Version:     m.Version,
+			Description: m.Description,
+			Record:      records[m.Version],
+		}
+	}
+
+	return status, nil
+}
+
+// Run applies all pending migrations in order of their version and records
+// them. It stops at the first failing migration and returns the results of
+// the migrations applied before. In a dry run, nothing is modified and the
+// results contain the number of documents matching the steps, which is only
+// exact for the first pending migration.
+func Run(ctx context.Context, dryRun bool) ([]Result, error) {
+	records, err := getRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []Result
+
+	for _, m := range pending(migrations, records) {
+		n, err := apply(ctx, m, dryRun)
+		if err != nil {
+			return results, fmt.Errorf("migration %d failed: %w", m.Version, err)
+		}
+
+		if !dryRun {
+			if err := record(ctx, m, n); err != nil {
+				return results, fmt.Errorf("recording migration %d failed: %w", m.Version, err)
+			}
+		}
+
+		results = append(results, Result{
+			Version:     m.Version,
+			Description: m.Description,
+			Affected:    n,
+		})
+	}
+
+	return results, nil
+}
+
+// pending returns the migrations without a record
+func pending(all []*Migration, records map[int]*Record) []*Migration {
+	var l []*Migration
+	for _, m := range all {
+		if _, ok := records[m.Version]; !ok {
+			l = append(l, m)
+		}
+	}
+
+	return l
+}
+
+func apply(ctx context.Context, m *Migration, dryRun bool) (int64, error) {
+	db := database.GetDB()
+
+	var n int64
+
+	for i, s := range m.Steps {
+		c := db.Collection(s.Collection)
+
+		if dryRun {
+			count, err := c.CountDocuments(ctx, s.Filter)
+			if err != nil {
+				return n, fmt.Errorf("step %d: %w", i+1, err)
+			}
+			n += count
+
+			continue
+		}
+
+		res, err := c.UpdateMany(ctx, s.Filter, s.Update)
+		if err != nil {
+			return n, fmt.Errorf("step %d: %w", i+1, err)
+		}
+		n += res.ModifiedCount
+	}
+
+	return n, nil
+}
+
+func getRecords(ctx context.Context) (map[int]*Record, error) {
+	c := database.GetDB().Collection(Collection)
+
+	cur, err := c.Find(ctx, bson.D{})
+	if err != nil {
+		return nil, fmt.Errorf("reading migration records failed: %w", err)
+	}
+	defer cur.Close(ctx)
+
+	records := make(map[int]*Record)
+
+	for cur.Next(ctx) {
+		r := &Record{}
+		if err := cur.Decode(r); err != nil {
+			return nil, fmt.Errorf("decoding migration record failed: %w", err)
+		}
+		records[r.Version] = r
+	}
+
+	return records, cur.Err()
+}
+
+// record stores the record of an applied migration, keeping the one of a
+// concurrent run
+func record(ctx context.Context, m *Migration, affected int64) error {
+	c := database.GetDB().Collection(Collection)
+
+	filter := bson.D{{Key: "_id", Value: m.Version}}
+	update := bson.D{{Key: "$setOnInsert", Value: bson.D{
+		{Key: "description", Value: m.Description},
+		{Key: "affected", Value: affected},
+		{Key: "applied", Value: time.Now().UTC()},
+	}}}
+
+	_, err := c.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return nil
+	}
+
+	return err
+}
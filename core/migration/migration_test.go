@@ -0,0 +1,48 @@
+package migration
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestMigrations(t *testing.T) {
+	version := 0
+
+	for _, m := range migrations {
+		if m.Version <= version {
+			t.Errorf("Version %d follows version %d", m.Version, version)
+		}
+		version = m.Version
+
+		if m.Description == "" {
+			t.Errorf("Migration %d has no description", m.Version)
+		}
+		if len(m.Steps) == 0 {
+			t.Errorf("Migration %d has no steps", m.Version)
+		}
+
+		for i, s := range m.Steps {
+			if s.Collection == "" || len(s.Filter) == 0 {
+				t.Errorf("Step %d of migration %d has no collection or filter", i+1, m.Version)
+			}
+			if _, err := bson.Marshal(bson.D{{Key: "u", Value: s.Update}}); err != nil {
+				t.Errorf("Update of step %d of migration %d is invalid: %v", i+1, m.Version, err)
+			}
+		}
+	}
+}
+
+func TestPending(t *testing.T) {
+	all := []*Migration{{Version: 1}, {Version: 2}, {Version: 3}}
+	records := map[int]*Record{1: {Version: 1}, 3: {Version: 3}}
+
+	l := pending(all, records)
+	if len(l) != 1 || l[0].Version != 2 {
+		t.Fatalf("Pending migrations are %v, expected version 2", l)
+	}
+
+	if l := pending(all, map[int]*Record{}); len(l) != len(all) {
+		t.Errorf("Pending migrations without records are %v, expected all", l)
+	}
+}
@@ -0,0 +1,142 @@
+package migration
+
+import (
+	"github.com/tarkov-database/rest-api/model/item"
+	"github.com/tarkov-database/rest-api/model/role"
+	"github.com/tarkov-database/rest-api/model/user"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// migrations holds all migrations in order of their version. Versions must
+// never be changed or reused once released.
+var migrations = []*Migration{
+	{
+		Version:     1,
+		Description: "backfill ergonomicsFP from the deprecated ergonomics and drop it",
+		Steps: []Step{
+			{
+				Collection: item.Collection,
+				Filter:     bson.D{{Key: "ergonomics", Value: exists}},
+				Update:     replaceField("ergonomics", "ergonomicsFP", unlessNonZero("ergonomicsFP", toDouble("ergonomics"))),
+			},
+			{
+				Collection: item.Collection,
+				Filter:     bson.D{{Key: "penalties.ergonomics", Value: exists}},
+				Update: replaceField("penalties.ergonomics", "penalties.ergonomicsFP",
+					unlessNonZero("penalties.ergonomicsFP", toDouble("penalties.ergonomics"))),
+			},
+		},
+	},
+	{
+		Version:     2,
+		Description: "drop the unsupported price and rarity of items",
+		Steps: []Step{
+			{
+				Collection: item.Collection,
+				Filter: bson.D{{Key: "$or", Value: bson.A{
+					bson.D{{Key: "price", Value: exists}},
+					bson.D{{Key: "rarity", Value: exists}},
+				}}},
+				Update: bson.D{{Key: "$unset", Value: bson.D{
+					{Key: "price", Value: ""},
+					{Key: "rarity", Value: ""},
+				}}},
+			},
+		},
+	},
+	{
+		Version:     3,
+		Description: "backfill armorComponents of tactical rigs from the deprecated armor and drop it",
+		Steps: []Step{
+			{
+				Collection: item.Collection,
+				Filter: bson.D{
+					{Key: "_kind", Value: item.KindTacticalrig},
+					{Key: "armor", Value: exists},
+				},
+				Update: replaceField("armor", "armorComponents", bson.D{{Key: "$cond", Value: bson.D{
+					{Key: "if", Value: bson.D{{Key: "$and", Value: bson.A{
+						bson.D{{Key: "$eq", Value: bson.A{bson.D{{Key: "$type", Value: "$armor"}}, "object"}}},
+						bson.D{{Key: "$eq", Value: bson.A{
+							bson.D{{Key: "$size", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$armorComponents", bson.A{}}}}}},
+							0,
+						}}},
+					}}}},
+					{Key: "then", Value: bson.A{"$armor"}},
+					{Key: "else", Value: "$armorComponents"},
+				}}}),
+			},
+		},
+	},
+	{
+		Version:     4,
+		Description: "backfill projectiles of ammunition from the deprecated pellets and drop them",
+		Steps: []Step{
+			{
+				Collection: item.Collection,
+				Filter: bson.D{
+					{Key: "_kind", Value: item.KindAmmunition},
+					{Key: "pellets", Value: exists},
+				},
+				Update: replaceField("pellets", "projectiles", unlessNonZero("projectiles", "$pellets")),
+			},
+		},
+	},
+	{
+		Version:     5,
+		Description: "backfill lightBleeding effects from the deprecated bloodloss and drop it",
+		Steps: []Step{
+			{
+				Collection: item.Collection,
+				Filter:     bson.D{{Key: "effects.bloodloss", Value: exists}},
+				Update: replaceField("effects.bloodloss", "effects.lightBleeding", bson.D{
+					{Key: "$ifNull", Value: bson.A{"$effects.lightBleeding", "$effects.bloodloss"}},
+				}),
+			},
+		},
+	},
+	{
+		// Users without roles were allowed every scope before the roles were
+		// introduced, so they keep their access with the admin role
+		Version:     6,
+		Description: "assign the admin role to users without roles",
+		Steps: []Step{
+			{
+				Collection: user.Collection,
+				Filter:     bson.D{{Key: "roles", Value: bson.D{{Key: "$exists", Value: false}}}},
+				Update: bson.D{{Key: "$set", Value: bson.D{
+					{Key: "roles", Value: bson.A{role.Admin}},
+				}}},
+			},
+		},
+	},
+}
+
+var exists = bson.D{{Key: "$exists", Value: true}}
+
+// replaceField returns a pipeline setting the field to the value of the
+// expression and removing the deprecated field
+func replaceField(deprecated, field string, expr interface{}) bson.A {
+	return bson.A{
+		bson.D{{Key: "$set", Value: bson.D{{Key: field, Value: expr}}}},
+		bson.D{{Key: "$unset", Value: deprecated}},
+	}
+}
+
+// unlessNonZero returns an expression evaluating to the field if it is set
+// and non-zero and to the fallback expression otherwise
+func unlessNonZero(field string, fallback interface{}) bson.D {
+	return bson.D{{Key: "$cond", Value: bson.D{
+		{Key: "if", Value: bson.D{{Key: "$eq", Value: bson.A{
+			bson.D{{Key: "$ifNull", Value: bson.A{"$" + field, 0}}},
+			0,
+		}}}},
+		{Key: "then", Value: fallback},
+		{Key: "else", Value: "$" + field},
+	}}}
+}
+
+func toDouble(field string) bson.D {
+	return bson.D{{Key: "$toDouble", Value: "$" + field}}
+}
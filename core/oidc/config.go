@@ -0,0 +1,35 @@
+package oidc
+
+import (
+	"strings"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/config"
+)
+
+var cfg = newConfig(config.Default().OIDC)
+
+type settings struct {
+	Issuer        string
+	ClientID      string
+	AutoProvision bool
+	Roles         []string
+	Timeout       time.Duration
+}
+
+// Configure applies the configuration to the provider login. It has to be
+// called before Init.
+func Configure(c config.OIDC) {
+	cfg = newConfig(c)
+}
+
+func newConfig(c config.OIDC) *settings {
+	// The provider login is disabled without an issuer
+	return &settings{
+		Issuer:        strings.TrimSuffix(c.Issuer, "/"),
+		ClientID:      c.ClientID,
+		AutoProvision: c.AutoProvision,
+		Roles:         c.Roles,
+		Timeout:       c.Timeout.Duration,
+	}
+}
@@ -0,0 +1,235 @@
+package oidc
+
+import (
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+
+	jwtgo "github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	// ErrInvalidToken indicates that an ID token is not valid
+	ErrInvalidToken = errors.New("invalid id token")
+
+	// ErrDiscovery indicates that the provider metadata can't be fetched
+	ErrDiscovery = errors.New("provider discovery failed")
+)
+
+const (
+	// discoveryPath is the path of the provider metadata relative to the issuer
+	discoveryPath = "/.well-known/openid-configuration"
+
+	// keyRefreshInterval limits how often unknown key IDs trigger a JWKS fetch
+	keyRefreshInterval = time.Minute
+
+	leeway = time.Minute
+
+	maxResponseSize = 1 << 20
+)
+
+// signingMethods are the accepted algorithms of ID tokens
+var signingMethods = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+// Claims represents the claims of an ID token
+type Claims struct {
+	jwtgo.RegisteredClaims
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+}
+
+// metadata represents the relevant part of the provider metadata
+type metadata struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+// Provider verifies the ID tokens of an OpenID Connect issuer. The metadata
+// and keys of the issuer are fetched on first use.
+type Provider struct {
+	// Issuer is the issuer identifier of the provider
+	Issuer string
+
+	// ClientID is the expected audience of ID tokens
+	ClientID string
+
+	// AutoProvision allows to create users for unknown e-mail addresses
+	AutoProvision bool
+
+	// Roles are assigned to provisioned users
+	Roles []string
+
+	// Client is used for discovery and key requests
+	Client *http.Client
+
+	mu      sync.Mutex
+	meta    *metadata
+	keys    map[string]crypto.PublicKey
+	fetched time.Time
+}
+
+var provider *Provider
+
+// Init sets up the configured provider
+func Init() {
+	if cfg.Issuer == "" {
+		return
+	}
+
+	provider = &Provider{
+		Issuer:        cfg.Issuer,
+		ClientID:      cfg.ClientID,
+		AutoProvision: cfg.AutoProvision,
+		Roles:         cfg.Roles,
+		Client:        &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+// Default returns the configured provider or nil if the login is disabled
+func Default() *Provider {
+	return provider
+}
+
+// SetProvider replaces the configured provider
+func SetProvider(p *Provider) {
+	provider = p
+}
+
+// Verify verifies an ID token and returns its claims
+func (p *Provider) Verify(s string) (*Claims, error) {
+	clm := &Claims{}
+
+	opts := []jwtgo.ParserOption{
+		jwtgo.WithValidMethods(signingMethods),
+		jwtgo.WithIssuer(p.Issuer),
+		jwtgo.WithAudience(p.ClientID),
+		jwtgo.WithExpirationRequired(),
+		jwtgo.WithIssuedAt(),
+		jwtgo.WithLeeway(leeway),
+	}
+
+	if _, err := jwtgo.ParseWithClaims(s, clm, p.keyFunc, opts...); err != nil {
+		if errors.Is(err, ErrDiscovery) {
+			return nil, err
+		}
+		return nil, errors.Join(ErrInvalidToken, err)
+	}
+
+	return clm, nil
+}
+
+func (p *Provider) keyFunc(t *jwtgo.Token) (interface{}, error) {
+	kid, _ := t.Header["kid"].(string)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.key(kid); ok {
+		return key, nil
+	}
+
+	// An unknown key ID may belong to a rotated key
+	if !p.fetched.IsZero() && time.Since(p.fetched) < keyRefreshInterval {
+		return nil, errors.New("unknown key")
+	}
+
+	if err := p.refresh(); err != nil {
+		return nil, errors.Join(ErrDiscovery, err)
+	}
+
+	if key, ok := p.key(kid); ok {
+		return key, nil
+	}
+
+	return nil, errors.New("unknown key")
+}
+
+// key returns the key of the ID. Tokens without key ID are accepted if the
+// issuer has only one key.
+func (p *Provider) key(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+
+	key, ok := p.keys[kid]
+
+	return key, ok
+}
+
+// refresh fetches the metadata if needed and the keys of the issuer
+func (p *Provider) refresh() error {
+	p.fetched = time.Now()
+
+	if p.meta == nil {
+		meta := &metadata{}
+		if err := p.get(p.Issuer+discoveryPath, meta); err != nil {
+			return err
+		}
+
+		if meta.Issuer != p.Issuer {
+			return fmt.Errorf("issuer mismatch: %s", meta.Issuer)
+		}
+
+		if meta.JWKSURI == "" {
+			return errors.New("no jwks uri")
+		}
+
+		p.meta = meta
+	}
+
+	set := &jwt.JWKS{}
+	if err := p.get(p.meta.JWKSURI, set); err != nil {
+		return err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		key, err := k.PublicKey()
+		if err != nil {
+			continue
+		}
+
+		keys[k.KeyID] = key
+	}
+
+	p.keys = keys
+
+	return nil
+}
+
+func (p *Provider) get(url string, v interface{}) error {
+	client := p.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	res, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s of %s", res.Status, url)
+	}
+
+	return json.NewDecoder(io.LimitReader(res.Body, maxResponseSize)).Decode(v)
+}
@@ -0,0 +1,104 @@
+package oidc
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/oidc/oidctest"
+
+	jwtgo "github.com/golang-jwt/jwt/v5"
+)
+
+func TestVerify(t *testing.T) {
+	iss := oidctest.NewIssuer()
+	defer iss.Close()
+
+	p := &Provider{Issuer: iss.URL, ClientID: "client", Client: iss.Client()}
+
+	clm, err := p.Verify(iss.Token("client", "editor@testing.dev", true))
+	if err != nil {
+		t.Fatalf("Verifying ID token failed: %s", err)
+	}
+
+	if clm.Email != "editor@testing.dev" || !clm.EmailVerified {
+		t.Errorf("Verifying ID token failed: unexpected claims %+v", clm)
+	}
+
+	now := time.Now()
+
+	invalid := map[string]string{
+		"audience": iss.Token("other", "editor@testing.dev", true),
+		"issuer": iss.Sign(jwtgo.MapClaims{
+			"iss": "https://issuer.testing.dev",
+			"aud": "client",
+			"exp": now.Add(time.Minute).Unix(),
+		}),
+		"expiration": iss.Sign(jwtgo.MapClaims{
+			"iss": iss.URL,
+			"aud": "client",
+			"exp": now.Add(-time.Hour).Unix(),
+		}),
+		"no expiration": iss.Sign(jwtgo.MapClaims{
+			"iss": iss.URL,
+			"aud": "client",
+		}),
+	}
+
+	for name, s := range invalid {
+		if _, err := p.Verify(s); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("Verifying ID token failed: invalid %s accepted: %v", name, err)
+		}
+	}
+
+	hmac, err := jwtgo.NewWithClaims(jwtgo.SigningMethodHS256, jwtgo.MapClaims{
+		"iss": iss.URL,
+		"aud": "client",
+		"exp": now.Add(time.Minute).Unix(),
+	}).SignedString([]byte("client"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := p.Verify(hmac); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Verifying ID token failed: HMAC token accepted: %v", err)
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	iss := oidctest.NewIssuer()
+	defer iss.Close()
+
+	p := &Provider{Issuer: iss.URL, ClientID: "client", Client: iss.Client()}
+
+	if _, err := p.Verify(iss.Token("client", "editor@testing.dev", true)); err != nil {
+		t.Fatalf("Verifying ID token failed: %s", err)
+	}
+
+	iss.Rotate()
+
+	s := iss.Token("client", "editor@testing.dev", true)
+
+	// Keys are refetched at most once per interval
+	if _, err := p.Verify(s); err == nil {
+		t.Error("Verifying ID token failed: token of unknown key accepted before refresh")
+	}
+
+	p.fetched = time.Now().Add(-keyRefreshInterval)
+
+	if _, err := p.Verify(s); err != nil {
+		t.Errorf("Verifying ID token failed: token of rotated key: %s", err)
+	}
+}
+
+func TestDiscovery(t *testing.T) {
+	iss := oidctest.NewIssuer()
+	defer iss.Close()
+
+	// The issuer of the metadata must match the configured one
+	p := &Provider{Issuer: iss.URL + "/tenant", ClientID: "client", Client: iss.Client()}
+
+	if _, err := p.Verify(iss.Token("client", "editor@testing.dev", true)); !errors.Is(err, ErrDiscovery) {
+		t.Errorf("Discovery failed: unexpected error %v", err)
+	}
+}
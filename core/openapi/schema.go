@@ -0,0 +1,187 @@
+package openapi
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+)
+
+// Schema describes a JSON schema object
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+	Discriminator        *Discriminator     `json:"discriminator,omitempty"`
+}
+
+// Discriminator describes how polymorphic schemas are distinguished
+type Discriminator struct {
+	PropertyName string            `json:"propertyName"`
+	Mapping      map[string]string `json:"mapping,omitempty"`
+}
+
+// Ref returns a schema that references a component schema
+func Ref(name string) *Schema {
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// Generator generates schemas from Go types based on their JSON encoding.
+// Named struct types are added as component schemas and referenced.
+type Generator struct {
+	schemas   map[string]*Schema
+	names     map[reflect.Type]string
+	overrides map[reflect.Type]*Schema
+}
+
+// NewGenerator creates a new generator which adds component schemas to the given map
+func NewGenerator(schemas map[string]*Schema) *Generator {
+	return &Generator{
+		schemas:   schemas,
+		names:     make(map[reflect.Type]string),
+		overrides: make(map[reflect.Type]*Schema),
+	}
+}
+
+// Override sets the schema used for the type of v,
+// e.g. for types implementing a custom JSON marshaler
+func (g *Generator) Override(v interface{}, s *Schema) {
+	g.overrides[reflect.TypeOf(v)] = s
+}
+
+// Schema returns the schema for the type of v
+func (g *Generator) Schema(v interface{}) *Schema {
+	return g.SchemaOf(reflect.TypeOf(v))
+}
+
+// Name returns the component name of the type of v and
+// makes sure the component schema exists
+func (g *Generator) Name(v interface{}) string {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	g.SchemaOf(t)
+
+	return g.names[t]
+}
+
+// SchemaOf returns the schema for the given type
+func (g *Generator) SchemaOf(t reflect.Type) *Schema {
+	if t == nil {
+		return &Schema{}
+	}
+
+	if s, ok := g.overrides[t]; ok {
+		return s
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return g.SchemaOf(t.Elem())
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: g.SchemaOf(t.Elem())}
+	case reflect.Array:
+		n := t.Len()
+		return &Schema{Type: "array", Items: g.SchemaOf(t.Elem()), MinItems: &n, MaxItems: &n}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.SchemaOf(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		return g.component(t)
+	default:
+		return &Schema{}
+	}
+}
+
+func (g *Generator) component(t reflect.Type) *Schema {
+	if name, ok := g.names[t]; ok {
+		return Ref(name)
+	}
+
+	name := fmt.Sprintf("%s.%s", path.Base(t.PkgPath()), t.Name())
+	for i := 2; g.schemas[name] != nil; i++ {
+		name = fmt.Sprintf("%s.%s%d", path.Base(t.PkgPath()), t.Name(), i)
+	}
+
+	// Register a placeholder first to support recursive types
+	s := &Schema{}
+	g.names[t] = name
+	g.schemas[name] = s
+
+	*s = *g.structSchema(t)
+
+	return Ref(name)
+}
+
+func (g *Generator) structSchema(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+
+	var embedded []*Schema
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.SplitN(tag, ",", 2)[0]
+
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, g.SchemaOf(ft))
+				continue
+			}
+		}
+
+		if !f.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		s.Properties[name] = g.SchemaOf(f.Type)
+	}
+
+	if len(embedded) > 0 {
+		return &Schema{AllOf: append(embedded, s)}
+	}
+
+	return s
+}
@@ -0,0 +1,91 @@
+package openapi
+
+import (
+	"testing"
+)
+
+type testBase struct {
+	ID   string `json:"_id"`
+	Name string `json:"name"`
+}
+
+type testEntity struct {
+	testBase `bson:",inline"`
+
+	Count    int64             `json:"count"`
+	Ratio    float64           `json:"ratio"`
+	Tags     []string          `json:"tags"`
+	Position [2]float64        `json:"position"`
+	Props    map[string]string `json:"props"`
+	Children []testEntity      `json:"children,omitempty"`
+	Secret   string            `json:"-"`
+	hidden   string
+}
+
+func TestGeneratorSchema(t *testing.T) {
+	schemas := make(map[string]*Schema)
+	g := NewGenerator(schemas)
+
+	s := g.Schema(&testEntity{})
+	if s.Ref != "#/components/schemas/openapi.testEntity" {
+		t.Fatalf("Getting schema failed: unexpected reference %q", s.Ref)
+	}
+
+	c, ok := schemas["openapi.testEntity"]
+	if !ok {
+		t.Fatal("Getting schema failed: component not registered")
+	}
+
+	if len(c.AllOf) != 2 {
+		t.Fatalf("Getting schema failed: expected 2 allOf schemas, got %v", len(c.AllOf))
+	}
+
+	if c.AllOf[0].Ref != "#/components/schemas/openapi.testBase" {
+		t.Errorf("Getting schema failed: unexpected embedded reference %q", c.AllOf[0].Ref)
+	}
+
+	props := c.AllOf[1].Properties
+
+	for name, typ := range map[string]string{
+		"count":    "integer",
+		"ratio":    "number",
+		"tags":     "array",
+		"position": "array",
+		"props":    "object",
+		"children": "array",
+	} {
+		p, ok := props[name]
+		if !ok {
+			t.Errorf("Getting schema failed: property %s missing", name)
+			continue
+		}
+		if p.Type != typ {
+			t.Errorf("Getting schema failed: property %s has type %q, expected %q", name, p.Type, typ)
+		}
+	}
+
+	if p := props["position"]; *p.MinItems != 2 || *p.MaxItems != 2 {
+		t.Error("Getting schema failed: array length not set")
+	}
+
+	if p := props["children"]; p.Items.Ref != s.Ref {
+		t.Errorf("Getting schema failed: unexpected recursive reference %q", p.Items.Ref)
+	}
+
+	for _, name := range []string{"Secret", "hidden", "testBase"} {
+		if _, ok := props[name]; ok {
+			t.Errorf("Getting schema failed: property %s should not exist", name)
+		}
+	}
+}
+
+func TestGeneratorOverride(t *testing.T) {
+	type id [12]byte
+
+	g := NewGenerator(make(map[string]*Schema))
+	g.Override(id{}, &Schema{Type: "string"})
+
+	if s := g.Schema(id{}); s.Type != "string" {
+		t.Errorf("Overriding schema failed: unexpected type %q", s.Type)
+	}
+}
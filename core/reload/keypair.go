@@ -0,0 +1,46 @@
+package reload
+
+import (
+	"crypto/tls"
+	"sync/atomic"
+)
+
+// KeyPair is a certificate and private key which is reloaded from its files.
+// Connections of the previous certificate are kept, while new handshakes use
+// the current one.
+type KeyPair struct {
+	certFile, keyFile string
+	cert              atomic.Pointer[tls.Certificate]
+}
+
+// NewKeyPair loads a certificate and private key and registers them for
+// reloading under the given name
+func NewKeyPair(name, certFile, keyFile string) (*KeyPair, error) {
+	kp := &KeyPair{certFile: certFile, keyFile: keyFile}
+
+	if err := kp.load(); err != nil {
+		return nil, err
+	}
+
+	Register(name, kp.load, certFile, keyFile)
+
+	return kp, nil
+}
+
+// load replaces the certificate if both files are valid and match
+func (kp *KeyPair) load() error {
+	cert, err := tls.LoadX509KeyPair(kp.certFile, kp.keyFile)
+	if err != nil {
+		return err
+	}
+
+	kp.cert.Store(&cert)
+
+	return nil
+}
+
+// GetCertificate returns the current certificate. It is meant to be used as
+// tls.Config.GetCertificate.
+func (kp *KeyPair) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return kp.cert.Load(), nil
+}
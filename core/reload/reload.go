@@ -0,0 +1,144 @@
+package reload
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/config"
+	"github.com/tarkov-database/rest-api/core/logger"
+)
+
+var cfg = &config.Default().Reload
+
+// Configure applies the configuration to the reloading. It has to be called
+// before Start.
+func Configure(c config.Reload) {
+	cfg = &c
+}
+
+// Func reloads a component from its files. It must keep the previous state
+// if any of the files is not valid.
+type Func func() error
+
+type target struct {
+	name  string
+	fn    Func
+	files map[string]time.Time
+}
+
+var (
+	mu      sync.Mutex
+	targets []*target
+)
+
+// Register adds a component which is reloaded on SIGHUP or, if watching is
+// enabled, when one of its files changes
+func Register(name string, fn Func, files ...string) {
+	t := &target{name: name, fn: fn, files: make(map[string]time.Time, len(files))}
+
+	for _, f := range files {
+		if f != "" {
+			t.files[f] = modTime(f)
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	targets = append(targets, t)
+}
+
+// Reload reloads all registered components. The errors of all components
+// are returned together.
+func Reload() error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	var errs []error
+	for _, t := range targets {
+		if err := t.reload(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// check reloads the components of which a file has changed since it was last
+// seen
+func check() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	for _, t := range targets {
+		changed := false
+
+		for f, prev := range t.files {
+			if mod := modTime(f); !mod.Equal(prev) {
+				t.files[f], changed = mod, true
+			}
+		}
+
+		if changed {
+			if err := t.reload(); err != nil {
+				logger.Error(err)
+			}
+		}
+	}
+}
+
+func (t *target) reload() error {
+	if err := t.fn(); err != nil {
+		return fmt.Errorf("reloading %s failed: %w", t.name, err)
+	}
+
+	logger.Infof("Reloaded %s", t.name)
+
+	return nil
+}
+
+// modTime returns the modification time of a file, following symbolic links
+// as they are swapped by mounted secrets, or the zero time if it can't be read
+func modTime(path string) time.Time {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return fi.ModTime()
+}
+
+// Start reloads all components on SIGHUP and starts watching their files if
+// a watch interval is set
+func Start() {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGHUP)
+
+	go func() {
+		for range sig {
+			logger.Info("Reloading certificates and keys...")
+
+			if err := Reload(); err != nil {
+				logger.Error(err)
+			}
+		}
+	}()
+
+	if cfg.WatchInterval.Duration <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(cfg.WatchInterval.Duration)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			check()
+		}
+	}()
+}
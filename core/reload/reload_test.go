@@ -0,0 +1,159 @@
+package reload
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/logger"
+)
+
+func init() {
+	logger.SetOutput(io.Discard)
+}
+
+// useTargets replaces the registered components for the duration of the test
+func useTargets(t *testing.T) {
+	t.Helper()
+
+	mu.Lock()
+	prev := targets
+	targets = nil
+	mu.Unlock()
+
+	t.Cleanup(func() {
+		mu.Lock()
+		targets = prev
+		mu.Unlock()
+	})
+}
+
+// writeKeyPair writes a self-signed certificate of the common name and its key
+func writeKeyPair(t *testing.T, dir, name string) (certFile, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile, keyFile = filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return certFile, keyFile
+}
+
+func commonName(t *testing.T, kp *KeyPair) string {
+	t.Helper()
+
+	cert, err := kp.GetCertificate(nil)
+	if err != nil {
+		t.Fatalf("Getting certificate failed: %v", err)
+	}
+
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatalf("Parsing certificate failed: %v", err)
+	}
+
+	return leaf.Subject.CommonName
+}
+
+func TestKeyPair(t *testing.T) {
+	useTargets(t)
+
+	dir := t.TempDir()
+	certFile, keyFile := writeKeyPair(t, dir, "first")
+
+	kp, err := NewKeyPair("certificate", certFile, keyFile)
+	if err != nil {
+		t.Fatalf("Loading key pair failed: %v", err)
+	}
+
+	writeKeyPair(t, dir, "second")
+
+	if err := Reload(); err != nil {
+		t.Fatalf("Reloading failed: %v", err)
+	}
+	if cn := commonName(t, kp); cn != "second" {
+		t.Errorf("Certificate is %q, expected the reloaded one", cn)
+	}
+
+	if err := os.WriteFile(keyFile, []byte("invalid"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Reload(); err == nil {
+		t.Error("Reloading invalid key succeeded")
+	}
+	if cn := commonName(t, kp); cn != "second" {
+		t.Errorf("Certificate is %q, expected the previous one to be kept", cn)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	useTargets(t)
+
+	path := filepath.Join(t.TempDir(), "key.pem")
+	if err := os.WriteFile(path, []byte("first"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := 0
+	Register("key", func() error {
+		calls++
+		return errors.New("invalid key")
+	}, path)
+
+	check()
+
+	if calls != 0 {
+		t.Fatalf("Unchanged file reloaded %v times", calls)
+	}
+
+	// The modification time is set explicitly, as it might not change
+	// within the resolution of the file system
+	if err := os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	check()
+	check()
+
+	if calls != 1 {
+		t.Errorf("Changed file reloaded %v times, expected once", calls)
+	}
+}
@@ -0,0 +1,119 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/tarkov-database/rest-api/core/rpc/pb"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// serviceScopes maps the services to their required scope. A qualified
+// scope of a service is granted by any qualifier, so that the methods have to
+// check the scope qualified by the kind or ID of the request.
+var serviceScopes = map[string]string{
+	pb.ItemService_ServiceDesc.ServiceName:      jwt.QualifyScope(jwt.ScopeItemRead, jwt.ScopeWildcard),
+	pb.LocationService_ServiceDesc.ServiceName:  jwt.QualifyScope(jwt.ScopeLocationRead, jwt.ScopeWildcard),
+	pb.HideoutService_ServiceDesc.ServiceName:   jwt.ScopeHideoutRead,
+	pb.StatisticService_ServiceDesc.ServiceName: jwt.ScopeStatisticRead,
+}
+
+// scopeOf returns the required scope of a full method name
+func scopeOf(method string) (string, bool) {
+	service := strings.SplitN(strings.TrimPrefix(method, "/"), "/", 2)[0]
+	scope, ok := serviceScopes[service]
+
+	return scope, ok
+}
+
+// authenticate authenticates the API key of the "x-api-key" metadata
+// or the bearer token of the "authorization" metadata
+func authenticate(ctx context.Context) (*jwt.Claims, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if keys := md.Get(strings.ToLower(jwt.HeaderAPIKey)); len(keys) > 0 {
+		return jwt.AuthenticateAPIKey(ctx, keys[0])
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, jwt.ErrNoAuthHeader
+	}
+
+	value := strings.TrimSpace(values[0])
+	if !strings.HasPrefix(value, "Bearer ") {
+		return nil, jwt.ErrInvalidAuthHeader
+	}
+
+	return jwt.Authenticate(ctx, strings.TrimPrefix(value, "Bearer "))
+}
+
+// authorize authenticates the context against the scope of the method
+// and returns a context containing the claims
+func authorize(ctx context.Context, method string) (context.Context, error) {
+	scope, ok := scopeOf(method)
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "unknown method %s", method)
+	}
+
+	claims, err := authenticate(ctx)
+	if errors.Is(err, model.ErrInternalError) {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if !claims.HasScope(scope) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s: %s required", jwt.ErrInvalidScope, scope)
+	}
+
+	return jwt.NewContext(ctx, claims), nil
+}
+
+// checkScope checks if the claims of the context contain the scope
+func checkScope(ctx context.Context, scope string) error {
+	if claims, ok := jwt.FromContext(ctx); !ok || !claims.HasScope(scope) {
+		return status.Errorf(codes.PermissionDenied, "%s: %s required", jwt.ErrInvalidScope, scope)
+	}
+
+	return nil
+}
+
+// unaryAuth is the unary interceptor for the authorization
+func unaryAuth(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// streamAuth is the stream interceptor for the authorization
+func streamAuth(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authStream{ServerStream: ss, ctx: ctx})
+}
+
+// authStream is a server stream with the context of the authorization
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context implements the grpc.ServerStream interface
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,15 @@
+package rpc
+
+import "github.com/tarkov-database/rest-api/core/config"
+
+var cfg = &config.Default().GRPC
+
+// Configure applies the configuration to the gRPC server
+func Configure(c config.GRPC) {
+	cfg = &c
+}
+
+// Enabled reports whether the gRPC server is enabled
+func Enabled() bool {
+	return cfg.Enabled
+}
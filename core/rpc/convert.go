@@ -0,0 +1,179 @@
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tarkov-database/rest-api/core/rpc/pb"
+	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/model/item"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// pageSize is the number of entities fetched per query while streaming
+	pageSize = 100
+
+	// headerTotalCount is the header metadata key of the total count of a list call
+	headerTotalCount = "x-total-count"
+)
+
+var unmarshalOpts = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+// toMessage converts a model entity into a protobuf message using the JSON
+// representation of the entity, which the field names of the messages follow
+func toMessage(v interface{}, m proto.Message) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return unmarshalOpts.Unmarshal(b, m)
+}
+
+// toItem converts an item entity into an item message of its kind
+func toItem(e item.Entity) (*pb.Item, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err = json.Marshal(map[string]json.RawMessage{e.GetKind().String(): b})
+	if err != nil {
+		return nil, err
+	}
+
+	m := &pb.Item{}
+	if err := unmarshalOpts.Unmarshal(b, m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// toStatus converts a model error into a status error
+func toStatus(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, model.ErrNoResult):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrInvalidObjectID),
+		errors.Is(err, model.ErrInvalidInput),
+		errors.Is(err, model.ErrInvalidKind):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrInternalError):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// getSort returns the sort of the options or the default
+func getSort(def string, opts *pb.ListOptions) bson.D {
+	sortStr := def
+	if s := opts.GetSort(); len(s) > 1 {
+		sortStr = s
+	}
+
+	if strings.HasPrefix(sortStr, "-") {
+		return bson.D{{Key: strings.TrimPrefix(sortStr, "-"), Value: -1}}
+	}
+
+	return bson.D{{Key: sortStr, Value: 1}}
+}
+
+var regexNonAlnumBlankPunct = regexp.MustCompile(`[^[:alnum:][:blank:][:punct:]]`)
+
+// checkText checks the length and characters of a text query
+func checkText(txt string) error {
+	if l := len(txt); l < 3 || l > 32 {
+		return status.Error(codes.InvalidArgument, "text has an invalid length")
+	}
+
+	if regexNonAlnumBlankPunct.MatchString(txt) {
+		return status.Error(codes.InvalidArgument, "text contains invalid characters")
+	}
+
+	return nil
+}
+
+// checkIDs checks the number of IDs of a query
+func checkIDs(ids []string) error {
+	if len(ids) > 100 {
+		return status.Error(codes.InvalidArgument, "ID limit exceeded")
+	}
+
+	return nil
+}
+
+// fetchFunc fetches one page of a list
+type fetchFunc func(limit, offset int64) (*model.Result, error)
+
+// streamList fetches the pages of a list and sends the entities to the stream.
+// The total count is sent as header metadata before the first entity.
+func streamList(ss grpc.ServerStream, opts *pb.ListOptions, fetch fetchFunc, send func(v interface{}) error) error {
+	limit, offset := opts.GetLimit(), opts.GetOffset()
+	if limit < 0 || offset < 0 {
+		return status.Error(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+
+	var sent int64
+	for {
+		n := int64(pageSize)
+		if limit > 0 && limit-sent < n {
+			n = limit - sent
+		}
+
+		result, err := fetch(n, offset+sent)
+		if err != nil && !errors.Is(err, model.ErrNoResult) {
+			return toStatus(err)
+		}
+		if result == nil {
+			result = &model.Result{}
+		}
+
+		if sent == 0 {
+			md := metadata.Pairs(headerTotalCount, strconv.FormatInt(result.Count, 10))
+			if err := ss.SetHeader(md); err != nil {
+				return err
+			}
+		}
+
+		for _, v := range result.Items {
+			if err := send(v); err != nil {
+				return err
+			}
+			sent++
+		}
+
+		switch {
+		case int64(len(result.Items)) < n,
+			limit > 0 && sent >= limit,
+			offset+sent >= result.Count:
+			return nil
+		}
+
+		if err := ss.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+	}
+}
+
+// conversionError returns the status error of a failed conversion
+func conversionError(err error) error {
+	return status.Error(codes.Internal, fmt.Sprintf("conversion error: %s", err))
+}
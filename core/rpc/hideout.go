@@ -0,0 +1,138 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/tarkov-database/rest-api/core/rpc/pb"
+	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/model/hideout/module"
+	"github.com/tarkov-database/rest-api/model/hideout/production"
+)
+
+type hideoutServer struct {
+	pb.UnimplementedHideoutServiceServer
+}
+
+// GetModule implements the HideoutService
+func (s *hideoutServer) GetModule(ctx context.Context, req *pb.GetRequest) (*pb.Module, error) {
+	mod, err := module.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	m := &pb.Module{}
+	if err := toMessage(mod, m); err != nil {
+		return nil, conversionError(err)
+	}
+
+	return m, nil
+}
+
+// ListModules implements the HideoutService
+func (s *hideoutServer) ListModules(req *pb.ListModulesRequest, stream pb.HideoutService_ListModulesServer) error {
+	ctx := stream.Context()
+
+	var fetch fetchFunc
+
+	switch {
+	case len(req.GetIds()) > 0:
+		if err := checkIDs(req.GetIds()); err != nil {
+			return err
+		}
+
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return module.GetByIDs(ctx, req.GetIds(), moduleOptions(req.GetOptions(), limit, offset))
+		}
+	case req.GetText() != "":
+		if err := checkText(req.GetText()); err != nil {
+			return err
+		}
+
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return module.GetByText(ctx, req.GetText(), moduleOptions(req.GetOptions(), limit, offset))
+		}
+	case req.GetMaterial() != "":
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return module.GetByMaterial(ctx, req.GetMaterial(), moduleOptions(req.GetOptions(), limit, offset))
+		}
+	default:
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return module.GetAll(ctx, moduleOptions(req.GetOptions(), limit, offset))
+		}
+	}
+
+	return streamList(stream, req.GetOptions(), fetch, func(v interface{}) error {
+		m := &pb.Module{}
+		if err := toMessage(v, m); err != nil {
+			return conversionError(err)
+		}
+
+		return stream.Send(m)
+	})
+}
+
+// GetProduction implements the HideoutService
+func (s *hideoutServer) GetProduction(ctx context.Context, req *pb.GetRequest) (*pb.Production, error) {
+	prod, err := production.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	m := &pb.Production{}
+	if err := toMessage(prod, m); err != nil {
+		return nil, conversionError(err)
+	}
+
+	return m, nil
+}
+
+// ListProductions implements the HideoutService
+func (s *hideoutServer) ListProductions(req *pb.ListProductionsRequest, stream pb.HideoutService_ListProductionsServer) error {
+	ctx := stream.Context()
+
+	var fetch fetchFunc
+
+	switch {
+	case len(req.GetIds()) > 0:
+		if err := checkIDs(req.GetIds()); err != nil {
+			return err
+		}
+
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return production.GetByIDs(ctx, req.GetIds(), productionOptions(req.GetOptions(), limit, offset))
+		}
+	case req.GetModule() != "":
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return production.GetByModule(ctx, req.GetModule(), productionOptions(req.GetOptions(), limit, offset))
+		}
+	case req.GetMaterial() != "":
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return production.GetByMaterial(ctx, req.GetMaterial(), productionOptions(req.GetOptions(), limit, offset))
+		}
+	case req.GetOutcome() != "":
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return production.GetByOutcome(ctx, req.GetOutcome(), productionOptions(req.GetOptions(), limit, offset))
+		}
+	default:
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return production.GetAll(ctx, productionOptions(req.GetOptions(), limit, offset))
+		}
+	}
+
+	return streamList(stream, req.GetOptions(), fetch, func(v interface{}) error {
+		m := &pb.Production{}
+		if err := toMessage(v, m); err != nil {
+			return conversionError(err)
+		}
+
+		return stream.Send(m)
+	})
+}
+
+func moduleOptions(opts *pb.ListOptions, limit, offset int64) *module.Options {
+	return &module.Options{Sort: getSort("-_modified", opts), Limit: limit, Offset: offset}
+}
+
+func productionOptions(opts *pb.ListOptions, limit, offset int64) *production.Options {
+	return &production.Options{Sort: getSort("-_modified", opts), Limit: limit, Offset: offset}
+}
@@ -0,0 +1,97 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/tarkov-database/rest-api/core/rpc/pb"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/model/item"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type itemServer struct {
+	pb.UnimplementedItemServiceServer
+}
+
+// GetItem implements the ItemService
+func (s *itemServer) GetItem(ctx context.Context, req *pb.GetItemRequest) (*pb.Item, error) {
+	kind := item.Kind(req.GetKind())
+	if !kind.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "kind not found")
+	}
+
+	if err := checkScope(ctx, jwt.QualifyScopeValue(jwt.ScopeItemRead, kind.String())); err != nil {
+		return nil, err
+	}
+
+	e, err := item.GetByID(ctx, req.GetId(), kind)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	m, err := toItem(e)
+	if err != nil {
+		return nil, conversionError(err)
+	}
+
+	return m, nil
+}
+
+// ListItems implements the ItemService
+func (s *itemServer) ListItems(req *pb.ListItemsRequest, stream pb.ItemService_ListItemsServer) error {
+	ctx := stream.Context()
+
+	kind := item.Kind(req.GetKind())
+	if !kind.IsValid() {
+		return status.Error(codes.InvalidArgument, "kind not found")
+	}
+
+	if err := checkScope(ctx, jwt.QualifyScopeValue(jwt.ScopeItemRead, kind.String())); err != nil {
+		return err
+	}
+
+	var fetch fetchFunc
+
+	switch {
+	case len(req.GetIds()) > 0:
+		if err := checkIDs(req.GetIds()); err != nil {
+			return err
+		}
+
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return item.GetByIDs(ctx, req.GetIds(), kind, itemOptions(req.GetOptions(), limit, offset))
+		}
+	case req.GetText() != "":
+		if err := checkText(req.GetText()); err != nil {
+			return err
+		}
+
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return item.GetByText(ctx, req.GetText(), itemOptions(req.GetOptions(), limit, offset), kind)
+		}
+	default:
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return item.GetAll(ctx, nil, kind, itemOptions(req.GetOptions(), limit, offset))
+		}
+	}
+
+	return streamList(stream, req.GetOptions(), fetch, func(v interface{}) error {
+		m, err := toItem(v.(item.Entity))
+		if err != nil {
+			return conversionError(err)
+		}
+
+		return stream.Send(m)
+	})
+}
+
+func itemOptions(opts *pb.ListOptions, limit, offset int64) *item.Options {
+	return &item.Options{
+		Sort:   getSort("-_modified", opts),
+		Limit:  limit,
+		Offset: offset,
+	}
+}
@@ -0,0 +1,210 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/tarkov-database/rest-api/core/rpc/pb"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/model/location"
+	"github.com/tarkov-database/rest-api/model/location/feature"
+	"github.com/tarkov-database/rest-api/model/location/featuregroup"
+)
+
+type locationServer struct {
+	pb.UnimplementedLocationServiceServer
+}
+
+// GetLocation implements the LocationService
+func (s *locationServer) GetLocation(ctx context.Context, req *pb.GetRequest) (*pb.Location, error) {
+	if err := checkScope(ctx, jwt.QualifyScopeValue(jwt.ScopeLocationRead, req.GetId())); err != nil {
+		return nil, err
+	}
+
+	loc, err := location.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	m := &pb.Location{}
+	if err := toMessage(loc, m); err != nil {
+		return nil, conversionError(err)
+	}
+
+	return m, nil
+}
+
+// ListLocations implements the LocationService
+func (s *locationServer) ListLocations(req *pb.ListLocationsRequest, stream pb.LocationService_ListLocationsServer) error {
+	ctx := stream.Context()
+
+	if err := checkScope(ctx, jwt.ScopeLocationRead); err != nil {
+		return err
+	}
+
+	var fetch fetchFunc
+
+	switch {
+	case req.GetText() != "":
+		if err := checkText(req.GetText()); err != nil {
+			return err
+		}
+
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return location.GetByText(ctx, req.GetText(), locationOptions(req.GetOptions(), limit, offset))
+		}
+	case req.Available != nil:
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return location.GetByAvailability(ctx, req.GetAvailable(), locationOptions(req.GetOptions(), limit, offset))
+		}
+	default:
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return location.GetAll(ctx, locationOptions(req.GetOptions(), limit, offset))
+		}
+	}
+
+	return streamList(stream, req.GetOptions(), fetch, func(v interface{}) error {
+		m := &pb.Location{}
+		if err := toMessage(v, m); err != nil {
+			return conversionError(err)
+		}
+
+		return stream.Send(m)
+	})
+}
+
+// GetFeature implements the LocationService
+func (s *locationServer) GetFeature(ctx context.Context, req *pb.GetLocationEntityRequest) (*pb.Feature, error) {
+	if err := checkScope(ctx, jwt.QualifyScopeValue(jwt.ScopeLocationRead, req.GetLocation())); err != nil {
+		return nil, err
+	}
+
+	ft, err := feature.GetByID(ctx, req.GetId(), req.GetLocation())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	m := &pb.Feature{}
+	if err := toMessage(ft, m); err != nil {
+		return nil, conversionError(err)
+	}
+
+	return m, nil
+}
+
+// ListFeatures implements the LocationService
+func (s *locationServer) ListFeatures(req *pb.ListFeaturesRequest, stream pb.LocationService_ListFeaturesServer) error {
+	ctx := stream.Context()
+
+	if err := checkScope(ctx, jwt.QualifyScopeValue(jwt.ScopeLocationRead, req.GetLocation())); err != nil {
+		return err
+	}
+
+	if _, err := location.GetByID(ctx, req.GetLocation()); err != nil {
+		return toStatus(err)
+	}
+
+	var fetch fetchFunc
+
+	switch {
+	case req.GetText() != "":
+		if err := checkText(req.GetText()); err != nil {
+			return err
+		}
+
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return feature.GetByText(ctx, req.GetText(), req.GetLocation(), featureOptions(req.GetOptions(), limit, offset))
+		}
+	case req.GetGroup() != "":
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return feature.GetByGroup(ctx, req.GetGroup(), req.GetLocation(), featureOptions(req.GetOptions(), limit, offset))
+		}
+	default:
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return feature.GetAll(ctx, req.GetLocation(), featureOptions(req.GetOptions(), limit, offset))
+		}
+	}
+
+	return streamList(stream, req.GetOptions(), fetch, func(v interface{}) error {
+		m := &pb.Feature{}
+		if err := toMessage(v, m); err != nil {
+			return conversionError(err)
+		}
+
+		return stream.Send(m)
+	})
+}
+
+// GetFeatureGroup implements the LocationService
+func (s *locationServer) GetFeatureGroup(ctx context.Context, req *pb.GetLocationEntityRequest) (*pb.FeatureGroup, error) {
+	if err := checkScope(ctx, jwt.QualifyScopeValue(jwt.ScopeLocationRead, req.GetLocation())); err != nil {
+		return nil, err
+	}
+
+	fg, err := featuregroup.GetByID(ctx, req.GetId(), req.GetLocation())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	m := &pb.FeatureGroup{}
+	if err := toMessage(fg, m); err != nil {
+		return nil, conversionError(err)
+	}
+
+	return m, nil
+}
+
+// ListFeatureGroups implements the LocationService
+func (s *locationServer) ListFeatureGroups(req *pb.ListFeatureGroupsRequest, stream pb.LocationService_ListFeatureGroupsServer) error {
+	ctx := stream.Context()
+
+	if err := checkScope(ctx, jwt.QualifyScopeValue(jwt.ScopeLocationRead, req.GetLocation())); err != nil {
+		return err
+	}
+
+	if _, err := location.GetByID(ctx, req.GetLocation()); err != nil {
+		return toStatus(err)
+	}
+
+	var fetch fetchFunc
+
+	switch {
+	case req.GetText() != "":
+		if err := checkText(req.GetText()); err != nil {
+			return err
+		}
+
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return featuregroup.GetByText(ctx, req.GetText(), req.GetLocation(), featureGroupOptions(req.GetOptions(), limit, offset))
+		}
+	case len(req.GetTags()) > 0:
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return featuregroup.GetByTags(ctx, req.GetTags(), req.GetLocation(), featureGroupOptions(req.GetOptions(), limit, offset))
+		}
+	default:
+		fetch = func(limit, offset int64) (*model.Result, error) {
+			return featuregroup.GetAll(ctx, req.GetLocation(), featureGroupOptions(req.GetOptions(), limit, offset))
+		}
+	}
+
+	return streamList(stream, req.GetOptions(), fetch, func(v interface{}) error {
+		m := &pb.FeatureGroup{}
+		if err := toMessage(v, m); err != nil {
+			return conversionError(err)
+		}
+
+		return stream.Send(m)
+	})
+}
+
+func locationOptions(opts *pb.ListOptions, limit, offset int64) *location.Options {
+	return &location.Options{Sort: getSort("-_modified", opts), Limit: limit, Offset: offset}
+}
+
+func featureOptions(opts *pb.ListOptions, limit, offset int64) *feature.Options {
+	return &feature.Options{Sort: getSort("-_modified", opts), Limit: limit, Offset: offset}
+}
+
+func featureGroupOptions(opts *pb.ListOptions, limit, offset int64) *featuregroup.Options {
+	return &featuregroup.Options{Sort: getSort("-_modified", opts), Limit: limit, Offset: offset}
+}
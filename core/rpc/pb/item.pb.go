@@ -0,0 +1,12701 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: item.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Item holds the entity of exactly one item kind
+type Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Kind:
+	//	*Item_Ammunition
+	//	*Item_Armor
+	//	*Item_Backpack
+	//	*Item_Barter
+	//	*Item_Clothing
+	//	*Item_Common
+	//	*Item_Container
+	//	*Item_Firearm
+	//	*Item_Food
+	//	*Item_Grenade
+	//	*Item_Headphone
+	//	*Item_Key
+	//	*Item_Magazine
+	//	*Item_Map
+	//	*Item_Medical
+	//	*Item_Melee
+	//	*Item_Modification
+	//	*Item_ModificationBarrel
+	//	*Item_ModificationAuxiliary
+	//	*Item_ModificationBipod
+	//	*Item_ModificationCharge
+	//	*Item_ModificationDevice
+	//	*Item_ModificationForegrip
+	//	*Item_ModificationGasblock
+	//	*Item_ModificationGoggles
+	//	*Item_ModificationHandguard
+	//	*Item_ModificationLauncher
+	//	*Item_ModificationMount
+	//	*Item_ModificationMuzzle
+	//	*Item_ModificationPistolgrip
+	//	*Item_ModificationReceiver
+	//	*Item_ModificationSight
+	//	*Item_ModificationSightSpecial
+	//	*Item_ModificationStock
+	//	*Item_Money
+	//	*Item_Tacticalrig
+	Kind isItem_Kind `protobuf_oneof:"kind"`
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *Item) GetKind() isItem_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *Item) GetAmmunition() *Ammunition {
+	if x, ok := x.GetKind().(*Item_Ammunition); ok {
+		return x.Ammunition
+	}
+	return nil
+}
+
+func (x *Item) GetArmor() *Armor {
+	if x, ok := x.GetKind().(*Item_Armor); ok {
+		return x.Armor
+	}
+	return nil
+}
+
+func (x *Item) GetBackpack() *Backpack {
+	if x, ok := x.GetKind().(*Item_Backpack); ok {
+		return x.Backpack
+	}
+	return nil
+}
+
+func (x *Item) GetBarter() *Barter {
+	if x, ok := x.GetKind().(*Item_Barter); ok {
+		return x.Barter
+	}
+	return nil
+}
+
+func (x *Item) GetClothing() *Clothing {
+	if x, ok := x.GetKind().(*Item_Clothing); ok {
+		return x.Clothing
+	}
+	return nil
+}
+
+func (x *Item) GetCommon() *CommonItem {
+	if x, ok := x.GetKind().(*Item_Common); ok {
+		return x.Common
+	}
+	return nil
+}
+
+func (x *Item) GetContainer() *Container {
+	if x, ok := x.GetKind().(*Item_Container); ok {
+		return x.Container
+	}
+	return nil
+}
+
+func (x *Item) GetFirearm() *Firearm {
+	if x, ok := x.GetKind().(*Item_Firearm); ok {
+		return x.Firearm
+	}
+	return nil
+}
+
+func (x *Item) GetFood() *Food {
+	if x, ok := x.GetKind().(*Item_Food); ok {
+		return x.Food
+	}
+	return nil
+}
+
+func (x *Item) GetGrenade() *Grenade {
+	if x, ok := x.GetKind().(*Item_Grenade); ok {
+		return x.Grenade
+	}
+	return nil
+}
+
+func (x *Item) GetHeadphone() *Headphone {
+	if x, ok := x.GetKind().(*Item_Headphone); ok {
+		return x.Headphone
+	}
+	return nil
+}
+
+func (x *Item) GetKey() *Key {
+	if x, ok := x.GetKind().(*Item_Key); ok {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *Item) GetMagazine() *Magazine {
+	if x, ok := x.GetKind().(*Item_Magazine); ok {
+		return x.Magazine
+	}
+	return nil
+}
+
+func (x *Item) GetMap() *Map {
+	if x, ok := x.GetKind().(*Item_Map); ok {
+		return x.Map
+	}
+	return nil
+}
+
+func (x *Item) GetMedical() *Medical {
+	if x, ok := x.GetKind().(*Item_Medical); ok {
+		return x.Medical
+	}
+	return nil
+}
+
+func (x *Item) GetMelee() *Melee {
+	if x, ok := x.GetKind().(*Item_Melee); ok {
+		return x.Melee
+	}
+	return nil
+}
+
+func (x *Item) GetModification() *Modification {
+	if x, ok := x.GetKind().(*Item_Modification); ok {
+		return x.Modification
+	}
+	return nil
+}
+
+func (x *Item) GetModificationBarrel() *Barrel {
+	if x, ok := x.GetKind().(*Item_ModificationBarrel); ok {
+		return x.ModificationBarrel
+	}
+	return nil
+}
+
+func (x *Item) GetModificationAuxiliary() *Auxiliary {
+	if x, ok := x.GetKind().(*Item_ModificationAuxiliary); ok {
+		return x.ModificationAuxiliary
+	}
+	return nil
+}
+
+func (x *Item) GetModificationBipod() *Bipod {
+	if x, ok := x.GetKind().(*Item_ModificationBipod); ok {
+		return x.ModificationBipod
+	}
+	return nil
+}
+
+func (x *Item) GetModificationCharge() *Charge {
+	if x, ok := x.GetKind().(*Item_ModificationCharge); ok {
+		return x.ModificationCharge
+	}
+	return nil
+}
+
+func (x *Item) GetModificationDevice() *Device {
+	if x, ok := x.GetKind().(*Item_ModificationDevice); ok {
+		return x.ModificationDevice
+	}
+	return nil
+}
+
+func (x *Item) GetModificationForegrip() *Foregrip {
+	if x, ok := x.GetKind().(*Item_ModificationForegrip); ok {
+		return x.ModificationForegrip
+	}
+	return nil
+}
+
+func (x *Item) GetModificationGasblock() *GasBlock {
+	if x, ok := x.GetKind().(*Item_ModificationGasblock); ok {
+		return x.ModificationGasblock
+	}
+	return nil
+}
+
+func (x *Item) GetModificationGoggles() *Goggles {
+	if x, ok := x.GetKind().(*Item_ModificationGoggles); ok {
+		return x.ModificationGoggles
+	}
+	return nil
+}
+
+func (x *Item) GetModificationHandguard() *Handguard {
+	if x, ok := x.GetKind().(*Item_ModificationHandguard); ok {
+		return x.ModificationHandguard
+	}
+	return nil
+}
+
+func (x *Item) GetModificationLauncher() *Launcher {
+	if x, ok := x.GetKind().(*Item_ModificationLauncher); ok {
+		return x.ModificationLauncher
+	}
+	return nil
+}
+
+func (x *Item) GetModificationMount() *Mount {
+	if x, ok := x.GetKind().(*Item_ModificationMount); ok {
+		return x.ModificationMount
+	}
+	return nil
+}
+
+func (x *Item) GetModificationMuzzle() *Muzzle {
+	if x, ok := x.GetKind().(*Item_ModificationMuzzle); ok {
+		return x.ModificationMuzzle
+	}
+	return nil
+}
+
+func (x *Item) GetModificationPistolgrip() *PistolGrip {
+	if x, ok := x.GetKind().(*Item_ModificationPistolgrip); ok {
+		return x.ModificationPistolgrip
+	}
+	return nil
+}
+
+func (x *Item) GetModificationReceiver() *Receiver {
+	if x, ok := x.GetKind().(*Item_ModificationReceiver); ok {
+		return x.ModificationReceiver
+	}
+	return nil
+}
+
+func (x *Item) GetModificationSight() *Sight {
+	if x, ok := x.GetKind().(*Item_ModificationSight); ok {
+		return x.ModificationSight
+	}
+	return nil
+}
+
+func (x *Item) GetModificationSightSpecial() *SightSpecial {
+	if x, ok := x.GetKind().(*Item_ModificationSightSpecial); ok {
+		return x.ModificationSightSpecial
+	}
+	return nil
+}
+
+func (x *Item) GetModificationStock() *Stock {
+	if x, ok := x.GetKind().(*Item_ModificationStock); ok {
+		return x.ModificationStock
+	}
+	return nil
+}
+
+func (x *Item) GetMoney() *Money {
+	if x, ok := x.GetKind().(*Item_Money); ok {
+		return x.Money
+	}
+	return nil
+}
+
+func (x *Item) GetTacticalrig() *TacticalRig {
+	if x, ok := x.GetKind().(*Item_Tacticalrig); ok {
+		return x.Tacticalrig
+	}
+	return nil
+}
+
+type isItem_Kind interface {
+	isItem_Kind()
+}
+
+type Item_Ammunition struct {
+	Ammunition *Ammunition `protobuf:"bytes,1,opt,name=ammunition,proto3,oneof"`
+}
+
+type Item_Armor struct {
+	Armor *Armor `protobuf:"bytes,2,opt,name=armor,proto3,oneof"`
+}
+
+type Item_Backpack struct {
+	Backpack *Backpack `protobuf:"bytes,3,opt,name=backpack,proto3,oneof"`
+}
+
+type Item_Barter struct {
+	Barter *Barter `protobuf:"bytes,4,opt,name=barter,proto3,oneof"`
+}
+
+type Item_Clothing struct {
+	Clothing *Clothing `protobuf:"bytes,5,opt,name=clothing,proto3,oneof"`
+}
+
+type Item_Common struct {
+	Common *CommonItem `protobuf:"bytes,6,opt,name=common,proto3,oneof"`
+}
+
+type Item_Container struct {
+	Container *Container `protobuf:"bytes,7,opt,name=container,proto3,oneof"`
+}
+
+type Item_Firearm struct {
+	Firearm *Firearm `protobuf:"bytes,8,opt,name=firearm,proto3,oneof"`
+}
+
+type Item_Food struct {
+	Food *Food `protobuf:"bytes,9,opt,name=food,proto3,oneof"`
+}
+
+type Item_Grenade struct {
+	Grenade *Grenade `protobuf:"bytes,10,opt,name=grenade,proto3,oneof"`
+}
+
+type Item_Headphone struct {
+	Headphone *Headphone `protobuf:"bytes,11,opt,name=headphone,proto3,oneof"`
+}
+
+type Item_Key struct {
+	Key *Key `protobuf:"bytes,12,opt,name=key,proto3,oneof"`
+}
+
+type Item_Magazine struct {
+	Magazine *Magazine `protobuf:"bytes,13,opt,name=magazine,proto3,oneof"`
+}
+
+type Item_Map struct {
+	Map *Map `protobuf:"bytes,14,opt,name=map,proto3,oneof"`
+}
+
+type Item_Medical struct {
+	Medical *Medical `protobuf:"bytes,15,opt,name=medical,proto3,oneof"`
+}
+
+type Item_Melee struct {
+	Melee *Melee `protobuf:"bytes,16,opt,name=melee,proto3,oneof"`
+}
+
+type Item_Modification struct {
+	Modification *Modification `protobuf:"bytes,17,opt,name=modification,proto3,oneof"`
+}
+
+type Item_ModificationBarrel struct {
+	ModificationBarrel *Barrel `protobuf:"bytes,18,opt,name=modification_barrel,json=modificationBarrel,proto3,oneof"`
+}
+
+type Item_ModificationAuxiliary struct {
+	ModificationAuxiliary *Auxiliary `protobuf:"bytes,19,opt,name=modification_auxiliary,json=modificationAuxiliary,proto3,oneof"`
+}
+
+type Item_ModificationBipod struct {
+	ModificationBipod *Bipod `protobuf:"bytes,20,opt,name=modification_bipod,json=modificationBipod,proto3,oneof"`
+}
+
+type Item_ModificationCharge struct {
+	ModificationCharge *Charge `protobuf:"bytes,21,opt,name=modification_charge,json=modificationCharge,proto3,oneof"`
+}
+
+type Item_ModificationDevice struct {
+	ModificationDevice *Device `protobuf:"bytes,22,opt,name=modification_device,json=modificationDevice,proto3,oneof"`
+}
+
+type Item_ModificationForegrip struct {
+	ModificationForegrip *Foregrip `protobuf:"bytes,23,opt,name=modification_foregrip,json=modificationForegrip,proto3,oneof"`
+}
+
+type Item_ModificationGasblock struct {
+	ModificationGasblock *GasBlock `protobuf:"bytes,24,opt,name=modification_gasblock,json=modificationGasblock,proto3,oneof"`
+}
+
+type Item_ModificationGoggles struct {
+	ModificationGoggles *Goggles `protobuf:"bytes,25,opt,name=modification_goggles,json=modificationGoggles,proto3,oneof"`
+}
+
+type Item_ModificationHandguard struct {
+	ModificationHandguard *Handguard `protobuf:"bytes,26,opt,name=modification_handguard,json=modificationHandguard,proto3,oneof"`
+}
+
+type Item_ModificationLauncher struct {
+	ModificationLauncher *Launcher `protobuf:"bytes,27,opt,name=modification_launcher,json=modificationLauncher,proto3,oneof"`
+}
+
+type Item_ModificationMount struct {
+	ModificationMount *Mount `protobuf:"bytes,28,opt,name=modification_mount,json=modificationMount,proto3,oneof"`
+}
+
+type Item_ModificationMuzzle struct {
+	ModificationMuzzle *Muzzle `protobuf:"bytes,29,opt,name=modification_muzzle,json=modificationMuzzle,proto3,oneof"`
+}
+
+type Item_ModificationPistolgrip struct {
+	ModificationPistolgrip *PistolGrip `protobuf:"bytes,30,opt,name=modification_pistolgrip,json=modificationPistolgrip,proto3,oneof"`
+}
+
+type Item_ModificationReceiver struct {
+	ModificationReceiver *Receiver `protobuf:"bytes,31,opt,name=modification_receiver,json=modificationReceiver,proto3,oneof"`
+}
+
+type Item_ModificationSight struct {
+	ModificationSight *Sight `protobuf:"bytes,32,opt,name=modification_sight,json=modificationSight,proto3,oneof"`
+}
+
+type Item_ModificationSightSpecial struct {
+	ModificationSightSpecial *SightSpecial `protobuf:"bytes,33,opt,name=modification_sight_special,json=modificationSightSpecial,proto3,oneof"`
+}
+
+type Item_ModificationStock struct {
+	ModificationStock *Stock `protobuf:"bytes,34,opt,name=modification_stock,json=modificationStock,proto3,oneof"`
+}
+
+type Item_Money struct {
+	Money *Money `protobuf:"bytes,35,opt,name=money,proto3,oneof"`
+}
+
+type Item_Tacticalrig struct {
+	Tacticalrig *TacticalRig `protobuf:"bytes,36,opt,name=tacticalrig,proto3,oneof"`
+}
+
+func (*Item_Ammunition) isItem_Kind() {}
+
+func (*Item_Armor) isItem_Kind() {}
+
+func (*Item_Backpack) isItem_Kind() {}
+
+func (*Item_Barter) isItem_Kind() {}
+
+func (*Item_Clothing) isItem_Kind() {}
+
+func (*Item_Common) isItem_Kind() {}
+
+func (*Item_Container) isItem_Kind() {}
+
+func (*Item_Firearm) isItem_Kind() {}
+
+func (*Item_Food) isItem_Kind() {}
+
+func (*Item_Grenade) isItem_Kind() {}
+
+func (*Item_Headphone) isItem_Kind() {}
+
+func (*Item_Key) isItem_Kind() {}
+
+func (*Item_Magazine) isItem_Kind() {}
+
+func (*Item_Map) isItem_Kind() {}
+
+func (*Item_Medical) isItem_Kind() {}
+
+func (*Item_Melee) isItem_Kind() {}
+
+func (*Item_Modification) isItem_Kind() {}
+
+func (*Item_ModificationBarrel) isItem_Kind() {}
+
+func (*Item_ModificationAuxiliary) isItem_Kind() {}
+
+func (*Item_ModificationBipod) isItem_Kind() {}
+
+func (*Item_ModificationCharge) isItem_Kind() {}
+
+func (*Item_ModificationDevice) isItem_Kind() {}
+
+func (*Item_ModificationForegrip) isItem_Kind() {}
+
+func (*Item_ModificationGasblock) isItem_Kind() {}
+
+func (*Item_ModificationGoggles) isItem_Kind() {}
+
+func (*Item_ModificationHandguard) isItem_Kind() {}
+
+func (*Item_ModificationLauncher) isItem_Kind() {}
+
+func (*Item_ModificationMount) isItem_Kind() {}
+
+func (*Item_ModificationMuzzle) isItem_Kind() {}
+
+func (*Item_ModificationPistolgrip) isItem_Kind() {}
+
+func (*Item_ModificationReceiver) isItem_Kind() {}
+
+func (*Item_ModificationSight) isItem_Kind() {}
+
+func (*Item_ModificationSightSpecial) isItem_Kind() {}
+
+func (*Item_ModificationStock) isItem_Kind() {}
+
+func (*Item_Money) isItem_Kind() {}
+
+func (*Item_Tacticalrig) isItem_Kind() {}
+
+type RGBA struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	R uint64 `protobuf:"varint,1,opt,name=r,proto3" json:"r,omitempty"`
+	G uint64 `protobuf:"varint,2,opt,name=g,proto3" json:"g,omitempty"`
+	B uint64 `protobuf:"varint,3,opt,name=b,proto3" json:"b,omitempty"`
+	A uint64 `protobuf:"varint,4,opt,name=a,proto3" json:"a,omitempty"`
+}
+
+func (x *RGBA) Reset() {
+	*x = RGBA{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RGBA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RGBA) ProtoMessage() {}
+
+func (x *RGBA) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RGBA.ProtoReflect.Descriptor instead.
+func (*RGBA) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RGBA) GetR() uint64 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *RGBA) GetG() uint64 {
+	if x != nil {
+		return x.G
+	}
+	return 0
+}
+
+func (x *RGBA) GetB() uint64 {
+	if x != nil {
+		return x.B
+	}
+	return 0
+}
+
+func (x *RGBA) GetA() uint64 {
+	if x != nil {
+		return x.A
+	}
+	return 0
+}
+
+type GridProps struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Color  *RGBA `protobuf:"bytes,1,opt,name=color,proto3" json:"color,omitempty"`
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Width  int64 `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+}
+
+func (x *GridProps) Reset() {
+	*x = GridProps{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GridProps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GridProps) ProtoMessage() {}
+
+func (x *GridProps) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GridProps.ProtoReflect.Descriptor instead.
+func (*GridProps) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GridProps) GetColor() *RGBA {
+	if x != nil {
+		return x.Color
+	}
+	return nil
+}
+
+func (x *GridProps) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GridProps) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+type AmmoFrag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chance float64 `protobuf:"fixed64,1,opt,name=chance,proto3" json:"chance,omitempty"`
+	Min    int64   `protobuf:"varint,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max    int64   `protobuf:"varint,3,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *AmmoFrag) Reset() {
+	*x = AmmoFrag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmmoFrag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmmoFrag) ProtoMessage() {}
+
+func (x *AmmoFrag) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmmoFrag.ProtoReflect.Descriptor instead.
+func (*AmmoFrag) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AmmoFrag) GetChance() float64 {
+	if x != nil {
+		return x.Chance
+	}
+	return 0
+}
+
+func (x *AmmoFrag) GetMin() int64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *AmmoFrag) GetMax() int64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type AmmoEffects struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LightBleedingChance float64 `protobuf:"fixed64,1,opt,name=light_bleeding_chance,json=lightBleedingChance,proto3" json:"light_bleeding_chance,omitempty"`
+	HeavyBleedingChance float64 `protobuf:"fixed64,2,opt,name=heavy_bleeding_chance,json=heavyBleedingChance,proto3" json:"heavy_bleeding_chance,omitempty"`
+}
+
+func (x *AmmoEffects) Reset() {
+	*x = AmmoEffects{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmmoEffects) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmmoEffects) ProtoMessage() {}
+
+func (x *AmmoEffects) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmmoEffects.ProtoReflect.Descriptor instead.
+func (*AmmoEffects) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AmmoEffects) GetLightBleedingChance() float64 {
+	if x != nil {
+		return x.LightBleedingChance
+	}
+	return 0
+}
+
+func (x *AmmoEffects) GetHeavyBleedingChance() float64 {
+	if x != nil {
+		return x.HeavyBleedingChance
+	}
+	return 0
+}
+
+type WeaponModifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accuracy          float64 `protobuf:"fixed64,1,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil            float64 `protobuf:"fixed64,2,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	MalfunctionChance float64 `protobuf:"fixed64,3,opt,name=malfunction_chance,json=malfunctionChance,proto3" json:"malfunction_chance,omitempty"`
+	DurabilityBurn    float64 `protobuf:"fixed64,4,opt,name=durability_burn,json=durabilityBurn,proto3" json:"durability_burn,omitempty"`
+	HeatFactor        float64 `protobuf:"fixed64,5,opt,name=heat_factor,json=heatFactor,proto3" json:"heat_factor,omitempty"`
+}
+
+func (x *WeaponModifier) Reset() {
+	*x = WeaponModifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeaponModifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeaponModifier) ProtoMessage() {}
+
+func (x *WeaponModifier) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeaponModifier.ProtoReflect.Descriptor instead.
+func (*WeaponModifier) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WeaponModifier) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *WeaponModifier) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *WeaponModifier) GetMalfunctionChance() float64 {
+	if x != nil {
+		return x.MalfunctionChance
+	}
+	return 0
+}
+
+func (x *WeaponModifier) GetDurabilityBurn() float64 {
+	if x != nil {
+		return x.DurabilityBurn
+	}
+	return 0
+}
+
+func (x *WeaponModifier) GetHeatFactor() float64 {
+	if x != nil {
+		return x.HeatFactor
+	}
+	return 0
+}
+
+type AmmoGrenadeProperties struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delay     float64 `protobuf:"fixed64,1,opt,name=delay,proto3" json:"delay,omitempty"`
+	FragCount float64 `protobuf:"fixed64,2,opt,name=frag_count,json=fragCount,proto3" json:"frag_count,omitempty"`
+	MinRadius float64 `protobuf:"fixed64,3,opt,name=min_radius,json=minRadius,proto3" json:"min_radius,omitempty"`
+	MaxRadius float64 `protobuf:"fixed64,4,opt,name=max_radius,json=maxRadius,proto3" json:"max_radius,omitempty"`
+}
+
+func (x *AmmoGrenadeProperties) Reset() {
+	*x = AmmoGrenadeProperties{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmmoGrenadeProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmmoGrenadeProperties) ProtoMessage() {}
+
+func (x *AmmoGrenadeProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmmoGrenadeProperties.ProtoReflect.Descriptor instead.
+func (*AmmoGrenadeProperties) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AmmoGrenadeProperties) GetDelay() float64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+func (x *AmmoGrenadeProperties) GetFragCount() float64 {
+	if x != nil {
+		return x.FragCount
+	}
+	return 0
+}
+
+func (x *AmmoGrenadeProperties) GetMinRadius() float64 {
+	if x != nil {
+		return x.MinRadius
+	}
+	return 0
+}
+
+func (x *AmmoGrenadeProperties) GetMaxRadius() float64 {
+	if x != nil {
+		return x.MaxRadius
+	}
+	return 0
+}
+
+type Ammunition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName           string                 `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description         string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price               int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight              float64                `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack            int64                  `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity              string                 `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid                *GridProps             `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified            int64                  `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind                string                 `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	Caliber             string                 `protobuf:"bytes,12,opt,name=caliber,proto3" json:"caliber,omitempty"`
+	Type                string                 `protobuf:"bytes,13,opt,name=type,proto3" json:"type,omitempty"`
+	Tracer              bool                   `protobuf:"varint,14,opt,name=tracer,proto3" json:"tracer,omitempty"`
+	TracerColor         string                 `protobuf:"bytes,15,opt,name=tracer_color,json=tracerColor,proto3" json:"tracer_color,omitempty"`
+	Subsonic            bool                   `protobuf:"varint,16,opt,name=subsonic,proto3" json:"subsonic,omitempty"`
+	CasingMass          float64                `protobuf:"fixed64,17,opt,name=casing_mass,json=casingMass,proto3" json:"casing_mass,omitempty"`
+	BulletMass          float64                `protobuf:"fixed64,18,opt,name=bullet_mass,json=bulletMass,proto3" json:"bullet_mass,omitempty"`
+	BulletDiameter      float64                `protobuf:"fixed64,19,opt,name=bullet_diameter,json=bulletDiameter,proto3" json:"bullet_diameter,omitempty"`
+	Velocity            float64                `protobuf:"fixed64,20,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	BallisticCoef       float64                `protobuf:"fixed64,21,opt,name=ballistic_coef,json=ballisticCoef,proto3" json:"ballistic_coef,omitempty"`
+	Retardation         float64                `protobuf:"fixed64,22,opt,name=retardation,proto3" json:"retardation,omitempty"`
+	Damage              float64                `protobuf:"fixed64,23,opt,name=damage,proto3" json:"damage,omitempty"`
+	Penetration         float64                `protobuf:"fixed64,24,opt,name=penetration,proto3" json:"penetration,omitempty"`
+	ArmorDamage         float64                `protobuf:"fixed64,25,opt,name=armor_damage,json=armorDamage,proto3" json:"armor_damage,omitempty"`
+	Fragmentation       *AmmoFrag              `protobuf:"bytes,26,opt,name=fragmentation,proto3" json:"fragmentation,omitempty"`
+	Effects             *AmmoEffects           `protobuf:"bytes,27,opt,name=effects,proto3" json:"effects,omitempty"`
+	Projectiles         int64                  `protobuf:"varint,28,opt,name=projectiles,proto3" json:"projectiles,omitempty"`
+	Pellets             int64                  `protobuf:"varint,29,opt,name=pellets,proto3" json:"pellets,omitempty"`
+	MisfireChance       float64                `protobuf:"fixed64,30,opt,name=misfire_chance,json=misfireChance,proto3" json:"misfire_chance,omitempty"`
+	FailureToFeedChance float64                `protobuf:"fixed64,31,opt,name=failure_to_feed_chance,json=failureToFeedChance,proto3" json:"failure_to_feed_chance,omitempty"`
+	WeaponModifier      *WeaponModifier        `protobuf:"bytes,32,opt,name=weapon_modifier,json=weaponModifier,proto3" json:"weapon_modifier,omitempty"`
+	GrenadeProps        *AmmoGrenadeProperties `protobuf:"bytes,33,opt,name=grenade_props,json=grenadeProps,proto3" json:"grenade_props,omitempty"`
+}
+
+func (x *Ammunition) Reset() {
+	*x = Ammunition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ammunition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ammunition) ProtoMessage() {}
+
+func (x *Ammunition) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ammunition.ProtoReflect.Descriptor instead.
+func (*Ammunition) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Ammunition) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Ammunition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Ammunition) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Ammunition) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Ammunition) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Ammunition) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Ammunition) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Ammunition) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Ammunition) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Ammunition) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Ammunition) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Ammunition) GetCaliber() string {
+	if x != nil {
+		return x.Caliber
+	}
+	return ""
+}
+
+func (x *Ammunition) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Ammunition) GetTracer() bool {
+	if x != nil {
+		return x.Tracer
+	}
+	return false
+}
+
+func (x *Ammunition) GetTracerColor() string {
+	if x != nil {
+		return x.TracerColor
+	}
+	return ""
+}
+
+func (x *Ammunition) GetSubsonic() bool {
+	if x != nil {
+		return x.Subsonic
+	}
+	return false
+}
+
+func (x *Ammunition) GetCasingMass() float64 {
+	if x != nil {
+		return x.CasingMass
+	}
+	return 0
+}
+
+func (x *Ammunition) GetBulletMass() float64 {
+	if x != nil {
+		return x.BulletMass
+	}
+	return 0
+}
+
+func (x *Ammunition) GetBulletDiameter() float64 {
+	if x != nil {
+		return x.BulletDiameter
+	}
+	return 0
+}
+
+func (x *Ammunition) GetVelocity() float64 {
+	if x != nil {
+		return x.Velocity
+	}
+	return 0
+}
+
+func (x *Ammunition) GetBallisticCoef() float64 {
+	if x != nil {
+		return x.BallisticCoef
+	}
+	return 0
+}
+
+func (x *Ammunition) GetRetardation() float64 {
+	if x != nil {
+		return x.Retardation
+	}
+	return 0
+}
+
+func (x *Ammunition) GetDamage() float64 {
+	if x != nil {
+		return x.Damage
+	}
+	return 0
+}
+
+func (x *Ammunition) GetPenetration() float64 {
+	if x != nil {
+		return x.Penetration
+	}
+	return 0
+}
+
+func (x *Ammunition) GetArmorDamage() float64 {
+	if x != nil {
+		return x.ArmorDamage
+	}
+	return 0
+}
+
+func (x *Ammunition) GetFragmentation() *AmmoFrag {
+	if x != nil {
+		return x.Fragmentation
+	}
+	return nil
+}
+
+func (x *Ammunition) GetEffects() *AmmoEffects {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
+func (x *Ammunition) GetProjectiles() int64 {
+	if x != nil {
+		return x.Projectiles
+	}
+	return 0
+}
+
+func (x *Ammunition) GetPellets() int64 {
+	if x != nil {
+		return x.Pellets
+	}
+	return 0
+}
+
+func (x *Ammunition) GetMisfireChance() float64 {
+	if x != nil {
+		return x.MisfireChance
+	}
+	return 0
+}
+
+func (x *Ammunition) GetFailureToFeedChance() float64 {
+	if x != nil {
+		return x.FailureToFeedChance
+	}
+	return 0
+}
+
+func (x *Ammunition) GetWeaponModifier() *WeaponModifier {
+	if x != nil {
+		return x.WeaponModifier
+	}
+	return nil
+}
+
+func (x *Ammunition) GetGrenadeProps() *AmmoGrenadeProperties {
+	if x != nil {
+		return x.GrenadeProps
+	}
+	return nil
+}
+
+type ArmorMaterial struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Destructibility float64 `protobuf:"fixed64,2,opt,name=destructibility,proto3" json:"destructibility,omitempty"`
+}
+
+func (x *ArmorMaterial) Reset() {
+	*x = ArmorMaterial{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArmorMaterial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArmorMaterial) ProtoMessage() {}
+
+func (x *ArmorMaterial) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArmorMaterial.ProtoReflect.Descriptor instead.
+func (*ArmorMaterial) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ArmorMaterial) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ArmorMaterial) GetDestructibility() float64 {
+	if x != nil {
+		return x.Destructibility
+	}
+	return 0
+}
+
+type ArmorProps struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Class           int64          `protobuf:"varint,1,opt,name=class,proto3" json:"class,omitempty"`
+	Durability      float64        `protobuf:"fixed64,2,opt,name=durability,proto3" json:"durability,omitempty"`
+	Material        *ArmorMaterial `protobuf:"bytes,3,opt,name=material,proto3" json:"material,omitempty"`
+	BluntThroughput float64        `protobuf:"fixed64,4,opt,name=blunt_throughput,json=bluntThroughput,proto3" json:"blunt_throughput,omitempty"`
+	Zones           []string       `protobuf:"bytes,5,rep,name=zones,proto3" json:"zones,omitempty"`
+}
+
+func (x *ArmorProps) Reset() {
+	*x = ArmorProps{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArmorProps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArmorProps) ProtoMessage() {}
+
+func (x *ArmorProps) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArmorProps.ProtoReflect.Descriptor instead.
+func (*ArmorProps) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ArmorProps) GetClass() int64 {
+	if x != nil {
+		return x.Class
+	}
+	return 0
+}
+
+func (x *ArmorProps) GetDurability() float64 {
+	if x != nil {
+		return x.Durability
+	}
+	return 0
+}
+
+func (x *ArmorProps) GetMaterial() *ArmorMaterial {
+	if x != nil {
+		return x.Material
+	}
+	return nil
+}
+
+func (x *ArmorProps) GetBluntThroughput() float64 {
+	if x != nil {
+		return x.BluntThroughput
+	}
+	return 0
+}
+
+func (x *ArmorProps) GetZones() []string {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+type ArmorComponent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Class           int64          `protobuf:"varint,1,opt,name=class,proto3" json:"class,omitempty"`
+	Durability      float64        `protobuf:"fixed64,2,opt,name=durability,proto3" json:"durability,omitempty"`
+	Material        *ArmorMaterial `protobuf:"bytes,3,opt,name=material,proto3" json:"material,omitempty"`
+	BluntThroughput float64        `protobuf:"fixed64,4,opt,name=blunt_throughput,json=bluntThroughput,proto3" json:"blunt_throughput,omitempty"`
+	Zones           []string       `protobuf:"bytes,5,rep,name=zones,proto3" json:"zones,omitempty"`
+}
+
+func (x *ArmorComponent) Reset() {
+	*x = ArmorComponent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArmorComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArmorComponent) ProtoMessage() {}
+
+func (x *ArmorComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArmorComponent.ProtoReflect.Descriptor instead.
+func (*ArmorComponent) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ArmorComponent) GetClass() int64 {
+	if x != nil {
+		return x.Class
+	}
+	return 0
+}
+
+func (x *ArmorComponent) GetDurability() float64 {
+	if x != nil {
+		return x.Durability
+	}
+	return 0
+}
+
+func (x *ArmorComponent) GetMaterial() *ArmorMaterial {
+	if x != nil {
+		return x.Material
+	}
+	return nil
+}
+
+func (x *ArmorComponent) GetBluntThroughput() float64 {
+	if x != nil {
+		return x.BluntThroughput
+	}
+	return 0
+}
+
+func (x *ArmorComponent) GetZones() []string {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+type Penalties struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mouse        float64 `protobuf:"fixed64,1,opt,name=mouse,proto3" json:"mouse,omitempty"`
+	Speed        float64 `protobuf:"fixed64,2,opt,name=speed,proto3" json:"speed,omitempty"`
+	ErgonomicsFP float64 `protobuf:"fixed64,3,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics   int64   `protobuf:"varint,4,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Deafness     string  `protobuf:"bytes,5,opt,name=deafness,proto3" json:"deafness,omitempty"`
+}
+
+func (x *Penalties) Reset() {
+	*x = Penalties{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Penalties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Penalties) ProtoMessage() {}
+
+func (x *Penalties) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Penalties.ProtoReflect.Descriptor instead.
+func (*Penalties) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Penalties) GetMouse() float64 {
+	if x != nil {
+		return x.Mouse
+	}
+	return 0
+}
+
+func (x *Penalties) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *Penalties) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *Penalties) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *Penalties) GetDeafness() string {
+	if x != nil {
+		return x.Deafness
+	}
+	return ""
+}
+
+type Slot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   map[string]*structpb.ListValue `protobuf:"bytes,1,rep,name=filter,proto3" json:"filter,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Required bool                           `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+}
+
+func (x *Slot) Reset() {
+	*x = Slot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Slot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Slot) ProtoMessage() {}
+
+func (x *Slot) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Slot.ProtoReflect.Descriptor instead.
+func (*Slot) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Slot) GetFilter() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *Slot) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type Armor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name           string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName      string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description    string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price          int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight         float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack       int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity         string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid           *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified       int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind           string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	Type           string                         `protobuf:"bytes,12,opt,name=type,proto3" json:"type,omitempty"`
+	Armor          *ArmorProps                    `protobuf:"bytes,13,opt,name=armor,proto3" json:"armor,omitempty"`
+	Components     []*ArmorComponent              `protobuf:"bytes,14,rep,name=components,proto3" json:"components,omitempty"`
+	RicochetChance string                         `protobuf:"bytes,15,opt,name=ricochet_chance,json=ricochetChance,proto3" json:"ricochet_chance,omitempty"`
+	Penalties      *Penalties                     `protobuf:"bytes,16,opt,name=penalties,proto3" json:"penalties,omitempty"`
+	Blocking       []string                       `protobuf:"bytes,17,rep,name=blocking,proto3" json:"blocking,omitempty"`
+	Slots          map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility  map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts      map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Armor) Reset() {
+	*x = Armor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Armor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Armor) ProtoMessage() {}
+
+func (x *Armor) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Armor.ProtoReflect.Descriptor instead.
+func (*Armor) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Armor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Armor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Armor) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Armor) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Armor) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Armor) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Armor) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Armor) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Armor) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Armor) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Armor) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Armor) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Armor) GetArmor() *ArmorProps {
+	if x != nil {
+		return x.Armor
+	}
+	return nil
+}
+
+func (x *Armor) GetComponents() []*ArmorComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *Armor) GetRicochetChance() string {
+	if x != nil {
+		return x.RicochetChance
+	}
+	return ""
+}
+
+func (x *Armor) GetPenalties() *Penalties {
+	if x != nil {
+		return x.Penalties
+	}
+	return nil
+}
+
+func (x *Armor) GetBlocking() []string {
+	if x != nil {
+		return x.Blocking
+	}
+	return nil
+}
+
+func (x *Armor) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Armor) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Armor) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type Grid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Height    int64                          `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Width     int64                          `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	MaxWeight float64                        `protobuf:"fixed64,4,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	Filter    map[string]*structpb.ListValue `protobuf:"bytes,5,rep,name=filter,proto3" json:"filter,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Grid) Reset() {
+	*x = Grid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Grid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Grid) ProtoMessage() {}
+
+func (x *Grid) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Grid.ProtoReflect.Descriptor instead.
+func (*Grid) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Grid) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Grid) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Grid) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Grid) GetMaxWeight() float64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+func (x *Grid) GetFilter() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type Backpack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string     `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name        string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName   string     `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description string     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price       int64      `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight      float64    `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack    int64      `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity      string     `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid        *GridProps `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified    int64      `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind        string     `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	Capacity    int64      `protobuf:"varint,12,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Grids       []*Grid    `protobuf:"bytes,13,rep,name=grids,proto3" json:"grids,omitempty"`
+	Penalties   *Penalties `protobuf:"bytes,14,opt,name=penalties,proto3" json:"penalties,omitempty"`
+}
+
+func (x *Backpack) Reset() {
+	*x = Backpack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Backpack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Backpack) ProtoMessage() {}
+
+func (x *Backpack) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Backpack.ProtoReflect.Descriptor instead.
+func (*Backpack) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Backpack) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Backpack) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Backpack) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Backpack) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Backpack) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Backpack) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Backpack) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Backpack) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Backpack) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Backpack) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Backpack) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Backpack) GetCapacity() int64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Backpack) GetGrids() []*Grid {
+	if x != nil {
+		return x.Grids
+	}
+	return nil
+}
+
+func (x *Backpack) GetPenalties() *Penalties {
+	if x != nil {
+		return x.Penalties
+	}
+	return nil
+}
+
+type Barter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string     `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name        string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName   string     `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description string     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price       int64      `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight      float64    `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack    int64      `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity      string     `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid        *GridProps `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified    int64      `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind        string     `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+}
+
+func (x *Barter) Reset() {
+	*x = Barter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Barter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Barter) ProtoMessage() {}
+
+func (x *Barter) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Barter.ProtoReflect.Descriptor instead.
+func (*Barter) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Barter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Barter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Barter) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Barter) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Barter) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Barter) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Barter) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Barter) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Barter) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Barter) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Barter) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type Clothing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string           `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name        string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName   string           `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description string           `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price       int64            `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight      float64          `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack    int64            `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity      string           `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid        *GridProps       `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified    int64            `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind        string           `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	Type        string           `protobuf:"bytes,12,opt,name=type,proto3" json:"type,omitempty"`
+	Blocking    []string         `protobuf:"bytes,13,rep,name=blocking,proto3" json:"blocking,omitempty"`
+	Penalties   *Penalties       `protobuf:"bytes,14,opt,name=penalties,proto3" json:"penalties,omitempty"`
+	Slots       map[string]*Slot `protobuf:"bytes,15,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Clothing) Reset() {
+	*x = Clothing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clothing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clothing) ProtoMessage() {}
+
+func (x *Clothing) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clothing.ProtoReflect.Descriptor instead.
+func (*Clothing) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Clothing) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Clothing) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Clothing) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Clothing) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Clothing) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Clothing) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Clothing) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Clothing) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Clothing) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Clothing) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Clothing) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Clothing) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Clothing) GetBlocking() []string {
+	if x != nil {
+		return x.Blocking
+	}
+	return nil
+}
+
+func (x *Clothing) GetPenalties() *Penalties {
+	if x != nil {
+		return x.Penalties
+	}
+	return nil
+}
+
+func (x *Clothing) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type CommonItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string     `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name        string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName   string     `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description string     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price       int64      `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight      float64    `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack    int64      `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity      string     `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid        *GridProps `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified    int64      `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind        string     `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+}
+
+func (x *CommonItem) Reset() {
+	*x = CommonItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommonItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommonItem) ProtoMessage() {}
+
+func (x *CommonItem) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommonItem.ProtoReflect.Descriptor instead.
+func (*CommonItem) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CommonItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CommonItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CommonItem) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *CommonItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CommonItem) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CommonItem) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *CommonItem) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *CommonItem) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *CommonItem) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *CommonItem) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *CommonItem) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type Container struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string     `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name        string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName   string     `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description string     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price       int64      `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight      float64    `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack    int64      `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity      string     `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid        *GridProps `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified    int64      `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind        string     `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	Capacity    int64      `protobuf:"varint,12,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Grids       []*Grid    `protobuf:"bytes,13,rep,name=grids,proto3" json:"grids,omitempty"`
+}
+
+func (x *Container) Reset() {
+	*x = Container{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Container) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Container) ProtoMessage() {}
+
+func (x *Container) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Container.ProtoReflect.Descriptor instead.
+func (*Container) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Container) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Container) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Container) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Container) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Container) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Container) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Container) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Container) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Container) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Container) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Container) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Container) GetCapacity() int64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Container) GetGrids() []*Grid {
+	if x != nil {
+		return x.Grids
+	}
+	return nil
+}
+
+type Firearm struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 string           `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name               string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName          string           `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description        string           `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price              int64            `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight             float64          `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack           int64            `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity             string           `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid               *GridProps       `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified           int64            `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind               string           `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	Type               string           `protobuf:"bytes,12,opt,name=type,proto3" json:"type,omitempty"`
+	Class              string           `protobuf:"bytes,13,opt,name=class,proto3" json:"class,omitempty"`
+	Caliber            string           `protobuf:"bytes,14,opt,name=caliber,proto3" json:"caliber,omitempty"`
+	Manufacturer       string           `protobuf:"bytes,15,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	Rof                int64            `protobuf:"varint,16,opt,name=rof,proto3" json:"rof,omitempty"`
+	BurstRounds        int64            `protobuf:"varint,17,opt,name=burst_rounds,json=burstRounds,proto3" json:"burst_rounds,omitempty"`
+	Action             string           `protobuf:"bytes,18,opt,name=action,proto3" json:"action,omitempty"`
+	Modes              []string         `protobuf:"bytes,19,rep,name=modes,proto3" json:"modes,omitempty"`
+	Velocity           float64          `protobuf:"fixed64,20,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	EffectiveDist      int64            `protobuf:"varint,21,opt,name=effective_dist,json=effectiveDist,proto3" json:"effective_dist,omitempty"`
+	ErgonomicsFP       float64          `protobuf:"fixed64,22,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics         int64            `protobuf:"varint,23,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	FoldRectractable   bool             `protobuf:"varint,24,opt,name=fold_rectractable,json=foldRectractable,proto3" json:"fold_rectractable,omitempty"`
+	RecoilVertical     int64            `protobuf:"varint,25,opt,name=recoil_vertical,json=recoilVertical,proto3" json:"recoil_vertical,omitempty"`
+	RecoilHorizontal   int64            `protobuf:"varint,26,opt,name=recoil_horizontal,json=recoilHorizontal,proto3" json:"recoil_horizontal,omitempty"`
+	OperatingResources float64          `protobuf:"fixed64,27,opt,name=operating_resources,json=operatingResources,proto3" json:"operating_resources,omitempty"`
+	MalfunctionChance  float64          `protobuf:"fixed64,28,opt,name=malfunction_chance,json=malfunctionChance,proto3" json:"malfunction_chance,omitempty"`
+	DurabilityRatio    float64          `protobuf:"fixed64,29,opt,name=durability_ratio,json=durabilityRatio,proto3" json:"durability_ratio,omitempty"`
+	HeatFactor         float64          `protobuf:"fixed64,30,opt,name=heat_factor,json=heatFactor,proto3" json:"heat_factor,omitempty"`
+	HeatFactorByShot   float64          `protobuf:"fixed64,31,opt,name=heat_factor_by_shot,json=heatFactorByShot,proto3" json:"heat_factor_by_shot,omitempty"`
+	CoolFactor         float64          `protobuf:"fixed64,32,opt,name=cool_factor,json=coolFactor,proto3" json:"cool_factor,omitempty"`
+	CoolFactorMods     float64          `protobuf:"fixed64,33,opt,name=cool_factor_mods,json=coolFactorMods,proto3" json:"cool_factor_mods,omitempty"`
+	CenterOfImpact     float64          `protobuf:"fixed64,34,opt,name=center_of_impact,json=centerOfImpact,proto3" json:"center_of_impact,omitempty"`
+	Slots              map[string]*Slot `protobuf:"bytes,35,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Firearm) Reset() {
+	*x = Firearm{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Firearm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Firearm) ProtoMessage() {}
+
+func (x *Firearm) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Firearm.ProtoReflect.Descriptor instead.
+func (*Firearm) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Firearm) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Firearm) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Firearm) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Firearm) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Firearm) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Firearm) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Firearm) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Firearm) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Firearm) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Firearm) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Firearm) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Firearm) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Firearm) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *Firearm) GetCaliber() string {
+	if x != nil {
+		return x.Caliber
+	}
+	return ""
+}
+
+func (x *Firearm) GetManufacturer() string {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return ""
+}
+
+func (x *Firearm) GetRof() int64 {
+	if x != nil {
+		return x.Rof
+	}
+	return 0
+}
+
+func (x *Firearm) GetBurstRounds() int64 {
+	if x != nil {
+		return x.BurstRounds
+	}
+	return 0
+}
+
+func (x *Firearm) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Firearm) GetModes() []string {
+	if x != nil {
+		return x.Modes
+	}
+	return nil
+}
+
+func (x *Firearm) GetVelocity() float64 {
+	if x != nil {
+		return x.Velocity
+	}
+	return 0
+}
+
+func (x *Firearm) GetEffectiveDist() int64 {
+	if x != nil {
+		return x.EffectiveDist
+	}
+	return 0
+}
+
+func (x *Firearm) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *Firearm) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *Firearm) GetFoldRectractable() bool {
+	if x != nil {
+		return x.FoldRectractable
+	}
+	return false
+}
+
+func (x *Firearm) GetRecoilVertical() int64 {
+	if x != nil {
+		return x.RecoilVertical
+	}
+	return 0
+}
+
+func (x *Firearm) GetRecoilHorizontal() int64 {
+	if x != nil {
+		return x.RecoilHorizontal
+	}
+	return 0
+}
+
+func (x *Firearm) GetOperatingResources() float64 {
+	if x != nil {
+		return x.OperatingResources
+	}
+	return 0
+}
+
+func (x *Firearm) GetMalfunctionChance() float64 {
+	if x != nil {
+		return x.MalfunctionChance
+	}
+	return 0
+}
+
+func (x *Firearm) GetDurabilityRatio() float64 {
+	if x != nil {
+		return x.DurabilityRatio
+	}
+	return 0
+}
+
+func (x *Firearm) GetHeatFactor() float64 {
+	if x != nil {
+		return x.HeatFactor
+	}
+	return 0
+}
+
+func (x *Firearm) GetHeatFactorByShot() float64 {
+	if x != nil {
+		return x.HeatFactorByShot
+	}
+	return 0
+}
+
+func (x *Firearm) GetCoolFactor() float64 {
+	if x != nil {
+		return x.CoolFactor
+	}
+	return 0
+}
+
+func (x *Firearm) GetCoolFactorMods() float64 {
+	if x != nil {
+		return x.CoolFactorMods
+	}
+	return 0
+}
+
+func (x *Firearm) GetCenterOfImpact() float64 {
+	if x != nil {
+		return x.CenterOfImpact
+	}
+	return 0
+}
+
+func (x *Firearm) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type EffectPenalties struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HealthMin float64 `protobuf:"fixed64,1,opt,name=health_min,json=healthMin,proto3" json:"health_min,omitempty"`
+	HealthMax float64 `protobuf:"fixed64,2,opt,name=health_max,json=healthMax,proto3" json:"health_max,omitempty"`
+}
+
+func (x *EffectPenalties) Reset() {
+	*x = EffectPenalties{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EffectPenalties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectPenalties) ProtoMessage() {}
+
+func (x *EffectPenalties) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectPenalties.ProtoReflect.Descriptor instead.
+func (*EffectPenalties) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *EffectPenalties) GetHealthMin() float64 {
+	if x != nil {
+		return x.HealthMin
+	}
+	return 0
+}
+
+func (x *EffectPenalties) GetHealthMax() float64 {
+	if x != nil {
+		return x.HealthMax
+	}
+	return 0
+}
+
+type Effect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ResourceCosts int64            `protobuf:"varint,2,opt,name=resource_costs,json=resourceCosts,proto3" json:"resource_costs,omitempty"`
+	FadeIn        float64          `protobuf:"fixed64,3,opt,name=fade_in,json=fadeIn,proto3" json:"fade_in,omitempty"`
+	FadeOut       float64          `protobuf:"fixed64,4,opt,name=fade_out,json=fadeOut,proto3" json:"fade_out,omitempty"`
+	Chance        float64          `protobuf:"fixed64,5,opt,name=chance,proto3" json:"chance,omitempty"`
+	Delay         float64          `protobuf:"fixed64,6,opt,name=delay,proto3" json:"delay,omitempty"`
+	Duration      float64          `protobuf:"fixed64,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	Value         float64          `protobuf:"fixed64,8,opt,name=value,proto3" json:"value,omitempty"`
+	IsPercent     bool             `protobuf:"varint,9,opt,name=is_percent,json=isPercent,proto3" json:"is_percent,omitempty"`
+	Removes       bool             `protobuf:"varint,10,opt,name=removes,proto3" json:"removes,omitempty"`
+	Penalties     *EffectPenalties `protobuf:"bytes,11,opt,name=penalties,proto3" json:"penalties,omitempty"`
+}
+
+func (x *Effect) Reset() {
+	*x = Effect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Effect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Effect) ProtoMessage() {}
+
+func (x *Effect) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Effect.ProtoReflect.Descriptor instead.
+func (*Effect) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Effect) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Effect) GetResourceCosts() int64 {
+	if x != nil {
+		return x.ResourceCosts
+	}
+	return 0
+}
+
+func (x *Effect) GetFadeIn() float64 {
+	if x != nil {
+		return x.FadeIn
+	}
+	return 0
+}
+
+func (x *Effect) GetFadeOut() float64 {
+	if x != nil {
+		return x.FadeOut
+	}
+	return 0
+}
+
+func (x *Effect) GetChance() float64 {
+	if x != nil {
+		return x.Chance
+	}
+	return 0
+}
+
+func (x *Effect) GetDelay() float64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+func (x *Effect) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Effect) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Effect) GetIsPercent() bool {
+	if x != nil {
+		return x.IsPercent
+	}
+	return false
+}
+
+func (x *Effect) GetRemoves() bool {
+	if x != nil {
+		return x.Removes
+	}
+	return false
+}
+
+func (x *Effect) GetPenalties() *EffectPenalties {
+	if x != nil {
+		return x.Penalties
+	}
+	return nil
+}
+
+type Effects struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Energy          *Effect   `protobuf:"bytes,1,opt,name=energy,proto3" json:"energy,omitempty"`
+	EnergyRate      *Effect   `protobuf:"bytes,2,opt,name=energy_rate,json=energyRate,proto3" json:"energy_rate,omitempty"`
+	Hydration       *Effect   `protobuf:"bytes,3,opt,name=hydration,proto3" json:"hydration,omitempty"`
+	HydrationRate   *Effect   `protobuf:"bytes,4,opt,name=hydration_rate,json=hydrationRate,proto3" json:"hydration_rate,omitempty"`
+	Stamina         *Effect   `protobuf:"bytes,5,opt,name=stamina,proto3" json:"stamina,omitempty"`
+	StaminaRate     *Effect   `protobuf:"bytes,6,opt,name=stamina_rate,json=staminaRate,proto3" json:"stamina_rate,omitempty"`
+	Health          *Effect   `protobuf:"bytes,7,opt,name=health,proto3" json:"health,omitempty"`
+	HealthRate      *Effect   `protobuf:"bytes,8,opt,name=health_rate,json=healthRate,proto3" json:"health_rate,omitempty"`
+	Bloodloss       *Effect   `protobuf:"bytes,9,opt,name=bloodloss,proto3" json:"bloodloss,omitempty"`
+	LightBleeding   *Effect   `protobuf:"bytes,10,opt,name=light_bleeding,json=lightBleeding,proto3" json:"light_bleeding,omitempty"`
+	HeavyBleeding   *Effect   `protobuf:"bytes,11,opt,name=heavy_bleeding,json=heavyBleeding,proto3" json:"heavy_bleeding,omitempty"`
+	Fracture        *Effect   `protobuf:"bytes,12,opt,name=fracture,proto3" json:"fracture,omitempty"`
+	Contusion       *Effect   `protobuf:"bytes,13,opt,name=contusion,proto3" json:"contusion,omitempty"`
+	Pain            *Effect   `protobuf:"bytes,14,opt,name=pain,proto3" json:"pain,omitempty"`
+	TunnelVision    *Effect   `protobuf:"bytes,15,opt,name=tunnel_vision,json=tunnelVision,proto3" json:"tunnel_vision,omitempty"`
+	Tremor          *Effect   `protobuf:"bytes,16,opt,name=tremor,proto3" json:"tremor,omitempty"`
+	Toxication      *Effect   `protobuf:"bytes,17,opt,name=toxication,proto3" json:"toxication,omitempty"`
+	Antidote        *Effect   `protobuf:"bytes,18,opt,name=antidote,proto3" json:"antidote,omitempty"`
+	RadExposure     *Effect   `protobuf:"bytes,19,opt,name=rad_exposure,json=radExposure,proto3" json:"rad_exposure,omitempty"`
+	BodyTemperature *Effect   `protobuf:"bytes,20,opt,name=body_temperature,json=bodyTemperature,proto3" json:"body_temperature,omitempty"`
+	Mobility        *Effect   `protobuf:"bytes,21,opt,name=mobility,proto3" json:"mobility,omitempty"`
+	Recoil          *Effect   `protobuf:"bytes,22,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	ReloadSpeed     *Effect   `protobuf:"bytes,23,opt,name=reload_speed,json=reloadSpeed,proto3" json:"reload_speed,omitempty"`
+	LootSpeed       *Effect   `protobuf:"bytes,24,opt,name=loot_speed,json=lootSpeed,proto3" json:"loot_speed,omitempty"`
+	UnlockSpeed     *Effect   `protobuf:"bytes,25,opt,name=unlock_speed,json=unlockSpeed,proto3" json:"unlock_speed,omitempty"`
+	DestroyedPart   *Effect   `protobuf:"bytes,26,opt,name=destroyed_part,json=destroyedPart,proto3" json:"destroyed_part,omitempty"`
+	WeightLimit     *Effect   `protobuf:"bytes,27,opt,name=weight_limit,json=weightLimit,proto3" json:"weight_limit,omitempty"`
+	DamageModifier  *Effect   `protobuf:"bytes,28,opt,name=damage_modifier,json=damageModifier,proto3" json:"damage_modifier,omitempty"`
+	Skill           []*Effect `protobuf:"bytes,29,rep,name=skill,proto3" json:"skill,omitempty"`
+}
+
+func (x *Effects) Reset() {
+	*x = Effects{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Effects) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Effects) ProtoMessage() {}
+
+func (x *Effects) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Effects.ProtoReflect.Descriptor instead.
+func (*Effects) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Effects) GetEnergy() *Effect {
+	if x != nil {
+		return x.Energy
+	}
+	return nil
+}
+
+func (x *Effects) GetEnergyRate() *Effect {
+	if x != nil {
+		return x.EnergyRate
+	}
+	return nil
+}
+
+func (x *Effects) GetHydration() *Effect {
+	if x != nil {
+		return x.Hydration
+	}
+	return nil
+}
+
+func (x *Effects) GetHydrationRate() *Effect {
+	if x != nil {
+		return x.HydrationRate
+	}
+	return nil
+}
+
+func (x *Effects) GetStamina() *Effect {
+	if x != nil {
+		return x.Stamina
+	}
+	return nil
+}
+
+func (x *Effects) GetStaminaRate() *Effect {
+	if x != nil {
+		return x.StaminaRate
+	}
+	return nil
+}
+
+func (x *Effects) GetHealth() *Effect {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *Effects) GetHealthRate() *Effect {
+	if x != nil {
+		return x.HealthRate
+	}
+	return nil
+}
+
+func (x *Effects) GetBloodloss() *Effect {
+	if x != nil {
+		return x.Bloodloss
+	}
+	return nil
+}
+
+func (x *Effects) GetLightBleeding() *Effect {
+	if x != nil {
+		return x.LightBleeding
+	}
+	return nil
+}
+
+func (x *Effects) GetHeavyBleeding() *Effect {
+	if x != nil {
+		return x.HeavyBleeding
+	}
+	return nil
+}
+
+func (x *Effects) GetFracture() *Effect {
+	if x != nil {
+		return x.Fracture
+	}
+	return nil
+}
+
+func (x *Effects) GetContusion() *Effect {
+	if x != nil {
+		return x.Contusion
+	}
+	return nil
+}
+
+func (x *Effects) GetPain() *Effect {
+	if x != nil {
+		return x.Pain
+	}
+	return nil
+}
+
+func (x *Effects) GetTunnelVision() *Effect {
+	if x != nil {
+		return x.TunnelVision
+	}
+	return nil
+}
+
+func (x *Effects) GetTremor() *Effect {
+	if x != nil {
+		return x.Tremor
+	}
+	return nil
+}
+
+func (x *Effects) GetToxication() *Effect {
+	if x != nil {
+		return x.Toxication
+	}
+	return nil
+}
+
+func (x *Effects) GetAntidote() *Effect {
+	if x != nil {
+		return x.Antidote
+	}
+	return nil
+}
+
+func (x *Effects) GetRadExposure() *Effect {
+	if x != nil {
+		return x.RadExposure
+	}
+	return nil
+}
+
+func (x *Effects) GetBodyTemperature() *Effect {
+	if x != nil {
+		return x.BodyTemperature
+	}
+	return nil
+}
+
+func (x *Effects) GetMobility() *Effect {
+	if x != nil {
+		return x.Mobility
+	}
+	return nil
+}
+
+func (x *Effects) GetRecoil() *Effect {
+	if x != nil {
+		return x.Recoil
+	}
+	return nil
+}
+
+func (x *Effects) GetReloadSpeed() *Effect {
+	if x != nil {
+		return x.ReloadSpeed
+	}
+	return nil
+}
+
+func (x *Effects) GetLootSpeed() *Effect {
+	if x != nil {
+		return x.LootSpeed
+	}
+	return nil
+}
+
+func (x *Effects) GetUnlockSpeed() *Effect {
+	if x != nil {
+		return x.UnlockSpeed
+	}
+	return nil
+}
+
+func (x *Effects) GetDestroyedPart() *Effect {
+	if x != nil {
+		return x.DestroyedPart
+	}
+	return nil
+}
+
+func (x *Effects) GetWeightLimit() *Effect {
+	if x != nil {
+		return x.WeightLimit
+	}
+	return nil
+}
+
+func (x *Effects) GetDamageModifier() *Effect {
+	if x != nil {
+		return x.DamageModifier
+	}
+	return nil
+}
+
+func (x *Effects) GetSkill() []*Effect {
+	if x != nil {
+		return x.Skill
+	}
+	return nil
+}
+
+type Food struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string     `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name        string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName   string     `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description string     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price       int64      `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight      float64    `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack    int64      `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity      string     `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid        *GridProps `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified    int64      `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind        string     `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	Type        string     `protobuf:"bytes,12,opt,name=type,proto3" json:"type,omitempty"`
+	Resources   int64      `protobuf:"varint,13,opt,name=resources,proto3" json:"resources,omitempty"`
+	UseTime     float64    `protobuf:"fixed64,14,opt,name=use_time,json=useTime,proto3" json:"use_time,omitempty"`
+	Effects     *Effects   `protobuf:"bytes,15,opt,name=effects,proto3" json:"effects,omitempty"`
+}
+
+func (x *Food) Reset() {
+	*x = Food{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Food) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Food) ProtoMessage() {}
+
+func (x *Food) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Food.ProtoReflect.Descriptor instead.
+func (*Food) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Food) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Food) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Food) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Food) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Food) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Food) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Food) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Food) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Food) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Food) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Food) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Food) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Food) GetResources() int64 {
+	if x != nil {
+		return x.Resources
+	}
+	return 0
+}
+
+func (x *Food) GetUseTime() float64 {
+	if x != nil {
+		return x.UseTime
+	}
+	return 0
+}
+
+func (x *Food) GetEffects() *Effects {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
+type Grenade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string     `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name              string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName         string     `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description       string     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price             int64      `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight            float64    `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack          int64      `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity            string     `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid              *GridProps `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified          int64      `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind              string     `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	Type              string     `protobuf:"bytes,12,opt,name=type,proto3" json:"type,omitempty"`
+	Delay             float64    `protobuf:"fixed64,13,opt,name=delay,proto3" json:"delay,omitempty"`
+	FragCount         float64    `protobuf:"fixed64,14,opt,name=frag_count,json=fragCount,proto3" json:"frag_count,omitempty"`
+	FragDamage        float64    `protobuf:"fixed64,15,opt,name=frag_damage,json=fragDamage,proto3" json:"frag_damage,omitempty"`
+	MinDistance       float64    `protobuf:"fixed64,16,opt,name=min_distance,json=minDistance,proto3" json:"min_distance,omitempty"`
+	MaxDistance       float64    `protobuf:"fixed64,17,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	ContusionDistance float64    `protobuf:"fixed64,18,opt,name=contusion_distance,json=contusionDistance,proto3" json:"contusion_distance,omitempty"`
+	Strength          float64    `protobuf:"fixed64,19,opt,name=strength,proto3" json:"strength,omitempty"`
+	EmitTime          float64    `protobuf:"fixed64,20,opt,name=emit_time,json=emitTime,proto3" json:"emit_time,omitempty"`
+}
+
+func (x *Grenade) Reset() {
+	*x = Grenade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Grenade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Grenade) ProtoMessage() {}
+
+func (x *Grenade) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Grenade.ProtoReflect.Descriptor instead.
+func (*Grenade) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Grenade) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Grenade) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Grenade) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Grenade) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Grenade) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Grenade) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Grenade) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Grenade) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Grenade) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Grenade) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Grenade) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Grenade) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Grenade) GetDelay() float64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+func (x *Grenade) GetFragCount() float64 {
+	if x != nil {
+		return x.FragCount
+	}
+	return 0
+}
+
+func (x *Grenade) GetFragDamage() float64 {
+	if x != nil {
+		return x.FragDamage
+	}
+	return 0
+}
+
+func (x *Grenade) GetMinDistance() float64 {
+	if x != nil {
+		return x.MinDistance
+	}
+	return 0
+}
+
+func (x *Grenade) GetMaxDistance() float64 {
+	if x != nil {
+		return x.MaxDistance
+	}
+	return 0
+}
+
+func (x *Grenade) GetContusionDistance() float64 {
+	if x != nil {
+		return x.ContusionDistance
+	}
+	return 0
+}
+
+func (x *Grenade) GetStrength() float64 {
+	if x != nil {
+		return x.Strength
+	}
+	return 0
+}
+
+func (x *Grenade) GetEmitTime() float64 {
+	if x != nil {
+		return x.EmitTime
+	}
+	return 0
+}
+
+type HighPass struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CutoffFreq float64 `protobuf:"fixed64,1,opt,name=cutoff_freq,json=cutoffFreq,proto3" json:"cutoff_freq,omitempty"`
+	Resonance  float64 `protobuf:"fixed64,2,opt,name=resonance,proto3" json:"resonance,omitempty"`
+}
+
+func (x *HighPass) Reset() {
+	*x = HighPass{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HighPass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighPass) ProtoMessage() {}
+
+func (x *HighPass) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighPass.ProtoReflect.Descriptor instead.
+func (*HighPass) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *HighPass) GetCutoffFreq() float64 {
+	if x != nil {
+		return x.CutoffFreq
+	}
+	return 0
+}
+
+func (x *HighPass) GetResonance() float64 {
+	if x != nil {
+		return x.Resonance
+	}
+	return 0
+}
+
+type Compressor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attack    float64 `protobuf:"fixed64,1,opt,name=attack,proto3" json:"attack,omitempty"`
+	Gain      float64 `protobuf:"fixed64,2,opt,name=gain,proto3" json:"gain,omitempty"`
+	Release   float64 `protobuf:"fixed64,3,opt,name=release,proto3" json:"release,omitempty"`
+	Treshhold float64 `protobuf:"fixed64,4,opt,name=treshhold,proto3" json:"treshhold,omitempty"`
+	Volume    float64 `protobuf:"fixed64,5,opt,name=volume,proto3" json:"volume,omitempty"`
+}
+
+func (x *Compressor) Reset() {
+	*x = Compressor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Compressor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Compressor) ProtoMessage() {}
+
+func (x *Compressor) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Compressor.ProtoReflect.Descriptor instead.
+func (*Compressor) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Compressor) GetAttack() float64 {
+	if x != nil {
+		return x.Attack
+	}
+	return 0
+}
+
+func (x *Compressor) GetGain() float64 {
+	if x != nil {
+		return x.Gain
+	}
+	return 0
+}
+
+func (x *Compressor) GetRelease() float64 {
+	if x != nil {
+		return x.Release
+	}
+	return 0
+}
+
+func (x *Compressor) GetTreshhold() float64 {
+	if x != nil {
+		return x.Treshhold
+	}
+	return 0
+}
+
+func (x *Compressor) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+type Headphone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string      `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name        string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName   string      `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description string      `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price       int64       `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight      float64     `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack    int64       `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity      string      `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid        *GridProps  `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified    int64       `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind        string      `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	AmbientVol  float64     `protobuf:"fixed64,12,opt,name=ambient_vol,json=ambientVol,proto3" json:"ambient_vol,omitempty"`
+	DryVol      float64     `protobuf:"fixed64,13,opt,name=dry_vol,json=dryVol,proto3" json:"dry_vol,omitempty"`
+	Distortion  float64     `protobuf:"fixed64,14,opt,name=distortion,proto3" json:"distortion,omitempty"`
+	Hpf         *HighPass   `protobuf:"bytes,15,opt,name=hpf,proto3" json:"hpf,omitempty"`
+	Compressor  *Compressor `protobuf:"bytes,16,opt,name=compressor,proto3" json:"compressor,omitempty"`
+}
+
+func (x *Headphone) Reset() {
+	*x = Headphone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Headphone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Headphone) ProtoMessage() {}
+
+func (x *Headphone) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Headphone.ProtoReflect.Descriptor instead.
+func (*Headphone) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Headphone) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Headphone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Headphone) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Headphone) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Headphone) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Headphone) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Headphone) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Headphone) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Headphone) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Headphone) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Headphone) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Headphone) GetAmbientVol() float64 {
+	if x != nil {
+		return x.AmbientVol
+	}
+	return 0
+}
+
+func (x *Headphone) GetDryVol() float64 {
+	if x != nil {
+		return x.DryVol
+	}
+	return 0
+}
+
+func (x *Headphone) GetDistortion() float64 {
+	if x != nil {
+		return x.Distortion
+	}
+	return 0
+}
+
+func (x *Headphone) GetHpf() *HighPass {
+	if x != nil {
+		return x.Hpf
+	}
+	return nil
+}
+
+func (x *Headphone) GetCompressor() *Compressor {
+	if x != nil {
+		return x.Compressor
+	}
+	return nil
+}
+
+type Key struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string     `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name        string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName   string     `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description string     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price       int64      `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight      float64    `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack    int64      `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity      string     `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid        *GridProps `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified    int64      `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind        string     `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	Location    string     `protobuf:"bytes,12,opt,name=location,proto3" json:"location,omitempty"`
+	Usages      int64      `protobuf:"varint,13,opt,name=usages,proto3" json:"usages,omitempty"`
+}
+
+func (x *Key) Reset() {
+	*x = Key{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Key) ProtoMessage() {}
+
+func (x *Key) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Key.ProtoReflect.Descriptor instead.
+func (*Key) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Key) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Key) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Key) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Key) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Key) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Key) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Key) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Key) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Key) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Key) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Key) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Key) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Key) GetUsages() int64 {
+	if x != nil {
+		return x.Usages
+	}
+	return 0
+}
+
+type MagazineModifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CheckTime  float64 `protobuf:"fixed64,1,opt,name=check_time,json=checkTime,proto3" json:"check_time,omitempty"`
+	LoadUnload float64 `protobuf:"fixed64,2,opt,name=load_unload,json=loadUnload,proto3" json:"load_unload,omitempty"`
+}
+
+func (x *MagazineModifier) Reset() {
+	*x = MagazineModifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MagazineModifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MagazineModifier) ProtoMessage() {}
+
+func (x *MagazineModifier) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MagazineModifier.ProtoReflect.Descriptor instead.
+func (*MagazineModifier) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MagazineModifier) GetCheckTime() float64 {
+	if x != nil {
+		return x.CheckTime
+	}
+	return 0
+}
+
+func (x *MagazineModifier) GetLoadUnload() float64 {
+	if x != nil {
+		return x.LoadUnload
+	}
+	return 0
+}
+
+type GridModifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Width  int64 `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+}
+
+func (x *GridModifier) Reset() {
+	*x = GridModifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GridModifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GridModifier) ProtoMessage() {}
+
+func (x *GridModifier) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GridModifier.ProtoReflect.Descriptor instead.
+func (*GridModifier) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GridModifier) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GridModifier) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+type Magazine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name              string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName         string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description       string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price             int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight            float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack          int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity            string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid              *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified          int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind              string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	Capacity          int64                          `protobuf:"varint,12,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Caliber           string                         `protobuf:"bytes,13,opt,name=caliber,proto3" json:"caliber,omitempty"`
+	ErgonomicsFP      float64                        `protobuf:"fixed64,14,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics        int64                          `protobuf:"varint,15,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	MalfunctionChance float64                        `protobuf:"fixed64,16,opt,name=malfunction_chance,json=malfunctionChance,proto3" json:"malfunction_chance,omitempty"`
+	Modifier          *MagazineModifier              `protobuf:"bytes,17,opt,name=modifier,proto3" json:"modifier,omitempty"`
+	GridModifier      *GridModifier                  `protobuf:"bytes,18,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Compatibility     map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts         map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Magazine) Reset() {
+	*x = Magazine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Magazine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Magazine) ProtoMessage() {}
+
+func (x *Magazine) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Magazine.ProtoReflect.Descriptor instead.
+func (*Magazine) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Magazine) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Magazine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Magazine) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Magazine) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Magazine) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Magazine) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Magazine) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Magazine) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Magazine) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Magazine) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Magazine) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Magazine) GetCapacity() int64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Magazine) GetCaliber() string {
+	if x != nil {
+		return x.Caliber
+	}
+	return ""
+}
+
+func (x *Magazine) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *Magazine) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *Magazine) GetMalfunctionChance() float64 {
+	if x != nil {
+		return x.MalfunctionChance
+	}
+	return 0
+}
+
+func (x *Magazine) GetModifier() *MagazineModifier {
+	if x != nil {
+		return x.Modifier
+	}
+	return nil
+}
+
+func (x *Magazine) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *Magazine) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Magazine) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type Map struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string     `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name        string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName   string     `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description string     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price       int64      `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight      float64    `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack    int64      `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity      string     `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid        *GridProps `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified    int64      `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind        string     `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+}
+
+func (x *Map) Reset() {
+	*x = Map{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Map) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Map) ProtoMessage() {}
+
+func (x *Map) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Map.ProtoReflect.Descriptor instead.
+func (*Map) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Map) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Map) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Map) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Map) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Map) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Map) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Map) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Map) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Map) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Map) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Map) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type Medical struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string     `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name         string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName    string     `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description  string     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price        int64      `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight       float64    `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack     int64      `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity       string     `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid         *GridProps `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified     int64      `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind         string     `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	Type         string     `protobuf:"bytes,12,opt,name=type,proto3" json:"type,omitempty"`
+	Resources    int64      `protobuf:"varint,13,opt,name=resources,proto3" json:"resources,omitempty"`
+	ResourceRate int64      `protobuf:"varint,14,opt,name=resource_rate,json=resourceRate,proto3" json:"resource_rate,omitempty"`
+	UseTime      float64    `protobuf:"fixed64,15,opt,name=use_time,json=useTime,proto3" json:"use_time,omitempty"`
+	Effects      *Effects   `protobuf:"bytes,16,opt,name=effects,proto3" json:"effects,omitempty"`
+}
+
+func (x *Medical) Reset() {
+	*x = Medical{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Medical) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Medical) ProtoMessage() {}
+
+func (x *Medical) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Medical.ProtoReflect.Descriptor instead.
+func (*Medical) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Medical) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Medical) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Medical) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Medical) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Medical) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Medical) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Medical) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Medical) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Medical) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Medical) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Medical) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Medical) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Medical) GetResources() int64 {
+	if x != nil {
+		return x.Resources
+	}
+	return 0
+}
+
+func (x *Medical) GetResourceRate() int64 {
+	if x != nil {
+		return x.ResourceRate
+	}
+	return 0
+}
+
+func (x *Medical) GetUseTime() float64 {
+	if x != nil {
+		return x.UseTime
+	}
+	return 0
+}
+
+func (x *Medical) GetEffects() *Effects {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
+type MeleeAttack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Damage      float64 `protobuf:"fixed64,1,opt,name=damage,proto3" json:"damage,omitempty"`
+	Rate        float64 `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	Range       float64 `protobuf:"fixed64,3,opt,name=range,proto3" json:"range,omitempty"`
+	Consumption float64 `protobuf:"fixed64,4,opt,name=consumption,proto3" json:"consumption,omitempty"`
+}
+
+func (x *MeleeAttack) Reset() {
+	*x = MeleeAttack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeleeAttack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeleeAttack) ProtoMessage() {}
+
+func (x *MeleeAttack) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeleeAttack.ProtoReflect.Descriptor instead.
+func (*MeleeAttack) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MeleeAttack) GetDamage() float64 {
+	if x != nil {
+		return x.Damage
+	}
+	return 0
+}
+
+func (x *MeleeAttack) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *MeleeAttack) GetRange() float64 {
+	if x != nil {
+		return x.Range
+	}
+	return 0
+}
+
+func (x *MeleeAttack) GetConsumption() float64 {
+	if x != nil {
+		return x.Consumption
+	}
+	return 0
+}
+
+type Melee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string       `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name        string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName   string       `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description string       `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price       int64        `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight      float64      `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack    int64        `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity      string       `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid        *GridProps   `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified    int64        `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind        string       `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	Slash       *MeleeAttack `protobuf:"bytes,12,opt,name=slash,proto3" json:"slash,omitempty"`
+	Stab        *MeleeAttack `protobuf:"bytes,13,opt,name=stab,proto3" json:"stab,omitempty"`
+}
+
+func (x *Melee) Reset() {
+	*x = Melee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Melee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Melee) ProtoMessage() {}
+
+func (x *Melee) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Melee.ProtoReflect.Descriptor instead.
+func (*Melee) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Melee) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Melee) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Melee) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Melee) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Melee) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Melee) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Melee) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Melee) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Melee) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Melee) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Melee) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Melee) GetSlash() *MeleeAttack {
+	if x != nil {
+		return x.Slash
+	}
+	return nil
+}
+
+func (x *Melee) GetStab() *MeleeAttack {
+	if x != nil {
+		return x.Stab
+	}
+	return nil
+}
+
+type Modification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name          string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName     string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description   string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight        float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack      int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity        string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid          *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified      int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind          string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	ErgonomicsFP  float64                        `protobuf:"fixed64,12,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics    int64                          `protobuf:"varint,13,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Accuracy      float64                        `protobuf:"fixed64,14,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil        float64                        `protobuf:"fixed64,15,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	RaidModdable  int64                          `protobuf:"varint,16,opt,name=raid_moddable,json=raidModdable,proto3" json:"raid_moddable,omitempty"`
+	GridModifier  *GridModifier                  `protobuf:"bytes,17,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Slots         map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts     map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Modification) Reset() {
+	*x = Modification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Modification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Modification) ProtoMessage() {}
+
+func (x *Modification) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Modification.ProtoReflect.Descriptor instead.
+func (*Modification) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Modification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Modification) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Modification) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Modification) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Modification) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Modification) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Modification) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Modification) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Modification) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Modification) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Modification) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Modification) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *Modification) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *Modification) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *Modification) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *Modification) GetRaidModdable() int64 {
+	if x != nil {
+		return x.RaidModdable
+	}
+	return 0
+}
+
+func (x *Modification) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *Modification) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Modification) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Modification) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type Barrel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name           string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName      string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description    string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price          int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight         float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack       int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity         string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid           *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified       int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind           string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	ErgonomicsFP   float64                        `protobuf:"fixed64,12,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics     int64                          `protobuf:"varint,13,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Accuracy       float64                        `protobuf:"fixed64,14,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil         float64                        `protobuf:"fixed64,15,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	RaidModdable   int64                          `protobuf:"varint,16,opt,name=raid_moddable,json=raidModdable,proto3" json:"raid_moddable,omitempty"`
+	GridModifier   *GridModifier                  `protobuf:"bytes,17,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Slots          map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility  map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts      map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Length         float64                        `protobuf:"fixed64,21,opt,name=length,proto3" json:"length,omitempty"`
+	Velocity       float64                        `protobuf:"fixed64,22,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	Suppressor     bool                           `protobuf:"varint,23,opt,name=suppressor,proto3" json:"suppressor,omitempty"`
+	CenterOfImpact float64                        `protobuf:"fixed64,24,opt,name=center_of_impact,json=centerOfImpact,proto3" json:"center_of_impact,omitempty"`
+	DurabilityBurn float64                        `protobuf:"fixed64,25,opt,name=durability_burn,json=durabilityBurn,proto3" json:"durability_burn,omitempty"`
+	HeatFactor     float64                        `protobuf:"fixed64,26,opt,name=heat_factor,json=heatFactor,proto3" json:"heat_factor,omitempty"`
+	CoolFactor     float64                        `protobuf:"fixed64,27,opt,name=cool_factor,json=coolFactor,proto3" json:"cool_factor,omitempty"`
+}
+
+func (x *Barrel) Reset() {
+	*x = Barrel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Barrel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Barrel) ProtoMessage() {}
+
+func (x *Barrel) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Barrel.ProtoReflect.Descriptor instead.
+func (*Barrel) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Barrel) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Barrel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Barrel) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Barrel) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Barrel) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Barrel) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Barrel) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Barrel) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Barrel) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Barrel) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Barrel) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Barrel) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *Barrel) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *Barrel) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *Barrel) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *Barrel) GetRaidModdable() int64 {
+	if x != nil {
+		return x.RaidModdable
+	}
+	return 0
+}
+
+func (x *Barrel) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *Barrel) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Barrel) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Barrel) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *Barrel) GetLength() float64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Barrel) GetVelocity() float64 {
+	if x != nil {
+		return x.Velocity
+	}
+	return 0
+}
+
+func (x *Barrel) GetSuppressor() bool {
+	if x != nil {
+		return x.Suppressor
+	}
+	return false
+}
+
+func (x *Barrel) GetCenterOfImpact() float64 {
+	if x != nil {
+		return x.CenterOfImpact
+	}
+	return 0
+}
+
+func (x *Barrel) GetDurabilityBurn() float64 {
+	if x != nil {
+		return x.DurabilityBurn
+	}
+	return 0
+}
+
+func (x *Barrel) GetHeatFactor() float64 {
+	if x != nil {
+		return x.HeatFactor
+	}
+	return 0
+}
+
+func (x *Barrel) GetCoolFactor() float64 {
+	if x != nil {
+		return x.CoolFactor
+	}
+	return 0
+}
+
+type Auxiliary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name           string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName      string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description    string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price          int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight         float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack       int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity         string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid           *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified       int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind           string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	ErgonomicsFP   float64                        `protobuf:"fixed64,12,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics     int64                          `protobuf:"varint,13,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Accuracy       float64                        `protobuf:"fixed64,14,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil         float64                        `protobuf:"fixed64,15,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	RaidModdable   int64                          `protobuf:"varint,16,opt,name=raid_moddable,json=raidModdable,proto3" json:"raid_moddable,omitempty"`
+	GridModifier   *GridModifier                  `protobuf:"bytes,17,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Slots          map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility  map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts      map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DurabilityBurn float64                        `protobuf:"fixed64,21,opt,name=durability_burn,json=durabilityBurn,proto3" json:"durability_burn,omitempty"`
+	HeatFactor     float64                        `protobuf:"fixed64,22,opt,name=heat_factor,json=heatFactor,proto3" json:"heat_factor,omitempty"`
+	CoolFactor     float64                        `protobuf:"fixed64,23,opt,name=cool_factor,json=coolFactor,proto3" json:"cool_factor,omitempty"`
+}
+
+func (x *Auxiliary) Reset() {
+	*x = Auxiliary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Auxiliary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auxiliary) ProtoMessage() {}
+
+func (x *Auxiliary) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auxiliary.ProtoReflect.Descriptor instead.
+func (*Auxiliary) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Auxiliary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Auxiliary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Auxiliary) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Auxiliary) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Auxiliary) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Auxiliary) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Auxiliary) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Auxiliary) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Auxiliary) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Auxiliary) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Auxiliary) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Auxiliary) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *Auxiliary) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *Auxiliary) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *Auxiliary) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *Auxiliary) GetRaidModdable() int64 {
+	if x != nil {
+		return x.RaidModdable
+	}
+	return 0
+}
+
+func (x *Auxiliary) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *Auxiliary) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Auxiliary) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Auxiliary) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *Auxiliary) GetDurabilityBurn() float64 {
+	if x != nil {
+		return x.DurabilityBurn
+	}
+	return 0
+}
+
+func (x *Auxiliary) GetHeatFactor() float64 {
+	if x != nil {
+		return x.HeatFactor
+	}
+	return 0
+}
+
+func (x *Auxiliary) GetCoolFactor() float64 {
+	if x != nil {
+		return x.CoolFactor
+	}
+	return 0
+}
+
+type Bipod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name          string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName     string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description   string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight        float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack      int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity        string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid          *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified      int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind          string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	ErgonomicsFP  float64                        `protobuf:"fixed64,12,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics    int64                          `protobuf:"varint,13,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Accuracy      float64                        `protobuf:"fixed64,14,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil        float64                        `protobuf:"fixed64,15,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	RaidModdable  int64                          `protobuf:"varint,16,opt,name=raid_moddable,json=raidModdable,proto3" json:"raid_moddable,omitempty"`
+	GridModifier  *GridModifier                  `protobuf:"bytes,17,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Slots         map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts     map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Bipod) Reset() {
+	*x = Bipod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bipod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bipod) ProtoMessage() {}
+
+func (x *Bipod) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bipod.ProtoReflect.Descriptor instead.
+func (*Bipod) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Bipod) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Bipod) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Bipod) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Bipod) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Bipod) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Bipod) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Bipod) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Bipod) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Bipod) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Bipod) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Bipod) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Bipod) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *Bipod) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *Bipod) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *Bipod) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *Bipod) GetRaidModdable() int64 {
+	if x != nil {
+		return x.RaidModdable
+	}
+	return 0
+}
+
+func (x *Bipod) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *Bipod) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Bipod) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Bipod) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type Charge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name          string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName     string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description   string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight        float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack      int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity        string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid          *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified      int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind          string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	ErgonomicsFP  float64                        `protobuf:"fixed64,12,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics    int64                          `protobuf:"varint,13,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Accuracy      float64                        `protobuf:"fixed64,14,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil        float64                        `protobuf:"fixed64,15,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	RaidModdable  int64                          `protobuf:"varint,16,opt,name=raid_moddable,json=raidModdable,proto3" json:"raid_moddable,omitempty"`
+	GridModifier  *GridModifier                  `protobuf:"bytes,17,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Slots         map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts     map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Charge) Reset() {
+	*x = Charge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Charge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Charge) ProtoMessage() {}
+
+func (x *Charge) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Charge.ProtoReflect.Descriptor instead.
+func (*Charge) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Charge) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Charge) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Charge) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Charge) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Charge) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Charge) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Charge) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Charge) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Charge) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Charge) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Charge) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Charge) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *Charge) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *Charge) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *Charge) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *Charge) GetRaidModdable() int64 {
+	if x != nil {
+		return x.RaidModdable
+	}
+	return 0
+}
+
+func (x *Charge) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *Charge) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Charge) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Charge) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type Device struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name          string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName     string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description   string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight        float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack      int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity        string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid          *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified      int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind          string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	ErgonomicsFP  float64                        `protobuf:"fixed64,12,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics    int64                          `protobuf:"varint,13,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Accuracy      float64                        `protobuf:"fixed64,14,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil        float64                        `protobuf:"fixed64,15,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	RaidModdable  int64                          `protobuf:"varint,16,opt,name=raid_moddable,json=raidModdable,proto3" json:"raid_moddable,omitempty"`
+	GridModifier  *GridModifier                  `protobuf:"bytes,17,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Slots         map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts     map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Type          string                         `protobuf:"bytes,21,opt,name=type,proto3" json:"type,omitempty"`
+	Modes         []string                       `protobuf:"bytes,22,rep,name=modes,proto3" json:"modes,omitempty"`
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Device) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Device) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Device) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Device) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Device) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Device) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Device) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Device) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Device) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Device) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Device) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Device) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *Device) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *Device) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *Device) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *Device) GetRaidModdable() int64 {
+	if x != nil {
+		return x.RaidModdable
+	}
+	return 0
+}
+
+func (x *Device) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *Device) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Device) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Device) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *Device) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Device) GetModes() []string {
+	if x != nil {
+		return x.Modes
+	}
+	return nil
+}
+
+type Foregrip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name          string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName     string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description   string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight        float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack      int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity        string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid          *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified      int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind          string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	ErgonomicsFP  float64                        `protobuf:"fixed64,12,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics    int64                          `protobuf:"varint,13,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Accuracy      float64                        `protobuf:"fixed64,14,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil        float64                        `protobuf:"fixed64,15,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	RaidModdable  int64                          `protobuf:"varint,16,opt,name=raid_moddable,json=raidModdable,proto3" json:"raid_moddable,omitempty"`
+	GridModifier  *GridModifier                  `protobuf:"bytes,17,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Slots         map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts     map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Foregrip) Reset() {
+	*x = Foregrip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Foregrip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Foregrip) ProtoMessage() {}
+
+func (x *Foregrip) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Foregrip.ProtoReflect.Descriptor instead.
+func (*Foregrip) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Foregrip) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Foregrip) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Foregrip) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Foregrip) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Foregrip) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Foregrip) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Foregrip) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Foregrip) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Foregrip) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Foregrip) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Foregrip) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Foregrip) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *Foregrip) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *Foregrip) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *Foregrip) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *Foregrip) GetRaidModdable() int64 {
+	if x != nil {
+		return x.RaidModdable
+	}
+	return 0
+}
+
+func (x *Foregrip) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *Foregrip) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Foregrip) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Foregrip) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type GasBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name           string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName      string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description    string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price          int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight         float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack       int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity         string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid           *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified       int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind           string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	ErgonomicsFP   float64                        `protobuf:"fixed64,12,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics     int64                          `protobuf:"varint,13,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Accuracy       float64                        `protobuf:"fixed64,14,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil         float64                        `protobuf:"fixed64,15,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	RaidModdable   int64                          `protobuf:"varint,16,opt,name=raid_moddable,json=raidModdable,proto3" json:"raid_moddable,omitempty"`
+	GridModifier   *GridModifier                  `protobuf:"bytes,17,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Slots          map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility  map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts      map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DurabilityBurn float64                        `protobuf:"fixed64,21,opt,name=durability_burn,json=durabilityBurn,proto3" json:"durability_burn,omitempty"`
+	HeatFactor     float64                        `protobuf:"fixed64,22,opt,name=heat_factor,json=heatFactor,proto3" json:"heat_factor,omitempty"`
+	CoolFactor     float64                        `protobuf:"fixed64,23,opt,name=cool_factor,json=coolFactor,proto3" json:"cool_factor,omitempty"`
+}
+
+func (x *GasBlock) Reset() {
+	*x = GasBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GasBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GasBlock) ProtoMessage() {}
+
+func (x *GasBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GasBlock.ProtoReflect.Descriptor instead.
+func (*GasBlock) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GasBlock) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GasBlock) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GasBlock) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *GasBlock) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GasBlock) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *GasBlock) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *GasBlock) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *GasBlock) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *GasBlock) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *GasBlock) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *GasBlock) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GasBlock) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *GasBlock) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *GasBlock) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *GasBlock) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *GasBlock) GetRaidModdable() int64 {
+	if x != nil {
+		return x.RaidModdable
+	}
+	return 0
+}
+
+func (x *GasBlock) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *GasBlock) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *GasBlock) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *GasBlock) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *GasBlock) GetDurabilityBurn() float64 {
+	if x != nil {
+		return x.DurabilityBurn
+	}
+	return 0
+}
+
+func (x *GasBlock) GetHeatFactor() float64 {
+	if x != nil {
+		return x.HeatFactor
+	}
+	return 0
+}
+
+func (x *GasBlock) GetCoolFactor() float64 {
+	if x != nil {
+		return x.CoolFactor
+	}
+	return 0
+}
+
+type Goggles struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name          string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName     string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description   string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight        float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack      int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity        string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid          *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified      int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind          string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	ErgonomicsFP  float64                        `protobuf:"fixed64,12,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics    int64                          `protobuf:"varint,13,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Accuracy      float64                        `protobuf:"fixed64,14,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil        float64                        `protobuf:"fixed64,15,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	RaidModdable  int64                          `protobuf:"varint,16,opt,name=raid_moddable,json=raidModdable,proto3" json:"raid_moddable,omitempty"`
+	GridModifier  *GridModifier                  `protobuf:"bytes,17,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Slots         map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts     map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Type          string                         `protobuf:"bytes,21,opt,name=type,proto3" json:"type,omitempty"`
+	Modes         []string                       `protobuf:"bytes,22,rep,name=modes,proto3" json:"modes,omitempty"`
+	Color         *RGBA                          `protobuf:"bytes,23,opt,name=color,proto3" json:"color,omitempty"`
+	Noise         string                         `protobuf:"bytes,24,opt,name=noise,proto3" json:"noise,omitempty"`
+}
+
+func (x *Goggles) Reset() {
+	*x = Goggles{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Goggles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Goggles) ProtoMessage() {}
+
+func (x *Goggles) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Goggles.ProtoReflect.Descriptor instead.
+func (*Goggles) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Goggles) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Goggles) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Goggles) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Goggles) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Goggles) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Goggles) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Goggles) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Goggles) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Goggles) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Goggles) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Goggles) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Goggles) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *Goggles) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *Goggles) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *Goggles) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *Goggles) GetRaidModdable() int64 {
+	if x != nil {
+		return x.RaidModdable
+	}
+	return 0
+}
+
+func (x *Goggles) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *Goggles) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Goggles) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Goggles) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *Goggles) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Goggles) GetModes() []string {
+	if x != nil {
+		return x.Modes
+	}
+	return nil
+}
+
+func (x *Goggles) GetColor() *RGBA {
+	if x != nil {
+		return x.Color
+	}
+	return nil
+}
+
+func (x *Goggles) GetNoise() string {
+	if x != nil {
+		return x.Noise
+	}
+	return ""
+}
+
+type Handguard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name          string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName     string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description   string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight        float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack      int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity        string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid          *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified      int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind          string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	ErgonomicsFP  float64                        `protobuf:"fixed64,12,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics    int64                          `protobuf:"varint,13,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Accuracy      float64                        `protobuf:"fixed64,14,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil        float64                        `protobuf:"fixed64,15,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	RaidModdable  int64                          `protobuf:"varint,16,opt,name=raid_moddable,json=raidModdable,proto3" json:"raid_moddable,omitempty"`
+	GridModifier  *GridModifier                  `protobuf:"bytes,17,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Slots         map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts     map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	HeatFactor    float64                        `protobuf:"fixed64,21,opt,name=heat_factor,json=heatFactor,proto3" json:"heat_factor,omitempty"`
+	CoolFactor    float64                        `protobuf:"fixed64,22,opt,name=cool_factor,json=coolFactor,proto3" json:"cool_factor,omitempty"`
+}
+
+func (x *Handguard) Reset() {
+	*x = Handguard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Handguard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Handguard) ProtoMessage() {}
+
+func (x *Handguard) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Handguard.ProtoReflect.Descriptor instead.
+func (*Handguard) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Handguard) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Handguard) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Handguard) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Handguard) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Handguard) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Handguard) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Handguard) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Handguard) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Handguard) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Handguard) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Handguard) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Handguard) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *Handguard) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *Handguard) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *Handguard) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *Handguard) GetRaidModdable() int64 {
+	if x != nil {
+		return x.RaidModdable
+	}
+	return 0
+}
+
+func (x *Handguard) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *Handguard) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Handguard) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Handguard) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *Handguard) GetHeatFactor() float64 {
+	if x != nil {
+		return x.HeatFactor
+	}
+	return 0
+}
+
+func (x *Handguard) GetCoolFactor() float64 {
+	if x != nil {
+		return x.CoolFactor
+	}
+	return 0
+}
+
+type Launcher struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name          string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName     string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description   string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight        float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack      int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity        string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid          *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified      int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind          string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	ErgonomicsFP  float64                        `protobuf:"fixed64,12,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics    int64                          `protobuf:"varint,13,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Accuracy      float64                        `protobuf:"fixed64,14,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil        float64                        `protobuf:"fixed64,15,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	RaidModdable  int64                          `protobuf:"varint,16,opt,name=raid_moddable,json=raidModdable,proto3" json:"raid_moddable,omitempty"`
+	GridModifier  *GridModifier                  `protobuf:"bytes,17,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Slots         map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts     map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Caliber       string                         `protobuf:"bytes,21,opt,name=caliber,proto3" json:"caliber,omitempty"`
+}
+
+func (x *Launcher) Reset() {
+	*x = Launcher{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Launcher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Launcher) ProtoMessage() {}
+
+func (x *Launcher) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Launcher.ProtoReflect.Descriptor instead.
+func (*Launcher) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Launcher) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Launcher) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Launcher) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Launcher) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Launcher) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Launcher) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Launcher) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Launcher) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Launcher) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Launcher) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Launcher) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Launcher) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *Launcher) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *Launcher) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *Launcher) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *Launcher) GetRaidModdable() int64 {
+	if x != nil {
+		return x.RaidModdable
+	}
+	return 0
+}
+
+func (x *Launcher) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *Launcher) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Launcher) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Launcher) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *Launcher) GetCaliber() string {
+	if x != nil {
+		return x.Caliber
+	}
+	return ""
+}
+
+type Mount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name          string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName     string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description   string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight        float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack      int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity        string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid          *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified      int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind          string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	ErgonomicsFP  float64                        `protobuf:"fixed64,12,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics    int64                          `protobuf:"varint,13,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Accuracy      float64                        `protobuf:"fixed64,14,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil        float64                        `protobuf:"fixed64,15,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	RaidModdable  int64                          `protobuf:"varint,16,opt,name=raid_moddable,json=raidModdable,proto3" json:"raid_moddable,omitempty"`
+	GridModifier  *GridModifier                  `protobuf:"bytes,17,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Slots         map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts     map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	HeatFactor    float64                        `protobuf:"fixed64,21,opt,name=heat_factor,json=heatFactor,proto3" json:"heat_factor,omitempty"`
+	CoolFactor    float64                        `protobuf:"fixed64,22,opt,name=cool_factor,json=coolFactor,proto3" json:"cool_factor,omitempty"`
+}
+
+func (x *Mount) Reset() {
+	*x = Mount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Mount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mount) ProtoMessage() {}
+
+func (x *Mount) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mount.ProtoReflect.Descriptor instead.
+func (*Mount) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Mount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Mount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Mount) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Mount) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Mount) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Mount) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Mount) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Mount) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Mount) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Mount) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Mount) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Mount) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *Mount) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *Mount) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *Mount) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *Mount) GetRaidModdable() int64 {
+	if x != nil {
+		return x.RaidModdable
+	}
+	return 0
+}
+
+func (x *Mount) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *Mount) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Mount) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Mount) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *Mount) GetHeatFactor() float64 {
+	if x != nil {
+		return x.HeatFactor
+	}
+	return 0
+}
+
+func (x *Mount) GetCoolFactor() float64 {
+	if x != nil {
+		return x.CoolFactor
+	}
+	return 0
+}
+
+type Muzzle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name           string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName      string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description    string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price          int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight         float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack       int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity         string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid           *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified       int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind           string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	ErgonomicsFP   float64                        `protobuf:"fixed64,12,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics     int64                          `protobuf:"varint,13,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Accuracy       float64                        `protobuf:"fixed64,14,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil         float64                        `protobuf:"fixed64,15,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	RaidModdable   int64                          `protobuf:"varint,16,opt,name=raid_moddable,json=raidModdable,proto3" json:"raid_moddable,omitempty"`
+	GridModifier   *GridModifier                  `protobuf:"bytes,17,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Slots          map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility  map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts      map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Type           string                         `protobuf:"bytes,21,opt,name=type,proto3" json:"type,omitempty"`
+	Velocity       float64                        `protobuf:"fixed64,22,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	Loudness       float64                        `protobuf:"fixed64,23,opt,name=loudness,proto3" json:"loudness,omitempty"`
+	DurabilityBurn float64                        `protobuf:"fixed64,24,opt,name=durability_burn,json=durabilityBurn,proto3" json:"durability_burn,omitempty"`
+	HeatFactor     float64                        `protobuf:"fixed64,25,opt,name=heat_factor,json=heatFactor,proto3" json:"heat_factor,omitempty"`
+	CoolFactor     float64                        `protobuf:"fixed64,26,opt,name=cool_factor,json=coolFactor,proto3" json:"cool_factor,omitempty"`
+}
+
+func (x *Muzzle) Reset() {
+	*x = Muzzle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Muzzle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Muzzle) ProtoMessage() {}
+
+func (x *Muzzle) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Muzzle.ProtoReflect.Descriptor instead.
+func (*Muzzle) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Muzzle) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Muzzle) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Muzzle) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Muzzle) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Muzzle) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Muzzle) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Muzzle) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Muzzle) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Muzzle) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Muzzle) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Muzzle) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Muzzle) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *Muzzle) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *Muzzle) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *Muzzle) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *Muzzle) GetRaidModdable() int64 {
+	if x != nil {
+		return x.RaidModdable
+	}
+	return 0
+}
+
+func (x *Muzzle) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *Muzzle) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Muzzle) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Muzzle) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *Muzzle) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Muzzle) GetVelocity() float64 {
+	if x != nil {
+		return x.Velocity
+	}
+	return 0
+}
+
+func (x *Muzzle) GetLoudness() float64 {
+	if x != nil {
+		return x.Loudness
+	}
+	return 0
+}
+
+func (x *Muzzle) GetDurabilityBurn() float64 {
+	if x != nil {
+		return x.DurabilityBurn
+	}
+	return 0
+}
+
+func (x *Muzzle) GetHeatFactor() float64 {
+	if x != nil {
+		return x.HeatFactor
+	}
+	return 0
+}
+
+func (x *Muzzle) GetCoolFactor() float64 {
+	if x != nil {
+		return x.CoolFactor
+	}
+	return 0
+}
+
+type PistolGrip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name          string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName     string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description   string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight        float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack      int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity        string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid          *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified      int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind          string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	ErgonomicsFP  float64                        `protobuf:"fixed64,12,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics    int64                          `protobuf:"varint,13,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Accuracy      float64                        `protobuf:"fixed64,14,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil        float64                        `protobuf:"fixed64,15,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	RaidModdable  int64                          `protobuf:"varint,16,opt,name=raid_moddable,json=raidModdable,proto3" json:"raid_moddable,omitempty"`
+	GridModifier  *GridModifier                  `protobuf:"bytes,17,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Slots         map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts     map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PistolGrip) Reset() {
+	*x = PistolGrip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PistolGrip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PistolGrip) ProtoMessage() {}
+
+func (x *PistolGrip) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PistolGrip.ProtoReflect.Descriptor instead.
+func (*PistolGrip) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PistolGrip) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PistolGrip) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PistolGrip) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *PistolGrip) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PistolGrip) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PistolGrip) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *PistolGrip) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *PistolGrip) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *PistolGrip) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *PistolGrip) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *PistolGrip) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PistolGrip) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *PistolGrip) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *PistolGrip) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *PistolGrip) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *PistolGrip) GetRaidModdable() int64 {
+	if x != nil {
+		return x.RaidModdable
+	}
+	return 0
+}
+
+func (x *PistolGrip) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *PistolGrip) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *PistolGrip) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *PistolGrip) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type Receiver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name           string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName      string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description    string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price          int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight         float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack       int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity         string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid           *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified       int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind           string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	ErgonomicsFP   float64                        `protobuf:"fixed64,12,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics     int64                          `protobuf:"varint,13,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Accuracy       float64                        `protobuf:"fixed64,14,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil         float64                        `protobuf:"fixed64,15,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	RaidModdable   int64                          `protobuf:"varint,16,opt,name=raid_moddable,json=raidModdable,proto3" json:"raid_moddable,omitempty"`
+	GridModifier   *GridModifier                  `protobuf:"bytes,17,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Slots          map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility  map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts      map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Velocity       float64                        `protobuf:"fixed64,21,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	DurabilityBurn float64                        `protobuf:"fixed64,22,opt,name=durability_burn,json=durabilityBurn,proto3" json:"durability_burn,omitempty"`
+	HeatFactor     float64                        `protobuf:"fixed64,23,opt,name=heat_factor,json=heatFactor,proto3" json:"heat_factor,omitempty"`
+	CoolFactor     float64                        `protobuf:"fixed64,24,opt,name=cool_factor,json=coolFactor,proto3" json:"cool_factor,omitempty"`
+}
+
+func (x *Receiver) Reset() {
+	*x = Receiver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Receiver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receiver) ProtoMessage() {}
+
+func (x *Receiver) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receiver.ProtoReflect.Descriptor instead.
+func (*Receiver) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Receiver) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Receiver) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Receiver) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Receiver) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Receiver) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Receiver) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Receiver) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Receiver) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Receiver) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Receiver) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Receiver) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Receiver) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *Receiver) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *Receiver) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *Receiver) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *Receiver) GetRaidModdable() int64 {
+	if x != nil {
+		return x.RaidModdable
+	}
+	return 0
+}
+
+func (x *Receiver) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *Receiver) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Receiver) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Receiver) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *Receiver) GetVelocity() float64 {
+	if x != nil {
+		return x.Velocity
+	}
+	return 0
+}
+
+func (x *Receiver) GetDurabilityBurn() float64 {
+	if x != nil {
+		return x.DurabilityBurn
+	}
+	return 0
+}
+
+func (x *Receiver) GetHeatFactor() float64 {
+	if x != nil {
+		return x.HeatFactor
+	}
+	return 0
+}
+
+func (x *Receiver) GetCoolFactor() float64 {
+	if x != nil {
+		return x.CoolFactor
+	}
+	return 0
+}
+
+type Sight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name          string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName     string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description   string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight        float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack      int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity        string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid          *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified      int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind          string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	ErgonomicsFP  float64                        `protobuf:"fixed64,12,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics    int64                          `protobuf:"varint,13,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Accuracy      float64                        `protobuf:"fixed64,14,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil        float64                        `protobuf:"fixed64,15,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	RaidModdable  int64                          `protobuf:"varint,16,opt,name=raid_moddable,json=raidModdable,proto3" json:"raid_moddable,omitempty"`
+	GridModifier  *GridModifier                  `protobuf:"bytes,17,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Slots         map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts     map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Type          string                         `protobuf:"bytes,21,opt,name=type,proto3" json:"type,omitempty"`
+	Magnification []string                       `protobuf:"bytes,22,rep,name=magnification,proto3" json:"magnification,omitempty"`
+	VariableZoom  bool                           `protobuf:"varint,23,opt,name=variable_zoom,json=variableZoom,proto3" json:"variable_zoom,omitempty"`
+	ZeroDistances []int64                        `protobuf:"varint,24,rep,packed,name=zero_distances,json=zeroDistances,proto3" json:"zero_distances,omitempty"`
+}
+
+func (x *Sight) Reset() {
+	*x = Sight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sight) ProtoMessage() {}
+
+func (x *Sight) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sight.ProtoReflect.Descriptor instead.
+func (*Sight) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Sight) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Sight) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Sight) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Sight) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Sight) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Sight) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Sight) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Sight) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Sight) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Sight) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Sight) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Sight) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *Sight) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *Sight) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *Sight) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *Sight) GetRaidModdable() int64 {
+	if x != nil {
+		return x.RaidModdable
+	}
+	return 0
+}
+
+func (x *Sight) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *Sight) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Sight) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Sight) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *Sight) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Sight) GetMagnification() []string {
+	if x != nil {
+		return x.Magnification
+	}
+	return nil
+}
+
+func (x *Sight) GetVariableZoom() bool {
+	if x != nil {
+		return x.VariableZoom
+	}
+	return false
+}
+
+func (x *Sight) GetZeroDistances() []int64 {
+	if x != nil {
+		return x.ZeroDistances
+	}
+	return nil
+}
+
+type SightSpecial struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name          string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName     string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description   string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight        float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack      int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity        string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid          *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified      int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind          string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	ErgonomicsFP  float64                        `protobuf:"fixed64,12,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics    int64                          `protobuf:"varint,13,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Accuracy      float64                        `protobuf:"fixed64,14,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil        float64                        `protobuf:"fixed64,15,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	RaidModdable  int64                          `protobuf:"varint,16,opt,name=raid_moddable,json=raidModdable,proto3" json:"raid_moddable,omitempty"`
+	GridModifier  *GridModifier                  `protobuf:"bytes,17,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Slots         map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts     map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Type          string                         `protobuf:"bytes,21,opt,name=type,proto3" json:"type,omitempty"`
+	Magnification []string                       `protobuf:"bytes,22,rep,name=magnification,proto3" json:"magnification,omitempty"`
+	VariableZoom  bool                           `protobuf:"varint,23,opt,name=variable_zoom,json=variableZoom,proto3" json:"variable_zoom,omitempty"`
+	ZeroDistances []int64                        `protobuf:"varint,24,rep,packed,name=zero_distances,json=zeroDistances,proto3" json:"zero_distances,omitempty"`
+	Modes         []string                       `protobuf:"bytes,25,rep,name=modes,proto3" json:"modes,omitempty"`
+	Color         *RGBA                          `protobuf:"bytes,26,opt,name=color,proto3" json:"color,omitempty"`
+	Noise         string                         `protobuf:"bytes,27,opt,name=noise,proto3" json:"noise,omitempty"`
+}
+
+func (x *SightSpecial) Reset() {
+	*x = SightSpecial{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SightSpecial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SightSpecial) ProtoMessage() {}
+
+func (x *SightSpecial) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SightSpecial.ProtoReflect.Descriptor instead.
+func (*SightSpecial) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SightSpecial) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SightSpecial) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SightSpecial) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *SightSpecial) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SightSpecial) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SightSpecial) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *SightSpecial) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *SightSpecial) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *SightSpecial) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *SightSpecial) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *SightSpecial) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SightSpecial) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *SightSpecial) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *SightSpecial) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *SightSpecial) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *SightSpecial) GetRaidModdable() int64 {
+	if x != nil {
+		return x.RaidModdable
+	}
+	return 0
+}
+
+func (x *SightSpecial) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *SightSpecial) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *SightSpecial) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *SightSpecial) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *SightSpecial) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SightSpecial) GetMagnification() []string {
+	if x != nil {
+		return x.Magnification
+	}
+	return nil
+}
+
+func (x *SightSpecial) GetVariableZoom() bool {
+	if x != nil {
+		return x.VariableZoom
+	}
+	return false
+}
+
+func (x *SightSpecial) GetZeroDistances() []int64 {
+	if x != nil {
+		return x.ZeroDistances
+	}
+	return nil
+}
+
+func (x *SightSpecial) GetModes() []string {
+	if x != nil {
+		return x.Modes
+	}
+	return nil
+}
+
+func (x *SightSpecial) GetColor() *RGBA {
+	if x != nil {
+		return x.Color
+	}
+	return nil
+}
+
+func (x *SightSpecial) GetNoise() string {
+	if x != nil {
+		return x.Noise
+	}
+	return ""
+}
+
+type Stock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string                         `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name             string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName        string                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description      string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price            int64                          `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight           float64                        `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack         int64                          `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity           string                         `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid             *GridProps                     `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified         int64                          `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind             string                         `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	ErgonomicsFP     float64                        `protobuf:"fixed64,12,opt,name=ergonomics_f_p,json=ergonomicsFP,proto3" json:"ergonomics_f_p,omitempty"`
+	Ergonomics       int64                          `protobuf:"varint,13,opt,name=ergonomics,proto3" json:"ergonomics,omitempty"`
+	Accuracy         float64                        `protobuf:"fixed64,14,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Recoil           float64                        `protobuf:"fixed64,15,opt,name=recoil,proto3" json:"recoil,omitempty"`
+	RaidModdable     int64                          `protobuf:"varint,16,opt,name=raid_moddable,json=raidModdable,proto3" json:"raid_moddable,omitempty"`
+	GridModifier     *GridModifier                  `protobuf:"bytes,17,opt,name=grid_modifier,json=gridModifier,proto3" json:"grid_modifier,omitempty"`
+	Slots            map[string]*Slot               `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compatibility    map[string]*structpb.ListValue `protobuf:"bytes,19,rep,name=compatibility,proto3" json:"compatibility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Conflicts        map[string]*structpb.ListValue `protobuf:"bytes,20,rep,name=conflicts,proto3" json:"conflicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FoldRectractable bool                           `protobuf:"varint,21,opt,name=fold_rectractable,json=foldRectractable,proto3" json:"fold_rectractable,omitempty"`
+	HeatFactor       float64                        `protobuf:"fixed64,22,opt,name=heat_factor,json=heatFactor,proto3" json:"heat_factor,omitempty"`
+	CoolFactor       float64                        `protobuf:"fixed64,23,opt,name=cool_factor,json=coolFactor,proto3" json:"cool_factor,omitempty"`
+}
+
+func (x *Stock) Reset() {
+	*x = Stock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stock) ProtoMessage() {}
+
+func (x *Stock) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stock.ProtoReflect.Descriptor instead.
+func (*Stock) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Stock) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Stock) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Stock) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Stock) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Stock) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Stock) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Stock) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Stock) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Stock) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Stock) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Stock) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Stock) GetErgonomicsFP() float64 {
+	if x != nil {
+		return x.ErgonomicsFP
+	}
+	return 0
+}
+
+func (x *Stock) GetErgonomics() int64 {
+	if x != nil {
+		return x.Ergonomics
+	}
+	return 0
+}
+
+func (x *Stock) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *Stock) GetRecoil() float64 {
+	if x != nil {
+		return x.Recoil
+	}
+	return 0
+}
+
+func (x *Stock) GetRaidModdable() int64 {
+	if x != nil {
+		return x.RaidModdable
+	}
+	return 0
+}
+
+func (x *Stock) GetGridModifier() *GridModifier {
+	if x != nil {
+		return x.GridModifier
+	}
+	return nil
+}
+
+func (x *Stock) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Stock) GetCompatibility() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Stock) GetConflicts() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *Stock) GetFoldRectractable() bool {
+	if x != nil {
+		return x.FoldRectractable
+	}
+	return false
+}
+
+func (x *Stock) GetHeatFactor() float64 {
+	if x != nil {
+		return x.HeatFactor
+	}
+	return 0
+}
+
+func (x *Stock) GetCoolFactor() float64 {
+	if x != nil {
+		return x.CoolFactor
+	}
+	return 0
+}
+
+type Money struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string     `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name        string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName   string     `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description string     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price       int64      `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight      float64    `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack    int64      `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity      string     `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid        *GridProps `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified    int64      `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind        string     `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Money) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Money) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Money) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Money) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Money) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Money) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Money) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Money) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Money) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Money) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *Money) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type TacticalRig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string            `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Name            string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName       string            `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Description     string            `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price           int64             `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Weight          float64           `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxStack        int64             `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Rarity          string            `protobuf:"bytes,8,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Grid            *GridProps        `protobuf:"bytes,9,opt,name=grid,proto3" json:"grid,omitempty"`
+	Modified        int64             `protobuf:"varint,10,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+	Kind            string            `protobuf:"bytes,11,opt,name=kind,json=_kind,proto3" json:"kind,omitempty"`
+	Capacity        int64             `protobuf:"varint,12,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Grids           []*Grid           `protobuf:"bytes,13,rep,name=grids,proto3" json:"grids,omitempty"`
+	Penalties       *Penalties        `protobuf:"bytes,14,opt,name=penalties,proto3" json:"penalties,omitempty"`
+	Armor           *ArmorProps       `protobuf:"bytes,15,opt,name=armor,proto3" json:"armor,omitempty"`
+	ArmorComponents []*ArmorComponent `protobuf:"bytes,16,rep,name=armor_components,json=armorComponents,proto3" json:"armor_components,omitempty"`
+	IsPlateCarrier  bool              `protobuf:"varint,17,opt,name=is_plate_carrier,json=isPlateCarrier,proto3" json:"is_plate_carrier,omitempty"`
+	Slots           map[string]*Slot  `protobuf:"bytes,18,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *TacticalRig) Reset() {
+	*x = TacticalRig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_item_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TacticalRig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TacticalRig) ProtoMessage() {}
+
+func (x *TacticalRig) ProtoReflect() protoreflect.Message {
+	mi := &file_item_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TacticalRig.ProtoReflect.Descriptor instead.
+func (*TacticalRig) Descriptor() ([]byte, []int) {
+	return file_item_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TacticalRig) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TacticalRig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TacticalRig) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *TacticalRig) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TacticalRig) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *TacticalRig) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *TacticalRig) GetMaxStack() int64 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *TacticalRig) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *TacticalRig) GetGrid() *GridProps {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *TacticalRig) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *TacticalRig) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *TacticalRig) GetCapacity() int64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *TacticalRig) GetGrids() []*Grid {
+	if x != nil {
+		return x.Grids
+	}
+	return nil
+}
+
+func (x *TacticalRig) GetPenalties() *Penalties {
+	if x != nil {
+		return x.Penalties
+	}
+	return nil
+}
+
+func (x *TacticalRig) GetArmor() *ArmorProps {
+	if x != nil {
+		return x.Armor
+	}
+	return nil
+}
+
+func (x *TacticalRig) GetArmorComponents() []*ArmorComponent {
+	if x != nil {
+		return x.ArmorComponents
+	}
+	return nil
+}
+
+func (x *TacticalRig) GetIsPlateCarrier() bool {
+	if x != nil {
+		return x.IsPlateCarrier
+	}
+	return false
+}
+
+func (x *TacticalRig) GetSlots() map[string]*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+var File_item_proto protoreflect.FileDescriptor
+
+var file_item_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x74, 0x61,
+	0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x11, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x37,
+	0x0a, 0x0a, 0x61, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x41,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x05, 0x61, 0x72, 0x6d, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x61, 0x72, 0x6d, 0x6f,
+	0x72, 0x12, 0x31, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e,
+	0x42, 0x61, 0x63, 0x6b, 0x70, 0x61, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b,
+	0x70, 0x61, 0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x06, 0x62, 0x61, 0x72, 0x74, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32,
+	0x2e, 0x42, 0x61, 0x72, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x62, 0x61, 0x72, 0x74, 0x65,
+	0x72, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e,
+	0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x74,
+	0x68, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x48, 0x00,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x07, 0x66,
+	0x69, 0x72, 0x65, 0x61, 0x72, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74,
+	0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x61, 0x72, 0x6d,
+	0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x72, 0x65, 0x61, 0x72, 0x6d, 0x12, 0x25, 0x0a, 0x04, 0x66,
+	0x6f, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x72, 0x6b,
+	0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x6f, 0x6f, 0x64, 0x48, 0x00, 0x52, 0x04, 0x66, 0x6f,
+	0x6f, 0x64, 0x12, 0x2e, 0x0a, 0x07, 0x67, 0x72, 0x65, 0x6e, 0x61, 0x64, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e,
+	0x47, 0x72, 0x65, 0x6e, 0x61, 0x64, 0x65, 0x48, 0x00, 0x52, 0x07, 0x67, 0x72, 0x65, 0x6e, 0x61,
+	0x64, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x64, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76,
+	0x32, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x48, 0x00, 0x52, 0x09, 0x68,
+	0x65, 0x61, 0x64, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76,
+	0x32, 0x2e, 0x4b, 0x65, 0x79, 0x48, 0x00, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x08,
+	0x6d, 0x61, 0x67, 0x61, 0x7a, 0x69, 0x6e, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x67, 0x61, 0x7a,
+	0x69, 0x6e, 0x65, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x67, 0x61, 0x7a, 0x69, 0x6e, 0x65, 0x12,
+	0x22, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74,
+	0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x70, 0x48, 0x00, 0x52, 0x03,
+	0x6d, 0x61, 0x70, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32,
+	0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x05, 0x6d, 0x65, 0x6c, 0x65, 0x65, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
+	0x65, 0x6c, 0x65, 0x65, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x65, 0x6c, 0x65, 0x65, 0x12, 0x3d, 0x0a,
+	0x0c, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e,
+	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x13,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x72,
+	0x72, 0x65, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b,
+	0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61, 0x72, 0x72, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x12,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x72, 0x72,
+	0x65, 0x6c, 0x12, 0x4d, 0x0a, 0x16, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x78, 0x69, 0x6c, 0x69, 0x61, 0x72, 0x79, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x41,
+	0x75, 0x78, 0x69, 0x6c, 0x69, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x15, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x78, 0x69, 0x6c, 0x69, 0x61, 0x72,
+	0x79, 0x12, 0x41, 0x0a, 0x12, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x69, 0x70, 0x6f, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x69, 0x70, 0x6f, 0x64, 0x48,
+	0x00, 0x52, 0x11, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x69, 0x70, 0x6f, 0x64, 0x12, 0x44, 0x0a, 0x13, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x48, 0x00, 0x52, 0x12, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x44, 0x0a, 0x13, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76,
+	0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x12, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x4a, 0x0a, 0x15, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x66, 0x6f, 0x72, 0x65, 0x67, 0x72, 0x69, 0x70, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x6f, 0x72, 0x65,
+	0x67, 0x72, 0x69, 0x70, 0x48, 0x00, 0x52, 0x14, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x67, 0x72, 0x69, 0x70, 0x12, 0x4a, 0x0a, 0x15,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x61, 0x73,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61,
+	0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x61, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x00, 0x52, 0x14, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x47, 0x61, 0x73, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x47, 0x0a, 0x14, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73,
+	0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x47, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x13, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x73, 0x12, 0x4d, 0x0a, 0x16, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x67, 0x75, 0x61, 0x72, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x61,
+	0x6e, 0x64, 0x67, 0x75, 0x61, 0x72, 0x64, 0x48, 0x00, 0x52, 0x15, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x12, 0x4a, 0x0a, 0x15, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x61, 0x75, 0x6e,
+	0x63, 0x68, 0x65, 0x72, 0x48, 0x00, 0x52, 0x14, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x12,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x11, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x44, 0x0a, 0x13, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74,
+	0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x48,
+	0x00, 0x52, 0x12, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x12, 0x50, 0x0a, 0x17, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x69, 0x73, 0x74, 0x6f, 0x6c, 0x67, 0x72, 0x69, 0x70,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x50, 0x69, 0x73, 0x74, 0x6f, 0x6c, 0x47, 0x72, 0x69, 0x70, 0x48, 0x00, 0x52,
+	0x16, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x69, 0x73,
+	0x74, 0x6f, 0x6c, 0x67, 0x72, 0x69, 0x70, 0x12, 0x4a, 0x0a, 0x15, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x48, 0x00, 0x52, 0x14, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x12, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x68, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x69, 0x67, 0x68,
+	0x74, 0x48, 0x00, 0x52, 0x11, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x69, 0x67, 0x68, 0x74, 0x12, 0x57, 0x0a, 0x1a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x61, 0x6c, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72,
+	0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x69, 0x67, 0x68, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x69, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x18, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67, 0x68, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x12,
+	0x41, 0x0a, 0x12, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x61,
+	0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x48, 0x00, 0x52,
+	0x11, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x12, 0x28, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x23, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x0b,
+	0x74, 0x61, 0x63, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x72, 0x69, 0x67, 0x18, 0x24, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61,
+	0x63, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x61, 0x63,
+	0x74, 0x69, 0x63, 0x61, 0x6c, 0x72, 0x69, 0x67, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x22, 0x3e, 0x0a, 0x04, 0x52, 0x47, 0x42, 0x41, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x01, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x01, 0x67, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x01, 0x62, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x01, 0x61,
+	0x22, 0x60, 0x0a, 0x09, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x25, 0x0a,
+	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74,
+	0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x47, 0x42, 0x41, 0x52, 0x05, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x22, 0x46, 0x0a, 0x08, 0x41, 0x6d, 0x6d, 0x6f, 0x46, 0x72, 0x61, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x75, 0x0a, 0x0b, 0x41, 0x6d,
+	0x6d, 0x6f, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x5f, 0x62, 0x6c, 0x65, 0x65, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x42,
+	0x6c, 0x65, 0x65, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a,
+	0x15, 0x68, 0x65, 0x61, 0x76, 0x79, 0x5f, 0x62, 0x6c, 0x65, 0x65, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x68, 0x65,
+	0x61, 0x76, 0x79, 0x42, 0x6c, 0x65, 0x65, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x63,
+	0x65, 0x22, 0xbd, 0x01, 0x0a, 0x0e, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x61, 0x6c, 0x66,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6d, 0x61, 0x6c, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0e, 0x64, 0x75, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x75, 0x72, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x74, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x22, 0x8a, 0x01, 0x0a, 0x15, 0x41, 0x6d, 0x6d, 0x6f, 0x47, 0x72, 0x65, 0x6e, 0x61, 0x64,
+	0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x61, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x72, 0x61, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x22, 0xf3,
+	0x08, 0x0a, 0x0a, 0x41, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32,
+	0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x65, 0x72, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x75, 0x62, 0x73, 0x6f, 0x6e, 0x69, 0x63, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73,
+	0x75, 0x62, 0x73, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x73, 0x69, 0x6e,
+	0x67, 0x5f, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x61,
+	0x73, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x62,
+	0x75, 0x6c, 0x6c, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x75, 0x6c,
+	0x6c, 0x65, 0x74, 0x5f, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0e, 0x62, 0x75, 0x6c, 0x6c, 0x65, 0x74, 0x44, 0x69, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x25,
+	0x0a, 0x0e, 0x62, 0x61, 0x6c, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x65, 0x66,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x62, 0x61, 0x6c, 0x6c, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x43, 0x6f, 0x65, 0x66, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x61, 0x72, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x61,
+	0x72, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x70, 0x65, 0x6e, 0x65, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x65, 0x6e, 0x65, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x44, 0x61,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61,
+	0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x6d, 0x6d, 0x6f, 0x46, 0x72, 0x61, 0x67,
+	0x52, 0x0d, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x30, 0x0a, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x6d, 0x6d,
+	0x6f, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x52, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x1c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x1d,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x6d, 0x69, 0x73, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x69, 0x73, 0x66, 0x69, 0x72, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f,
+	0x74, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x1f,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x6f, 0x46,
+	0x65, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x77, 0x65, 0x61,
+	0x70, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x20, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x57,
+	0x65, 0x61, 0x70, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0e, 0x77,
+	0x65, 0x61, 0x70, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x45, 0x0a,
+	0x0d, 0x67, 0x72, 0x65, 0x6e, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x21,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32,
+	0x2e, 0x41, 0x6d, 0x6d, 0x6f, 0x47, 0x72, 0x65, 0x6e, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x67, 0x72, 0x65, 0x6e, 0x61, 0x64, 0x65, 0x50,
+	0x72, 0x6f, 0x70, 0x73, 0x22, 0x4d, 0x0a, 0x0d, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x4d, 0x61, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x22, 0xb9, 0x01, 0x0a, 0x0a, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x50, 0x72, 0x6f,
+	0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x72, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x75,
+	0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x72,
+	0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x4d, 0x61, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x29,
+	0x0a, 0x10, 0x62, 0x6c, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70,
+	0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x62, 0x6c, 0x75, 0x6e, 0x74, 0x54,
+	0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x7a, 0x6f, 0x6e,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x22,
+	0xbd, 0x01, 0x0a, 0x0e, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x72, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x75,
+	0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x72,
+	0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x4d, 0x61, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x29,
+	0x0a, 0x10, 0x62, 0x6c, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70,
+	0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x62, 0x6c, 0x75, 0x6e, 0x74, 0x54,
+	0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x7a, 0x6f, 0x6e,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x22,
+	0x99, 0x01, 0x0a, 0x09, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6d, 0x6f, 0x75, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6d, 0x6f,
+	0x75, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x72, 0x67,
+	0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5f, 0x66, 0x5f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x46, 0x50, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x66, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x65, 0x61, 0x66, 0x6e, 0x65, 0x73, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x04,
+	0x53, 0x6c, 0x6f, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32,
+	0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x1a, 0x55, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe1, 0x07, 0x0a,
+	0x05, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72,
+	0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x2b, 0x0a, 0x05, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72, 0x6d, 0x6f, 0x72,
+	0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x05, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72, 0x6d,
+	0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x69, 0x63, 0x6f, 0x63,
+	0x68, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x72, 0x69, 0x63, 0x6f, 0x63, 0x68, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x32, 0x0a, 0x09, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e,
+	0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x52, 0x09, 0x70, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x12, 0x31, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72, 0x6d, 0x6f,
+	0x72, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x6c,
+	0x6f, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x72,
+	0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x3d,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72,
+	0x6d, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x1a, 0x49, 0x0a,
+	0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74,
+	0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xef, 0x01, 0x0a, 0x04, 0x47, 0x72, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x55, 0x0a, 0x0b, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xa6, 0x03, 0x0a, 0x08, 0x42, 0x61, 0x63, 0x6b, 0x70, 0x61, 0x63, 0x6b, 0x12,
+	0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12,
+	0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x12, 0x25, 0x0a, 0x05, 0x67, 0x72, 0x69, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64,
+	0x52, 0x05, 0x67, 0x72, 0x69, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x70, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72,
+	0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73,
+	0x52, 0x09, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x22, 0xad, 0x02, 0x0a, 0x06,
+	0x42, 0x61, 0x72, 0x74, 0x65, 0x72, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72,
+	0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x94, 0x04, 0x0a, 0x08,
+	0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64,
+	0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x0a,
+	0x09, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x52, 0x09, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6c, 0x6f,
+	0x74, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x1a, 0x49, 0x0a, 0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xb1, 0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61,
+	0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72,
+	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04,
+	0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0xf3, 0x02, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78,
+	0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a,
+	0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61,
+	0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x70,
+	0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x67, 0x72, 0x69, 0x64, 0x73, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32,
+	0x2e, 0x47, 0x72, 0x69, 0x64, 0x52, 0x05, 0x67, 0x72, 0x69, 0x64, 0x73, 0x22, 0xd5, 0x09, 0x0a,
+	0x07, 0x46, 0x69, 0x72, 0x65, 0x61, 0x72, 0x6d, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64,
+	0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x6c, 0x69, 0x62,
+	0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x65,
+	0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x66, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x75, 0x72, 0x73, 0x74,
+	0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62,
+	0x75, 0x72, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x6c, 0x6f,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x6f,
+	0x63, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x65,
+	0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5f, 0x66, 0x5f, 0x70, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x46,
+	0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63,
+	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x6f,
+	0x6c, 0x64, 0x52, 0x65, 0x63, 0x74, 0x72, 0x61, 0x63, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61,
+	0x6c, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x56,
+	0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x69,
+	0x6c, 0x5f, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f,
+	0x6e, 0x74, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x12, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x61, 0x6c, 0x66, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x11, 0x6d, 0x61, 0x6c, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x64, 0x75, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12,
+	0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x74, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x1e,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x2d, 0x0a, 0x13, 0x68, 0x65, 0x61, 0x74, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x62, 0x79, 0x5f, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x68,
+	0x65, 0x61, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x79, 0x53, 0x68, 0x6f, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x6c, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x20,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6f, 0x6c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x28, 0x0a, 0x10, 0x63, 0x6f, 0x6f, 0x6c, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x6d, 0x6f, 0x64, 0x73, 0x18, 0x21, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x6f, 0x6c,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4d, 0x6f, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x18, 0x22,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4f, 0x66, 0x49, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x23, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e,
+	0x46, 0x69, 0x72, 0x65, 0x61, 0x72, 0x6d, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x1a, 0x49, 0x0a, 0x0a, 0x53, 0x6c, 0x6f,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x4f, 0x0a, 0x0f, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x50, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x4d, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x5f, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x4d, 0x61, 0x78, 0x22, 0xca, 0x02, 0x0a, 0x06, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x66,
+	0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x66, 0x61,
+	0x64, 0x65, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x61, 0x64, 0x65, 0x5f, 0x6f, 0x75, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x66, 0x61, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x61,
+	0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x50, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x52, 0x09, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69,
+	0x65, 0x73, 0x22, 0xc7, 0x0b, 0x0a, 0x07, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x12, 0x29,
+	0x0a, 0x06, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x52, 0x06, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x12, 0x32, 0x0a, 0x0b, 0x65, 0x6e, 0x65,
+	0x72, 0x67, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x52, 0x0a, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a,
+	0x09, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x52, 0x09, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38,
+	0x0a, 0x0e, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x0d, 0x68, 0x79, 0x64, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x6d,
+	0x69, 0x6e, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b,
+	0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x07, 0x73, 0x74,
+	0x61, 0x6d, 0x69, 0x6e, 0x61, 0x12, 0x34, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x6d, 0x69, 0x6e, 0x61,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61,
+	0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x0b,
+	0x73, 0x74, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x52, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61,
+	0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x32, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61,
+	0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x0a,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x62, 0x6c,
+	0x6f, 0x6f, 0x64, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x6f, 0x64, 0x6c, 0x6f, 0x73, 0x73, 0x12, 0x38, 0x0a, 0x0e, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x5f, 0x62, 0x6c, 0x65, 0x65, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e,
+	0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x0d, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x6c, 0x65,
+	0x65, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x0e, 0x68, 0x65, 0x61, 0x76, 0x79, 0x5f, 0x62,
+	0x6c, 0x65, 0x65, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x52, 0x0d, 0x68, 0x65, 0x61, 0x76, 0x79, 0x42, 0x6c, 0x65, 0x65, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x2d, 0x0a, 0x08, 0x66, 0x72, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x52, 0x08, 0x66, 0x72, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2f,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x25, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x52, 0x04, 0x70, 0x61, 0x69, 0x6e, 0x12, 0x36, 0x0a, 0x0d, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x52, 0x0c, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x56, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29,
+	0x0a, 0x06, 0x74, 0x72, 0x65, 0x6d, 0x6f, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x52, 0x06, 0x74, 0x72, 0x65, 0x6d, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x0a, 0x74, 0x6f, 0x78,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x52, 0x0a, 0x74, 0x6f, 0x78, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08,
+	0x61, 0x6e, 0x74, 0x69, 0x64, 0x6f, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x52, 0x08, 0x61, 0x6e, 0x74, 0x69, 0x64, 0x6f, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x72,
+	0x61, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x52, 0x0b, 0x72, 0x61, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72,
+	0x65, 0x12, 0x3c, 0x0a, 0x10, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61,
+	0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x0f,
+	0x62, 0x6f, 0x64, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x2d, 0x0a, 0x08, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x52, 0x08, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x29,
+	0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x12, 0x34, 0x0a, 0x0c, 0x72, 0x65, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12,
+	0x30, 0x0a, 0x0a, 0x6c, 0x6f, 0x6f, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e,
+	0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x09, 0x6c, 0x6f, 0x6f, 0x74, 0x53, 0x70, 0x65, 0x65,
+	0x64, 0x12, 0x34, 0x0a, 0x0c, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x70, 0x65, 0x65,
+	0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76,
+	0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x0b, 0x75, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x0e, 0x64, 0x65, 0x73, 0x74, 0x72,
+	0x6f, 0x79, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65, 0x64, 0x50, 0x61, 0x72,
+	0x74, 0x12, 0x34, 0x0a, 0x0c, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76,
+	0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x0b, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3a, 0x0a, 0x0f, 0x64, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x52, 0x0e, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x1d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x22, 0xa6, 0x03, 0x0a,
+	0x04, 0x46, 0x6f, 0x6f, 0x64, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61,
+	0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28,
+	0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74,
+	0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72, 0x6f,
+	0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
+	0x75, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x52, 0x07, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x73, 0x22, 0xc6, 0x04, 0x0a, 0x07, 0x47, 0x72, 0x65, 0x6e, 0x61, 0x64,
+	0x65, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61,
+	0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72,
+	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04,
+	0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x6c, 0x61, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x61, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x72, 0x61, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x61, 0x67, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x72, 0x61, 0x67, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x75, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x13, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x65, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x49,
+	0x0a, 0x08, 0x48, 0x69, 0x67, 0x68, 0x50, 0x61, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75,
+	0x74, 0x6f, 0x66, 0x66, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x63, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x46, 0x72, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x0a, 0x43, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b,
+	0x12, 0x12, 0x0a, 0x04, 0x67, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x67, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x72, 0x65, 0x73, 0x68, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x74, 0x72, 0x65, 0x73, 0x68, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x22, 0xe8, 0x03, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x64, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74,
+	0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74,
+	0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x67,
+	0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b,
+	0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52,
+	0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6d, 0x62, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x76, 0x6f, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x61, 0x6d,
+	0x62, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f,
+	0x76, 0x6f, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x64, 0x72, 0x79, 0x56, 0x6f,
+	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x25, 0x0a, 0x03, 0x68, 0x70, 0x66, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x69, 0x67, 0x68, 0x50,
+	0x61, 0x73, 0x73, 0x52, 0x03, 0x68, 0x70, 0x66, 0x12, 0x35, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
+	0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x22,
+	0xde, 0x02, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50,
+	0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x22, 0x52, 0x0a, 0x10, 0x4d, 0x61, 0x67, 0x61, 0x7a, 0x69, 0x6e, 0x65, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x22, 0x3c, 0x0a, 0x0c, 0x47, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x22, 0x99, 0x07, 0x0a, 0x08, 0x4d, 0x61, 0x67, 0x61, 0x7a, 0x69, 0x6e, 0x65, 0x12,
+	0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12,
+	0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x72,
+	0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5f, 0x66, 0x5f, 0x70, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x46, 0x50,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
+	0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x61, 0x6c, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6d, 0x61,
+	0x6c, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x37, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61,
+	0x67, 0x61, 0x7a, 0x69, 0x6e, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x08,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x0d, 0x67, 0x72, 0x69, 0x64,
+	0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64,
+	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0c, 0x67, 0x72, 0x69, 0x64, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x67, 0x61, 0x7a, 0x69,
+	0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76,
+	0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x67, 0x61, 0x7a, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x1a, 0x5c, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xaa,
+	0x02, 0x0a, 0x03, 0x4d, 0x61, 0x70, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72,
+	0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0xce, 0x03, 0x0a, 0x07,
+	0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50,
+	0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x75, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c,
+	0x0a, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x73, 0x52, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x22, 0x71, 0x0a, 0x0b,
+	0x4d, 0x65, 0x6c, 0x65, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x64, 0x61, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x86, 0x03, 0x0a, 0x05, 0x4d, 0x65, 0x6c, 0x65, 0x65, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69,
+	0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x2c,
+	0x0a, 0x05, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x6c, 0x65, 0x65, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x12, 0x2a, 0x0a, 0x04,
+	0x73, 0x74, 0x61, 0x62, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x72,
+	0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x6c, 0x65, 0x65, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x6b, 0x52, 0x04, 0x73, 0x74, 0x61, 0x62, 0x22, 0xe5, 0x07, 0x0a, 0x0c, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69,
+	0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x24,
+	0x0a, 0x0e, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5f, 0x66, 0x5f, 0x70,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69,
+	0x63, 0x73, 0x46, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69,
+	0x63, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f,
+	0x6d, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x69, 0x64,
+	0x5f, 0x6d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x72, 0x61, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3c, 0x0a,
+	0x0d, 0x67, 0x72, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32,
+	0x2e, 0x47, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0c, 0x67,
+	0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x05, 0x73,
+	0x6c, 0x6f, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x61, 0x72,
+	0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
+	0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x74,
+	0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x44, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x61, 0x72,
+	0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x1a, 0x49, 0x0a,
+	0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74,
+	0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xb6, 0x09, 0x0a, 0x06, 0x42, 0x61, 0x72, 0x72, 0x65, 0x6c, 0x12, 0x0f, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47,
+	0x72, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b,
+	0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x24, 0x0a, 0x0e, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5f, 0x66,
+	0x5f, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f,
+	0x6d, 0x69, 0x63, 0x73, 0x46, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f,
+	0x6d, 0x69, 0x63, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x72, 0x67, 0x6f,
+	0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61,
+	0x63, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61,
+	0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61,
+	0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x72, 0x61, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x3c, 0x0a, 0x0d, 0x67, 0x72, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
+	0x0c, 0x67, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x32, 0x0a,
+	0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74,
+	0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61, 0x72, 0x72, 0x65, 0x6c, 0x2e,
+	0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74,
+	0x73, 0x12, 0x4a, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61, 0x72, 0x72, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x3e, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61, 0x72,
+	0x72, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74,
+	0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x69,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x4f, 0x66, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x64,
+	0x75, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x64, 0x75, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x42, 0x75, 0x72, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x74, 0x5f, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x74, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x6c, 0x5f, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6f, 0x6c,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x49, 0x0a, 0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76,
+	0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc4, 0x08, 0x0a, 0x09, 0x41, 0x75,
+	0x78, 0x69, 0x6c, 0x69, 0x61, 0x72, 0x79, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50,
+	0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0e,
+	0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5f, 0x66, 0x5f, 0x70, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
+	0x46, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69,
+	0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x69, 0x64, 0x5f, 0x6d,
+	0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72,
+	0x61, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x67,
+	0x72, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47,
+	0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0c, 0x67, 0x72, 0x69,
+	0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x6c, 0x6f,
+	0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x75, 0x78, 0x69, 0x6c, 0x69, 0x61, 0x72, 0x79, 0x2e, 0x53,
+	0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73,
+	0x12, 0x4d, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76,
+	0x2e, 0x76, 0x32, 0x2e, 0x41, 0x75, 0x78, 0x69, 0x6c, 0x69, 0x61, 0x72, 0x79, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x41, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x41,
+	0x75, 0x78, 0x69, 0x6c, 0x69, 0x61, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x5f, 0x62, 0x75, 0x72, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x64, 0x75, 0x72,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x68,
+	0x65, 0x61, 0x74, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x68, 0x65, 0x61, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6f, 0x6c, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6f, 0x6c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x49, 0x0a,
+	0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74,
+	0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xc9, 0x07, 0x0a, 0x05, 0x42, 0x69, 0x70, 0x6f, 0x64, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72,
+	0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x24, 0x0a, 0x0e, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5f, 0x66, 0x5f,
+	0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d,
+	0x69, 0x63, 0x73, 0x46, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d,
+	0x69, 0x63, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e,
+	0x6f, 0x6d, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63,
+	0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x69,
+	0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x72, 0x61, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3c,
+	0x0a, 0x0d, 0x67, 0x72, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76,
+	0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0c,
+	0x67, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x05,
+	0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61,
+	0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x69, 0x70, 0x6f, 0x64, 0x2e, 0x53, 0x6c,
+	0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12,
+	0x49, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x42, 0x69, 0x70, 0x6f, 0x64, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x69, 0x70, 0x6f, 0x64, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x1a, 0x49, 0x0a, 0x0a, 0x53, 0x6c, 0x6f,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xcd, 0x07, 0x0a,
+	0x06, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50,
+	0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0e,
+	0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5f, 0x66, 0x5f, 0x70, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
+	0x46, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69,
+	0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x69, 0x64, 0x5f, 0x6d,
+	0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72,
+	0x61, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x67,
+	0x72, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47,
+	0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0c, 0x67, 0x72, 0x69,
+	0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x6c, 0x6f,
+	0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x2e, 0x53, 0x6c, 0x6f, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x4a, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x13,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32,
+	0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74,
+	0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x1a, 0x49, 0x0a, 0x0a, 0x53, 0x6c, 0x6f,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf7, 0x07, 0x0a,
+	0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50,
+	0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0e,
+	0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5f, 0x66, 0x5f, 0x70, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
+	0x46, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69,
+	0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x69, 0x64, 0x5f, 0x6d,
+	0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72,
+	0x61, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x67,
+	0x72, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47,
+	0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0c, 0x67, 0x72, 0x69,
+	0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x6c, 0x6f,
+	0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x6c, 0x6f, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x4a, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x13,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32,
+	0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74,
+	0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f,
+	0x64, 0x65, 0x73, 0x1a, 0x49, 0x0a, 0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53,
+	0x6c, 0x6f, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c,
+	0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd5, 0x07, 0x0a, 0x08, 0x46, 0x6f, 0x72, 0x65, 0x67,
+	0x72, 0x69, 0x70, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53,
+	0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x04,
+	0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72,
+	0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73,
+	0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x72, 0x67, 0x6f,
+	0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5f, 0x66, 0x5f, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x46, 0x50, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x63, 0x6f, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f,
+	0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x64, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x61, 0x69, 0x64, 0x4d,
+	0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x67, 0x72, 0x69, 0x64, 0x5f,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0c, 0x67, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x12,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32,
+	0x2e, 0x46, 0x6f, 0x72, 0x65, 0x67, 0x72, 0x69, 0x70, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x46,
+	0x6f, 0x72, 0x65, 0x67, 0x72, 0x69, 0x70, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74,
+	0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x67, 0x72, 0x69,
+	0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x1a, 0x49, 0x0a, 0x0a, 0x53,
+	0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x72,
+	0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc0,
+	0x08, 0x0a, 0x08, 0x47, 0x61, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0f, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47,
+	0x72, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b,
+	0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x24, 0x0a, 0x0e, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5f, 0x66,
+	0x5f, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f,
+	0x6d, 0x69, 0x63, 0x73, 0x46, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f,
+	0x6d, 0x69, 0x63, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x72, 0x67, 0x6f,
+	0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61,
+	0x63, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61,
+	0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61,
+	0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x72, 0x61, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x3c, 0x0a, 0x0d, 0x67, 0x72, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
+	0x0c, 0x67, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x34, 0x0a,
+	0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74,
+	0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x61, 0x73, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x6c,
+	0x6f, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x61, 0x72,
+	0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x61, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x40, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x14,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32,
+	0x2e, 0x47, 0x61, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x64, 0x75,
+	0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x68, 0x65, 0x61, 0x74, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6f, 0x6c, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6f, 0x6c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x49,
+	0x0a, 0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xb8, 0x08, 0x0a, 0x07, 0x47, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x12, 0x0f, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32,
+	0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
+	0x5f, 0x66, 0x5f, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f,
+	0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x46, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f,
+	0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x72,
+	0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75,
+	0x72, 0x61, 0x63, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75,
+	0x72, 0x61, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x61, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x67, 0x72, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x52, 0x0c, 0x67, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x33, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73,
+	0x6c, 0x6f, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x61,
+	0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x3f, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x14,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32,
+	0x2e, 0x47, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x16, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x05,
+	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61,
+	0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x47, 0x42, 0x41, 0x52, 0x05, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x69, 0x73, 0x65, 0x18, 0x18, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x69, 0x73, 0x65, 0x1a, 0x49, 0x0a, 0x0a, 0x53, 0x6c, 0x6f,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9b, 0x08, 0x0a,
+	0x09, 0x48, 0x61, 0x6e, 0x64, 0x67, 0x75, 0x61, 0x72, 0x64, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72,
+	0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x24, 0x0a, 0x0e, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5f, 0x66, 0x5f,
+	0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d,
+	0x69, 0x63, 0x73, 0x46, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d,
+	0x69, 0x63, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e,
+	0x6f, 0x6d, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63,
+	0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x69,
+	0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x72, 0x61, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3c,
+	0x0a, 0x0d, 0x67, 0x72, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76,
+	0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0c,
+	0x67, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x05,
+	0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x61,
+	0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x6c,
+	0x6f, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x61, 0x72,
+	0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x41, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18,
+	0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76,
+	0x32, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x74, 0x5f, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x74,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x6c, 0x5f, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6f,
+	0x6c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x49, 0x0a, 0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xef, 0x07, 0x0a, 0x08, 0x4c,
+	0x61, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50,
+	0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0e,
+	0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5f, 0x66, 0x5f, 0x70, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
+	0x46, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69,
+	0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x69, 0x64, 0x5f, 0x6d,
+	0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72,
+	0x61, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x67,
+	0x72, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47,
+	0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0c, 0x67, 0x72, 0x69,
+	0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x6c, 0x6f,
+	0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x6c,
+	0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12,
+	0x4c, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x40, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x61, 0x75,
+	0x6e, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x65, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x65, 0x72, 0x1a, 0x49, 0x0a, 0x0a, 0x53, 0x6c, 0x6f,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8b, 0x08, 0x0a,
+	0x05, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72,
+	0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x65,
+	0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5f, 0x66, 0x5f, 0x70, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x46,
+	0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72,
+	0x65, 0x63, 0x6f, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x6f,
+	0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x61,
+	0x69, 0x64, 0x4d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x67, 0x72,
+	0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72,
+	0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0c, 0x67, 0x72, 0x69, 0x64,
+	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74,
+	0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76,
+	0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
+	0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x61, 0x72, 0x6b,
+	0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x74, 0x5f, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x74,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x6c, 0x5f, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6f,
+	0x6c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x49, 0x0a, 0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x84, 0x09, 0x0a, 0x06, 0x4d,
+	0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61,
+	0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28,
+	0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74,
+	0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72, 0x6f,
+	0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x72,
+	0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5f, 0x66, 0x5f, 0x70, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x46, 0x50,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65,
+	0x63, 0x6f, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64,
+	0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x61, 0x69,
+	0x64, 0x4d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x67, 0x72, 0x69,
+	0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69,
+	0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0c, 0x67, 0x72, 0x69, 0x64, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73,
+	0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x4d, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x4a, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
+	0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x61, 0x72,
+	0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76,
+	0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76,
+	0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x75, 0x64, 0x6e,
+	0x65, 0x73, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x75, 0x64, 0x6e,
+	0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x64, 0x75,
+	0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x68, 0x65, 0x61, 0x74, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6f, 0x6c, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6f, 0x6c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x49,
+	0x0a, 0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xdd, 0x07, 0x0a, 0x0a, 0x50, 0x69, 0x73, 0x74, 0x6f, 0x6c, 0x47, 0x72, 0x69, 0x70,
+	0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63,
+	0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63,
+	0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76,
+	0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67,
+	0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d,
+	0x69, 0x63, 0x73, 0x5f, 0x66, 0x5f, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65,
+	0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x46, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61,
+	0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69,
+	0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x61, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x64,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x67, 0x72, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61,
+	0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x52, 0x0c, 0x67, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x12, 0x36, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x69,
+	0x73, 0x74, 0x6f, 0x6c, 0x47, 0x72, 0x69, 0x70, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x4e, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x69,
+	0x73, 0x74, 0x6f, 0x6c, 0x47, 0x72, 0x69, 0x70, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x73, 0x74, 0x6f, 0x6c,
+	0x47, 0x72, 0x69, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x1a, 0x49,
+	0x0a, 0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xdc, 0x08, 0x0a, 0x08, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x0f,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76,
+	0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69,
+	0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63,
+	0x73, 0x5f, 0x66, 0x5f, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x72, 0x67,
+	0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x46, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x67,
+	0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65,
+	0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63,
+	0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63,
+	0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x61, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x64, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x67, 0x72, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6b,
+	0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x52, 0x0c, 0x67, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x12, 0x34, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76,
+	0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69,
+	0x74, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69,
+	0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x5f, 0x62, 0x75, 0x72, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x64, 0x75, 0x72,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x68,
+	0x65, 0x61, 0x74, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x17, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x68, 0x65, 0x61, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6f, 0x6c, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6f, 0x6c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x49, 0x0a,
+	0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74,
+	0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xcf, 0x08, 0x0a, 0x05, 0x53, 0x69, 0x67, 0x68, 0x74, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72,
+	0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x24, 0x0a, 0x0e, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5f, 0x66, 0x5f,
+	0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d,
+	0x69, 0x63, 0x73, 0x46, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d,
+	0x69, 0x63, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e,
+	0x6f, 0x6d, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63,
+	0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x69,
+	0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x72, 0x61, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3c,
+	0x0a, 0x0d, 0x67, 0x72, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76,
+	0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0c,
+	0x67, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x05,
+	0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61,
+	0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x53, 0x6c,
+	0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12,
+	0x49, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x53, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x69, 0x67, 0x68, 0x74, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a,
+	0x0d, 0x6d, 0x61, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x16,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x61, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x7a, 0x6f, 0x6f, 0x6d, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x5a, 0x6f, 0x6f, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x7a, 0x65, 0x72, 0x6f,
+	0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x0d, 0x7a, 0x65, 0x72, 0x6f, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x1a,
+	0x49, 0x0a, 0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xbe, 0x09, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x68, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x69, 0x61, 0x6c, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53,
+	0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x04,
+	0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72,
+	0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73,
+	0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x72, 0x67, 0x6f,
+	0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5f, 0x66, 0x5f, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x46, 0x50, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x63, 0x6f, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f,
+	0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x64, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x61, 0x69, 0x64, 0x4d,
+	0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x67, 0x72, 0x69, 0x64, 0x5f,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0c, 0x67, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x12,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32,
+	0x2e, 0x53, 0x69, 0x67, 0x68, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x2e, 0x53, 0x6c,
+	0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12,
+	0x50, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x53, 0x69, 0x67, 0x68, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x44, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x14,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32,
+	0x2e, 0x53, 0x69, 0x67, 0x68, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6d,
+	0x61, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x6d, 0x61, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x7a, 0x6f,
+	0x6f, 0x6d, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x5a, 0x6f, 0x6f, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d,
+	0x7a, 0x65, 0x72, 0x6f, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x52,
+	0x47, 0x42, 0x41, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x69, 0x73, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x69, 0x73, 0x65,
+	0x1a, 0x49, 0x0a, 0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xb8, 0x08, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x0f, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32,
+	0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x72, 0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
+	0x5f, 0x66, 0x5f, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x72, 0x67, 0x6f,
+	0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x46, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x67, 0x6f,
+	0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x72,
+	0x67, 0x6f, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75,
+	0x72, 0x61, 0x63, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75,
+	0x72, 0x61, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x61, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x64, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x67, 0x72, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x52, 0x0c, 0x67, 0x72, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x31, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b,
+	0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x6c, 0x6f,
+	0x74, 0x73, 0x12, 0x49, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x72, 0x6b,
+	0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x3d, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x66, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x74, 0x72, 0x61, 0x63, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x63,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x61,
+	0x74, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x68, 0x65, 0x61, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x17, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x63, 0x6f, 0x6f, 0x6c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x49, 0x0a, 0x0a, 0x53,
+	0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x72,
+	0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xac,
+	0x02, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64,
+	0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0xca, 0x05,
+	0x0a, 0x0b, 0x54, 0x61, 0x63, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x69, 0x67, 0x12, 0x0f, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32,
+	0x2e, 0x47, 0x72, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x5f, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x25,
+	0x0a, 0x05, 0x67, 0x72, 0x69, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x52, 0x05,
+	0x67, 0x72, 0x69, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x52, 0x09,
+	0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x61, 0x72, 0x6d,
+	0x6f, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f,
+	0x76, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52,
+	0x05, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x12, 0x44, 0x0a, 0x10, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72, 0x6d,
+	0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x61, 0x72, 0x6d,
+	0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10,
+	0x69, 0x73, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x50, 0x6c, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18,
+	0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76,
+	0x32, 0x2e, 0x54, 0x61, 0x63, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x69, 0x67, 0x2e, 0x53, 0x6c,
+	0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x1a,
+	0x49, 0x0a, 0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2d,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2d, 0x61, 0x70,
+	0x69, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_item_proto_rawDescOnce sync.Once
+	file_item_proto_rawDescData = file_item_proto_rawDesc
+)
+
+func file_item_proto_rawDescGZIP() []byte {
+	file_item_proto_rawDescOnce.Do(func() {
+		file_item_proto_rawDescData = protoimpl.X.CompressGZIP(file_item_proto_rawDescData)
+	})
+	return file_item_proto_rawDescData
+}
+
+var file_item_proto_msgTypes = make([]protoimpl.MessageInfo, 121)
+var file_item_proto_goTypes = []interface{}{
+	(*Item)(nil),                  // 0: tarkov.v2.Item
+	(*RGBA)(nil),                  // 1: tarkov.v2.RGBA
+	(*GridProps)(nil),             // 2: tarkov.v2.GridProps
+	(*AmmoFrag)(nil),              // 3: tarkov.v2.AmmoFrag
+	(*AmmoEffects)(nil),           // 4: tarkov.v2.AmmoEffects
+	(*WeaponModifier)(nil),        // 5: tarkov.v2.WeaponModifier
+	(*AmmoGrenadeProperties)(nil), // 6: tarkov.v2.AmmoGrenadeProperties
+	(*Ammunition)(nil),            // 7: tarkov.v2.Ammunition
+	(*ArmorMaterial)(nil),         // 8: tarkov.v2.ArmorMaterial
+	(*ArmorProps)(nil),            // 9: tarkov.v2.ArmorProps
+	(*ArmorComponent)(nil),        // 10: tarkov.v2.ArmorComponent
+	(*Penalties)(nil),             // 11: tarkov.v2.Penalties
+	(*Slot)(nil),                  // 12: tarkov.v2.Slot
+	(*Armor)(nil),                 // 13: tarkov.v2.Armor
+	(*Grid)(nil),                  // 14: tarkov.v2.Grid
+	(*Backpack)(nil),              // 15: tarkov.v2.Backpack
+	(*Barter)(nil),                // 16: tarkov.v2.Barter
+	(*Clothing)(nil),              // 17: tarkov.v2.Clothing
+	(*CommonItem)(nil),            // 18: tarkov.v2.CommonItem
+	(*Container)(nil),             // 19: tarkov.v2.Container
+	(*Firearm)(nil),               // 20: tarkov.v2.Firearm
+	(*EffectPenalties)(nil),       // 21: tarkov.v2.EffectPenalties
+	(*Effect)(nil),                // 22: tarkov.v2.Effect
+	(*Effects)(nil),               // 23: tarkov.v2.Effects
+	(*Food)(nil),                  // 24: tarkov.v2.Food
+	(*Grenade)(nil),               // 25: tarkov.v2.Grenade
+	(*HighPass)(nil),              // 26: tarkov.v2.HighPass
+	(*Compressor)(nil),            // 27: tarkov.v2.Compressor
+	(*Headphone)(nil),             // 28: tarkov.v2.Headphone
+	(*Key)(nil),                   // 29: tarkov.v2.Key
+	(*MagazineModifier)(nil),      // 30: tarkov.v2.MagazineModifier
+	(*GridModifier)(nil),          // 31: tarkov.v2.GridModifier
+	(*Magazine)(nil),              // 32: tarkov.v2.Magazine
+	(*Map)(nil),                   // 33: tarkov.v2.Map
+	(*Medical)(nil),               // 34: tarkov.v2.Medical
+	(*MeleeAttack)(nil),           // 35: tarkov.v2.MeleeAttack
+	(*Melee)(nil),                 // 36: tarkov.v2.Melee
+	(*Modification)(nil),          // 37: tarkov.v2.Modification
+	(*Barrel)(nil),                // 38: tarkov.v2.Barrel
+	(*Auxiliary)(nil),             // 39: tarkov.v2.Auxiliary
+	(*Bipod)(nil),                 // 40: tarkov.v2.Bipod
+	(*Charge)(nil),                // 41: tarkov.v2.Charge
+	(*Device)(nil),                // 42: tarkov.v2.Device
+	(*Foregrip)(nil),              // 43: tarkov.v2.Foregrip
+	(*GasBlock)(nil),              // 44: tarkov.v2.GasBlock
+	(*Goggles)(nil),               // 45: tarkov.v2.Goggles
+	(*Handguard)(nil),             // 46: tarkov.v2.Handguard
+	(*Launcher)(nil),              // 47: tarkov.v2.Launcher
+	(*Mount)(nil),                 // 48: tarkov.v2.Mount
+	(*Muzzle)(nil),                // 49: tarkov.v2.Muzzle
+	(*PistolGrip)(nil),            // 50: tarkov.v2.PistolGrip
+	(*Receiver)(nil),              // 51: tarkov.v2.Receiver
+	(*Sight)(nil),                 // 52: tarkov.v2.Sight
+	(*SightSpecial)(nil),          // 53: tarkov.v2.SightSpecial
+	(*Stock)(nil),                 // 54: tarkov.v2.Stock
+	(*Money)(nil),                 // 55: tarkov.v2.Money
+	(*TacticalRig)(nil),           // 56: tarkov.v2.TacticalRig
+	nil,                           // 57: tarkov.v2.Slot.FilterEntry
+	nil,                           // 58: tarkov.v2.Armor.SlotsEntry
+	nil,                           // 59: tarkov.v2.Armor.CompatibilityEntry
+	nil,                           // 60: tarkov.v2.Armor.ConflictsEntry
+	nil,                           // 61: tarkov.v2.Grid.FilterEntry
+	nil,                           // 62: tarkov.v2.Clothing.SlotsEntry
+	nil,                           // 63: tarkov.v2.Firearm.SlotsEntry
+	nil,                           // 64: tarkov.v2.Magazine.CompatibilityEntry
+	nil,                           // 65: tarkov.v2.Magazine.ConflictsEntry
+	nil,                           // 66: tarkov.v2.Modification.SlotsEntry
+	nil,                           // 67: tarkov.v2.Modification.CompatibilityEntry
+	nil,                           // 68: tarkov.v2.Modification.ConflictsEntry
+	nil,                           // 69: tarkov.v2.Barrel.SlotsEntry
+	nil,                           // 70: tarkov.v2.Barrel.CompatibilityEntry
+	nil,                           // 71: tarkov.v2.Barrel.ConflictsEntry
+	nil,                           // 72: tarkov.v2.Auxiliary.SlotsEntry
+	nil,                           // 73: tarkov.v2.Auxiliary.CompatibilityEntry
+	nil,                           // 74: tarkov.v2.Auxiliary.ConflictsEntry
+	nil,                           // 75: tarkov.v2.Bipod.SlotsEntry
+	nil,                           // 76: tarkov.v2.Bipod.CompatibilityEntry
+	nil,                           // 77: tarkov.v2.Bipod.ConflictsEntry
+	nil,                           // 78: tarkov.v2.Charge.SlotsEntry
+	nil,                           // 79: tarkov.v2.Charge.CompatibilityEntry
+	nil,                           // 80: tarkov.v2.Charge.ConflictsEntry
+	nil,                           // 81: tarkov.v2.Device.SlotsEntry
+	nil,                           // 82: tarkov.v2.Device.CompatibilityEntry
+	nil,                           // 83: tarkov.v2.Device.ConflictsEntry
+	nil,                           // 84: tarkov.v2.Foregrip.SlotsEntry
+	nil,                           // 85: tarkov.v2.Foregrip.CompatibilityEntry
+	nil,                           // 86: tarkov.v2.Foregrip.ConflictsEntry
+	nil,                           // 87: tarkov.v2.GasBlock.SlotsEntry
+	nil,                           // 88: tarkov.v2.GasBlock.CompatibilityEntry
+	nil,                           // 89: tarkov.v2.GasBlock.ConflictsEntry
+	nil,                           // 90: tarkov.v2.Goggles.SlotsEntry
+	nil,                           // 91: tarkov.v2.Goggles.CompatibilityEntry
+	nil,                           // 92: tarkov.v2.Goggles.ConflictsEntry
+	nil,                           // 93: tarkov.v2.Handguard.SlotsEntry
+	nil,                           // 94: tarkov.v2.Handguard.CompatibilityEntry
+	nil,                           // 95: tarkov.v2.Handguard.ConflictsEntry
+	nil,                           // 96: tarkov.v2.Launcher.SlotsEntry
+	nil,                           // 97: tarkov.v2.Launcher.CompatibilityEntry
+	nil,                           // 98: tarkov.v2.Launcher.ConflictsEntry
+	nil,                           // 99: tarkov.v2.Mount.SlotsEntry
+	nil,                           // 100: tarkov.v2.Mount.CompatibilityEntry
+	nil,                           // 101: tarkov.v2.Mount.ConflictsEntry
+	nil,                           // 102: tarkov.v2.Muzzle.SlotsEntry
+	nil,                           // 103: tarkov.v2.Muzzle.CompatibilityEntry
+	nil,                           // 104: tarkov.v2.Muzzle.ConflictsEntry
+	nil,                           // 105: tarkov.v2.PistolGrip.SlotsEntry
+	nil,                           // 106: tarkov.v2.PistolGrip.CompatibilityEntry
+	nil,                           // 107: tarkov.v2.PistolGrip.ConflictsEntry
+	nil,                           // 108: tarkov.v2.Receiver.SlotsEntry
+	nil,                           // 109: tarkov.v2.Receiver.CompatibilityEntry
+	nil,                           // 110: tarkov.v2.Receiver.ConflictsEntry
+	nil,                           // 111: tarkov.v2.Sight.SlotsEntry
+	nil,                           // 112: tarkov.v2.Sight.CompatibilityEntry
+	nil,                           // 113: tarkov.v2.Sight.ConflictsEntry
+	nil,                           // 114: tarkov.v2.SightSpecial.SlotsEntry
+	nil,                           // 115: tarkov.v2.SightSpecial.CompatibilityEntry
+	nil,                           // 116: tarkov.v2.SightSpecial.ConflictsEntry
+	nil,                           // 117: tarkov.v2.Stock.SlotsEntry
+	nil,                           // 118: tarkov.v2.Stock.CompatibilityEntry
+	nil,                           // 119: tarkov.v2.Stock.ConflictsEntry
+	nil,                           // 120: tarkov.v2.TacticalRig.SlotsEntry
+	(*structpb.ListValue)(nil),    // 121: google.protobuf.ListValue
+}
+var file_item_proto_depIdxs = []int32{
+	7,   // 0: tarkov.v2.Item.ammunition:type_name -> tarkov.v2.Ammunition
+	13,  // 1: tarkov.v2.Item.armor:type_name -> tarkov.v2.Armor
+	15,  // 2: tarkov.v2.Item.backpack:type_name -> tarkov.v2.Backpack
+	16,  // 3: tarkov.v2.Item.barter:type_name -> tarkov.v2.Barter
+	17,  // 4: tarkov.v2.Item.clothing:type_name -> tarkov.v2.Clothing
+	18,  // 5: tarkov.v2.Item.common:type_name -> tarkov.v2.CommonItem
+	19,  // 6: tarkov.v2.Item.container:type_name -> tarkov.v2.Container
+	20,  // 7: tarkov.v2.Item.firearm:type_name -> tarkov.v2.Firearm
+	24,  // 8: tarkov.v2.Item.food:type_name -> tarkov.v2.Food
+	25,  // 9: tarkov.v2.Item.grenade:type_name -> tarkov.v2.Grenade
+	28,  // 10: tarkov.v2.Item.headphone:type_name -> tarkov.v2.Headphone
+	29,  // 11: tarkov.v2.Item.key:type_name -> tarkov.v2.Key
+	32,  // 12: tarkov.v2.Item.magazine:type_name -> tarkov.v2.Magazine
+	33,  // 13: tarkov.v2.Item.map:type_name -> tarkov.v2.Map
+	34,  // 14: tarkov.v2.Item.medical:type_name -> tarkov.v2.Medical
+	36,  // 15: tarkov.v2.Item.melee:type_name -> tarkov.v2.Melee
+	37,  // 16: tarkov.v2.Item.modification:type_name -> tarkov.v2.Modification
+	38,  // 17: tarkov.v2.Item.modification_barrel:type_name -> tarkov.v2.Barrel
+	39,  // 18: tarkov.v2.Item.modification_auxiliary:type_name -> tarkov.v2.Auxiliary
+	40,  // 19: tarkov.v2.Item.modification_bipod:type_name -> tarkov.v2.Bipod
+	41,  // 20: tarkov.v2.Item.modification_charge:type_name -> tarkov.v2.Charge
+	42,  // 21: tarkov.v2.Item.modification_device:type_name -> tarkov.v2.Device
+	43,  // 22: tarkov.v2.Item.modification_foregrip:type_name -> tarkov.v2.Foregrip
+	44,  // 23: tarkov.v2.Item.modification_gasblock:type_name -> tarkov.v2.GasBlock
+	45,  // 24: tarkov.v2.Item.modification_goggles:type_name -> tarkov.v2.Goggles
+	46,  // 25: tarkov.v2.Item.modification_handguard:type_name -> tarkov.v2.Handguard
+	47,  // 26: tarkov.v2.Item.modification_launcher:type_name -> tarkov.v2.Launcher
+	48,  // 27: tarkov.v2.Item.modification_mount:type_name -> tarkov.v2.Mount
+	49,  // 28: tarkov.v2.Item.modification_muzzle:type_name -> tarkov.v2.Muzzle
+	50,  // 29: tarkov.v2.Item.modification_pistolgrip:type_name -> tarkov.v2.PistolGrip
+	51,  // 30: tarkov.v2.Item.modification_receiver:type_name -> tarkov.v2.Receiver
+	52,  // 31: tarkov.v2.Item.modification_sight:type_name -> tarkov.v2.Sight
+	53,  // 32: tarkov.v2.Item.modification_sight_special:type_name -> tarkov.v2.SightSpecial
+	54,  // 33: tarkov.v2.Item.modification_stock:type_name -> tarkov.v2.Stock
+	55,  // 34: tarkov.v2.Item.money:type_name -> tarkov.v2.Money
+	56,  // 35: tarkov.v2.Item.tacticalrig:type_name -> tarkov.v2.TacticalRig
+	1,   // 36: tarkov.v2.GridProps.color:type_name -> tarkov.v2.RGBA
+	2,   // 37: tarkov.v2.Ammunition.grid:type_name -> tarkov.v2.GridProps
+	3,   // 38: tarkov.v2.Ammunition.fragmentation:type_name -> tarkov.v2.AmmoFrag
+	4,   // 39: tarkov.v2.Ammunition.effects:type_name -> tarkov.v2.AmmoEffects
+	5,   // 40: tarkov.v2.Ammunition.weapon_modifier:type_name -> tarkov.v2.WeaponModifier
+	6,   // 41: tarkov.v2.Ammunition.grenade_props:type_name -> tarkov.v2.AmmoGrenadeProperties
+	8,   // 42: tarkov.v2.ArmorProps.material:type_name -> tarkov.v2.ArmorMaterial
+	8,   // 43: tarkov.v2.ArmorComponent.material:type_name -> tarkov.v2.ArmorMaterial
+	57,  // 44: tarkov.v2.Slot.filter:type_name -> tarkov.v2.Slot.FilterEntry
+	2,   // 45: tarkov.v2.Armor.grid:type_name -> tarkov.v2.GridProps
+	9,   // 46: tarkov.v2.Armor.armor:type_name -> tarkov.v2.ArmorProps
+	10,  // 47: tarkov.v2.Armor.components:type_name -> tarkov.v2.ArmorComponent
+	11,  // 48: tarkov.v2.Armor.penalties:type_name -> tarkov.v2.Penalties
+	58,  // 49: tarkov.v2.Armor.slots:type_name -> tarkov.v2.Armor.SlotsEntry
+	59,  // 50: tarkov.v2.Armor.compatibility:type_name -> tarkov.v2.Armor.CompatibilityEntry
+	60,  // 51: tarkov.v2.Armor.conflicts:type_name -> tarkov.v2.Armor.ConflictsEntry
+	61,  // 52: tarkov.v2.Grid.filter:type_name -> tarkov.v2.Grid.FilterEntry
+	2,   // 53: tarkov.v2.Backpack.grid:type_name -> tarkov.v2.GridProps
+	14,  // 54: tarkov.v2.Backpack.grids:type_name -> tarkov.v2.Grid
+	11,  // 55: tarkov.v2.Backpack.penalties:type_name -> tarkov.v2.Penalties
+	2,   // 56: tarkov.v2.Barter.grid:type_name -> tarkov.v2.GridProps
+	2,   // 57: tarkov.v2.Clothing.grid:type_name -> tarkov.v2.GridProps
+	11,  // 58: tarkov.v2.Clothing.penalties:type_name -> tarkov.v2.Penalties
+	62,  // 59: tarkov.v2.Clothing.slots:type_name -> tarkov.v2.Clothing.SlotsEntry
+	2,   // 60: tarkov.v2.CommonItem.grid:type_name -> tarkov.v2.GridProps
+	2,   // 61: tarkov.v2.Container.grid:type_name -> tarkov.v2.GridProps
+	14,  // 62: tarkov.v2.Container.grids:type_name -> tarkov.v2.Grid
+	2,   // 63: tarkov.v2.Firearm.grid:type_name -> tarkov.v2.GridProps
+	63,  // 64: tarkov.v2.Firearm.slots:type_name -> tarkov.v2.Firearm.SlotsEntry
+	21,  // 65: tarkov.v2.Effect.penalties:type_name -> tarkov.v2.EffectPenalties
+	22,  // 66: tarkov.v2.Effects.energy:type_name -> tarkov.v2.Effect
+	22,  // 67: tarkov.v2.Effects.energy_rate:type_name -> tarkov.v2.Effect
+	22,  // 68: tarkov.v2.Effects.hydration:type_name -> tarkov.v2.Effect
+	22,  // 69: tarkov.v2.Effects.hydration_rate:type_name -> tarkov.v2.Effect
+	22,  // 70: tarkov.v2.Effects.stamina:type_name -> tarkov.v2.Effect
+	22,  // 71: tarkov.v2.Effects.stamina_rate:type_name -> tarkov.v2.Effect
+	22,  // 72: tarkov.v2.Effects.health:type_name -> tarkov.v2.Effect
+	22,  // 73: tarkov.v2.Effects.health_rate:type_name -> tarkov.v2.Effect
+	22,  // 74: tarkov.v2.Effects.bloodloss:type_name -> tarkov.v2.Effect
+	22,  // 75: tarkov.v2.Effects.light_bleeding:type_name -> tarkov.v2.Effect
+	22,  // 76: tarkov.v2.Effects.heavy_bleeding:type_name -> tarkov.v2.Effect
+	22,  // 77: tarkov.v2.Effects.fracture:type_name -> tarkov.v2.Effect
+	22,  // 78: tarkov.v2.Effects.contusion:type_name -> tarkov.v2.Effect
+	22,  // 79: tarkov.v2.Effects.pain:type_name -> tarkov.v2.Effect
+	22,  // 80: tarkov.v2.Effects.tunnel_vision:type_name -> tarkov.v2.Effect
+	22,  // 81: tarkov.v2.Effects.tremor:type_name -> tarkov.v2.Effect
+	22,  // 82: tarkov.v2.Effects.toxication:type_name -> tarkov.v2.Effect
+	22,  // 83: tarkov.v2.Effects.antidote:type_name -> tarkov.v2.Effect
+	22,  // 84: tarkov.v2.Effects.rad_exposure:type_name -> tarkov.v2.Effect
+	22,  // 85: tarkov.v2.Effects.body_temperature:type_name -> tarkov.v2.Effect
+	22,  // 86: tarkov.v2.Effects.mobility:type_name -> tarkov.v2.Effect
+	22,  // 87: tarkov.v2.Effects.recoil:type_name -> tarkov.v2.Effect
+	22,  // 88: tarkov.v2.Effects.reload_speed:type_name -> tarkov.v2.Effect
+	22,  // 89: tarkov.v2.Effects.loot_speed:type_name -> tarkov.v2.Effect
+	22,  // 90: tarkov.v2.Effects.unlock_speed:type_name -> tarkov.v2.Effect
+	22,  // 91: tarkov.v2.Effects.destroyed_part:type_name -> tarkov.v2.Effect
+	22,  // 92: tarkov.v2.Effects.weight_limit:type_name -> tarkov.v2.Effect
+	22,  // 93: tarkov.v2.Effects.damage_modifier:type_name -> tarkov.v2.Effect
+	22,  // 94: tarkov.v2.Effects.skill:type_name -> tarkov.v2.Effect
+	2,   // 95: tarkov.v2.Food.grid:type_name -> tarkov.v2.GridProps
+	23,  // 96: tarkov.v2.Food.effects:type_name -> tarkov.v2.Effects
+	2,   // 97: tarkov.v2.Grenade.grid:type_name -> tarkov.v2.GridProps
+	2,   // 98: tarkov.v2.Headphone.grid:type_name -> tarkov.v2.GridProps
+	26,  // 99: tarkov.v2.Headphone.hpf:type_name -> tarkov.v2.HighPass
+	27,  // 100: tarkov.v2.Headphone.compressor:type_name -> tarkov.v2.Compressor
+	2,   // 101: tarkov.v2.Key.grid:type_name -> tarkov.v2.GridProps
+	2,   // 102: tarkov.v2.Magazine.grid:type_name -> tarkov.v2.GridProps
+	30,  // 103: tarkov.v2.Magazine.modifier:type_name -> tarkov.v2.MagazineModifier
+	31,  // 104: tarkov.v2.Magazine.grid_modifier:type_name -> tarkov.v2.GridModifier
+	64,  // 105: tarkov.v2.Magazine.compatibility:type_name -> tarkov.v2.Magazine.CompatibilityEntry
+	65,  // 106: tarkov.v2.Magazine.conflicts:type_name -> tarkov.v2.Magazine.ConflictsEntry
+	2,   // 107: tarkov.v2.Map.grid:type_name -> tarkov.v2.GridProps
+	2,   // 108: tarkov.v2.Medical.grid:type_name -> tarkov.v2.GridProps
+	23,  // 109: tarkov.v2.Medical.effects:type_name -> tarkov.v2.Effects
+	2,   // 110: tarkov.v2.Melee.grid:type_name -> tarkov.v2.GridProps
+	35,  // 111: tarkov.v2.Melee.slash:type_name -> tarkov.v2.MeleeAttack
+	35,  // 112: tarkov.v2.Melee.stab:type_name -> tarkov.v2.MeleeAttack
+	2,   // 113: tarkov.v2.Modification.grid:type_name -> tarkov.v2.GridProps
+	31,  // 114: tarkov.v2.Modification.grid_modifier:type_name -> tarkov.v2.GridModifier
+	66,  // 115: tarkov.v2.Modification.slots:type_name -> tarkov.v2.Modification.SlotsEntry
+	67,  // 116: tarkov.v2.Modification.compatibility:type_name -> tarkov.v2.Modification.CompatibilityEntry
+	68,  // 117: tarkov.v2.Modification.conflicts:type_name -> tarkov.v2.Modification.ConflictsEntry
+	2,   // 118: tarkov.v2.Barrel.grid:type_name -> tarkov.v2.GridProps
+	31,  // 119: tarkov.v2.Barrel.grid_modifier:type_name -> tarkov.v2.GridModifier
+	69,  // 120: tarkov.v2.Barrel.slots:type_name -> tarkov.v2.Barrel.SlotsEntry
+	70,  // 121: tarkov.v2.Barrel.compatibility:type_name -> tarkov.v2.Barrel.CompatibilityEntry
+	71,  // 122: tarkov.v2.Barrel.conflicts:type_name -> tarkov.v2.Barrel.ConflictsEntry
+	2,   // 123: tarkov.v2.Auxiliary.grid:type_name -> tarkov.v2.GridProps
+	31,  // 124: tarkov.v2.Auxiliary.grid_modifier:type_name -> tarkov.v2.GridModifier
+	72,  // 125: tarkov.v2.Auxiliary.slots:type_name -> tarkov.v2.Auxiliary.SlotsEntry
+	73,  // 126: tarkov.v2.Auxiliary.compatibility:type_name -> tarkov.v2.Auxiliary.CompatibilityEntry
+	74,  // 127: tarkov.v2.Auxiliary.conflicts:type_name -> tarkov.v2.Auxiliary.ConflictsEntry
+	2,   // 128: tarkov.v2.Bipod.grid:type_name -> tarkov.v2.GridProps
+	31,  // 129: tarkov.v2.Bipod.grid_modifier:type_name -> tarkov.v2.GridModifier
+	75,  // 130: tarkov.v2.Bipod.slots:type_name -> tarkov.v2.Bipod.SlotsEntry
+	76,  // 131: tarkov.v2.Bipod.compatibility:type_name -> tarkov.v2.Bipod.CompatibilityEntry
+	77,  // 132: tarkov.v2.Bipod.conflicts:type_name -> tarkov.v2.Bipod.ConflictsEntry
+	2,   // 133: tarkov.v2.Charge.grid:type_name -> tarkov.v2.GridProps
+	31,  // 134: tarkov.v2.Charge.grid_modifier:type_name -> tarkov.v2.GridModifier
+	78,  // 135: tarkov.v2.Charge.slots:type_name -> tarkov.v2.Charge.SlotsEntry
+	79,  // 136: tarkov.v2.Charge.compatibility:type_name -> tarkov.v2.Charge.CompatibilityEntry
+	80,  // 137: tarkov.v2.Charge.conflicts:type_name -> tarkov.v2.Charge.ConflictsEntry
+	2,   // 138: tarkov.v2.Device.grid:type_name -> tarkov.v2.GridProps
+	31,  // 139: tarkov.v2.Device.grid_modifier:type_name -> tarkov.v2.GridModifier
+	81,  // 140: tarkov.v2.Device.slots:type_name -> tarkov.v2.Device.SlotsEntry
+	82,  // 141: tarkov.v2.Device.compatibility:type_name -> tarkov.v2.Device.CompatibilityEntry
+	83,  // 142: tarkov.v2.Device.conflicts:type_name -> tarkov.v2.Device.ConflictsEntry
+	2,   // 143: tarkov.v2.Foregrip.grid:type_name -> tarkov.v2.GridProps
+	31,  // 144: tarkov.v2.Foregrip.grid_modifier:type_name -> tarkov.v2.GridModifier
+	84,  // 145: tarkov.v2.Foregrip.slots:type_name -> tarkov.v2.Foregrip.SlotsEntry
+	85,  // 146: tarkov.v2.Foregrip.compatibility:type_name -> tarkov.v2.Foregrip.CompatibilityEntry
+	86,  // 147: tarkov.v2.Foregrip.conflicts:type_name -> tarkov.v2.Foregrip.ConflictsEntry
+	2,   // 148: tarkov.v2.GasBlock.grid:type_name -> tarkov.v2.GridProps
+	31,  // 149: tarkov.v2.GasBlock.grid_modifier:type_name -> tarkov.v2.GridModifier
+	87,  // 150: tarkov.v2.GasBlock.slots:type_name -> tarkov.v2.GasBlock.SlotsEntry
+	88,  // 151: tarkov.v2.GasBlock.compatibility:type_name -> tarkov.v2.GasBlock.CompatibilityEntry
+	89,  // 152: tarkov.v2.GasBlock.conflicts:type_name -> tarkov.v2.GasBlock.ConflictsEntry
+	2,   // 153: tarkov.v2.Goggles.grid:type_name -> tarkov.v2.GridProps
+	31,  // 154: tarkov.v2.Goggles.grid_modifier:type_name -> tarkov.v2.GridModifier
+	90,  // 155: tarkov.v2.Goggles.slots:type_name -> tarkov.v2.Goggles.SlotsEntry
+	91,  // 156: tarkov.v2.Goggles.compatibility:type_name -> tarkov.v2.Goggles.CompatibilityEntry
+	92,  // 157: tarkov.v2.Goggles.conflicts:type_name -> tarkov.v2.Goggles.ConflictsEntry
+	1,   // 158: tarkov.v2.Goggles.color:type_name -> tarkov.v2.RGBA
+	2,   // 159: tarkov.v2.Handguard.grid:type_name -> tarkov.v2.GridProps
+	31,  // 160: tarkov.v2.Handguard.grid_modifier:type_name -> tarkov.v2.GridModifier
+	93,  // 161: tarkov.v2.Handguard.slots:type_name -> tarkov.v2.Handguard.SlotsEntry
+	94,  // 162: tarkov.v2.Handguard.compatibility:type_name -> tarkov.v2.Handguard.CompatibilityEntry
+	95,  // 163: tarkov.v2.Handguard.conflicts:type_name -> tarkov.v2.Handguard.ConflictsEntry
+	2,   // 164: tarkov.v2.Launcher.grid:type_name -> tarkov.v2.GridProps
+	31,  // 165: tarkov.v2.Launcher.grid_modifier:type_name -> tarkov.v2.GridModifier
+	96,  // 166: tarkov.v2.Launcher.slots:type_name -> tarkov.v2.Launcher.SlotsEntry
+	97,  // 167: tarkov.v2.Launcher.compatibility:type_name -> tarkov.v2.Launcher.CompatibilityEntry
+	98,  // 168: tarkov.v2.Launcher.conflicts:type_name -> tarkov.v2.Launcher.ConflictsEntry
+	2,   // 169: tarkov.v2.Mount.grid:type_name -> tarkov.v2.GridProps
+	31,  // 170: tarkov.v2.Mount.grid_modifier:type_name -> tarkov.v2.GridModifier
+	99,  // 171: tarkov.v2.Mount.slots:type_name -> tarkov.v2.Mount.SlotsEntry
+	100, // 172: tarkov.v2.Mount.compatibility:type_name -> tarkov.v2.Mount.CompatibilityEntry
+	101, // 173: tarkov.v2.Mount.conflicts:type_name -> tarkov.v2.Mount.ConflictsEntry
+	2,   // 174: tarkov.v2.Muzzle.grid:type_name -> tarkov.v2.GridProps
+	31,  // 175: tarkov.v2.Muzzle.grid_modifier:type_name -> tarkov.v2.GridModifier
+	102, // 176: tarkov.v2.Muzzle.slots:type_name -> tarkov.v2.Muzzle.SlotsEntry
+	103, // 177: tarkov.v2.Muzzle.compatibility:type_name -> tarkov.v2.Muzzle.CompatibilityEntry
+	104, // 178: tarkov.v2.Muzzle.conflicts:type_name -> tarkov.v2.Muzzle.ConflictsEntry
+	2,   // 179: tarkov.v2.PistolGrip.grid:type_name -> tarkov.v2.GridProps
+	31,  // 180: tarkov.v2.PistolGrip.grid_modifier:type_name -> tarkov.v2.GridModifier
+	105, // 181: tarkov.v2.PistolGrip.slots:type_name -> tarkov.v2.PistolGrip.SlotsEntry
+	106, // 182: tarkov.v2.PistolGrip.compatibility:type_name -> tarkov.v2.PistolGrip.CompatibilityEntry
+	107, // 183: tarkov.v2.PistolGrip.conflicts:type_name -> tarkov.v2.PistolGrip.ConflictsEntry
+	2,   // 184: tarkov.v2.Receiver.grid:type_name -> tarkov.v2.GridProps
+	31,  // 185: tarkov.v2.Receiver.grid_modifier:type_name -> tarkov.v2.GridModifier
+	108, // 186: tarkov.v2.Receiver.slots:type_name -> tarkov.v2.Receiver.SlotsEntry
+	109, // 187: tarkov.v2.Receiver.compatibility:type_name -> tarkov.v2.Receiver.CompatibilityEntry
+	110, // 188: tarkov.v2.Receiver.conflicts:type_name -> tarkov.v2.Receiver.ConflictsEntry
+	2,   // 189: tarkov.v2.Sight.grid:type_name -> tarkov.v2.GridProps
+	31,  // 190: tarkov.v2.Sight.grid_modifier:type_name -> tarkov.v2.GridModifier
+	111, // 191: tarkov.v2.Sight.slots:type_name -> tarkov.v2.Sight.SlotsEntry
+	112, // 192: tarkov.v2.Sight.compatibility:type_name -> tarkov.v2.Sight.CompatibilityEntry
+	113, // 193: tarkov.v2.Sight.conflicts:type_name -> tarkov.v2.Sight.ConflictsEntry
+	2,   // 194: tarkov.v2.SightSpecial.grid:type_name -> tarkov.v2.GridProps
+	31,  // 195: tarkov.v2.SightSpecial.grid_modifier:type_name -> tarkov.v2.GridModifier
+	114, // 196: tarkov.v2.SightSpecial.slots:type_name -> tarkov.v2.SightSpecial.SlotsEntry
+	115, // 197: tarkov.v2.SightSpecial.compatibility:type_name -> tarkov.v2.SightSpecial.CompatibilityEntry
+	116, // 198: tarkov.v2.SightSpecial.conflicts:type_name -> tarkov.v2.SightSpecial.ConflictsEntry
+	1,   // 199: tarkov.v2.SightSpecial.color:type_name -> tarkov.v2.RGBA
+	2,   // 200: tarkov.v2.Stock.grid:type_name -> tarkov.v2.GridProps
+	31,  // 201: tarkov.v2.Stock.grid_modifier:type_name -> tarkov.v2.GridModifier
+	117, // 202: tarkov.v2.Stock.slots:type_name -> tarkov.v2.Stock.SlotsEntry
+	118, // 203: tarkov.v2.Stock.compatibility:type_name -> tarkov.v2.Stock.CompatibilityEntry
+	119, // 204: tarkov.v2.Stock.conflicts:type_name -> tarkov.v2.Stock.ConflictsEntry
+	2,   // 205: tarkov.v2.Money.grid:type_name -> tarkov.v2.GridProps
+	2,   // 206: tarkov.v2.TacticalRig.grid:type_name -> tarkov.v2.GridProps
+	14,  // 207: tarkov.v2.TacticalRig.grids:type_name -> tarkov.v2.Grid
+	11,  // 208: tarkov.v2.TacticalRig.penalties:type_name -> tarkov.v2.Penalties
+	9,   // 209: tarkov.v2.TacticalRig.armor:type_name -> tarkov.v2.ArmorProps
+	10,  // 210: tarkov.v2.TacticalRig.armor_components:type_name -> tarkov.v2.ArmorComponent
+	120, // 211: tarkov.v2.TacticalRig.slots:type_name -> tarkov.v2.TacticalRig.SlotsEntry
+	121, // 212: tarkov.v2.Slot.FilterEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 213: tarkov.v2.Armor.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 214: tarkov.v2.Armor.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 215: tarkov.v2.Armor.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	121, // 216: tarkov.v2.Grid.FilterEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 217: tarkov.v2.Clothing.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	12,  // 218: tarkov.v2.Firearm.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 219: tarkov.v2.Magazine.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 220: tarkov.v2.Magazine.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 221: tarkov.v2.Modification.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 222: tarkov.v2.Modification.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 223: tarkov.v2.Modification.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 224: tarkov.v2.Barrel.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 225: tarkov.v2.Barrel.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 226: tarkov.v2.Barrel.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 227: tarkov.v2.Auxiliary.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 228: tarkov.v2.Auxiliary.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 229: tarkov.v2.Auxiliary.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 230: tarkov.v2.Bipod.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 231: tarkov.v2.Bipod.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 232: tarkov.v2.Bipod.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 233: tarkov.v2.Charge.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 234: tarkov.v2.Charge.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 235: tarkov.v2.Charge.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 236: tarkov.v2.Device.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 237: tarkov.v2.Device.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 238: tarkov.v2.Device.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 239: tarkov.v2.Foregrip.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 240: tarkov.v2.Foregrip.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 241: tarkov.v2.Foregrip.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 242: tarkov.v2.GasBlock.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 243: tarkov.v2.GasBlock.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 244: tarkov.v2.GasBlock.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 245: tarkov.v2.Goggles.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 246: tarkov.v2.Goggles.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 247: tarkov.v2.Goggles.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 248: tarkov.v2.Handguard.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 249: tarkov.v2.Handguard.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 250: tarkov.v2.Handguard.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 251: tarkov.v2.Launcher.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 252: tarkov.v2.Launcher.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 253: tarkov.v2.Launcher.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 254: tarkov.v2.Mount.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 255: tarkov.v2.Mount.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 256: tarkov.v2.Mount.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 257: tarkov.v2.Muzzle.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 258: tarkov.v2.Muzzle.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 259: tarkov.v2.Muzzle.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 260: tarkov.v2.PistolGrip.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 261: tarkov.v2.PistolGrip.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 262: tarkov.v2.PistolGrip.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 263: tarkov.v2.Receiver.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 264: tarkov.v2.Receiver.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 265: tarkov.v2.Receiver.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 266: tarkov.v2.Sight.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 267: tarkov.v2.Sight.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 268: tarkov.v2.Sight.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 269: tarkov.v2.SightSpecial.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 270: tarkov.v2.SightSpecial.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 271: tarkov.v2.SightSpecial.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 272: tarkov.v2.Stock.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	121, // 273: tarkov.v2.Stock.CompatibilityEntry.value:type_name -> google.protobuf.ListValue
+	121, // 274: tarkov.v2.Stock.ConflictsEntry.value:type_name -> google.protobuf.ListValue
+	12,  // 275: tarkov.v2.TacticalRig.SlotsEntry.value:type_name -> tarkov.v2.Slot
+	276, // [276:276] is the sub-list for method output_type
+	276, // [276:276] is the sub-list for method input_type
+	276, // [276:276] is the sub-list for extension type_name
+	276, // [276:276] is the sub-list for extension extendee
+	0,   // [0:276] is the sub-list for field type_name
+}
+
+func init() { file_item_proto_init() }
+func file_item_proto_init() {
+	if File_item_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_item_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RGBA); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GridProps); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmmoFrag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmmoEffects); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeaponModifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmmoGrenadeProperties); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ammunition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArmorMaterial); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArmorProps); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArmorComponent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Penalties); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Slot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Armor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Grid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Backpack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Barter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clothing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommonItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Container); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Firearm); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EffectPenalties); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Effect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Effects); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Food); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Grenade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HighPass); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Compressor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headphone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Key); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MagazineModifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GridModifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Magazine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Map); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Medical); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeleeAttack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Melee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Modification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Barrel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Auxiliary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bipod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Charge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Device); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Foregrip); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GasBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Goggles); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Handguard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Launcher); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Muzzle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PistolGrip); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Receiver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SightSpecial); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Money); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_item_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TacticalRig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_item_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Item_Ammunition)(nil),
+		(*Item_Armor)(nil),
+		(*Item_Backpack)(nil),
+		(*Item_Barter)(nil),
+		(*Item_Clothing)(nil),
+		(*Item_Common)(nil),
+		(*Item_Container)(nil),
+		(*Item_Firearm)(nil),
+		(*Item_Food)(nil),
+		(*Item_Grenade)(nil),
+		(*Item_Headphone)(nil),
+		(*Item_Key)(nil),
+		(*Item_Magazine)(nil),
+		(*Item_Map)(nil),
+		(*Item_Medical)(nil),
+		(*Item_Melee)(nil),
+		(*Item_Modification)(nil),
+		(*Item_ModificationBarrel)(nil),
+		(*Item_ModificationAuxiliary)(nil),
+		(*Item_ModificationBipod)(nil),
+		(*Item_ModificationCharge)(nil),
+		(*Item_ModificationDevice)(nil),
+		(*Item_ModificationForegrip)(nil),
+		(*Item_ModificationGasblock)(nil),
+		(*Item_ModificationGoggles)(nil),
+		(*Item_ModificationHandguard)(nil),
+		(*Item_ModificationLauncher)(nil),
+		(*Item_ModificationMount)(nil),
+		(*Item_ModificationMuzzle)(nil),
+		(*Item_ModificationPistolgrip)(nil),
+		(*Item_ModificationReceiver)(nil),
+		(*Item_ModificationSight)(nil),
+		(*Item_ModificationSightSpecial)(nil),
+		(*Item_ModificationStock)(nil),
+		(*Item_Money)(nil),
+		(*Item_Tacticalrig)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_item_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   121,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_item_proto_goTypes,
+		DependencyIndexes: file_item_proto_depIdxs,
+		MessageInfos:      file_item_proto_msgTypes,
+	}.Build()
+	File_item_proto = out.File
+	file_item_proto_rawDesc = nil
+	file_item_proto_goTypes = nil
+	file_item_proto_depIdxs = nil
+}
@@ -0,0 +1,1043 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: service.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ItemService_GetItem_FullMethodName   = "/tarkov.v2.ItemService/GetItem"
+	ItemService_ListItems_FullMethodName = "/tarkov.v2.ItemService/ListItems"
+)
+
+// ItemServiceClient is the client API for ItemService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ItemServiceClient interface {
+	GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*Item, error)
+	ListItems(ctx context.Context, in *ListItemsRequest, opts ...grpc.CallOption) (ItemService_ListItemsClient, error)
+}
+
+type itemServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewItemServiceClient(cc grpc.ClientConnInterface) ItemServiceClient {
+	return &itemServiceClient{cc}
+}
+
+func (c *itemServiceClient) GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*Item, error) {
+	out := new(Item)
+	err := c.cc.Invoke(ctx, ItemService_GetItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) ListItems(ctx context.Context, in *ListItemsRequest, opts ...grpc.CallOption) (ItemService_ListItemsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ItemService_ServiceDesc.Streams[0], ItemService_ListItems_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &itemServiceListItemsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ItemService_ListItemsClient interface {
+	Recv() (*Item, error)
+	grpc.ClientStream
+}
+
+type itemServiceListItemsClient struct {
+	grpc.ClientStream
+}
+
+func (x *itemServiceListItemsClient) Recv() (*Item, error) {
+	m := new(Item)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ItemServiceServer is the server API for ItemService service.
+// All implementations must embed UnimplementedItemServiceServer
+// for forward compatibility
+type ItemServiceServer interface {
+	GetItem(context.Context, *GetItemRequest) (*Item, error)
+	ListItems(*ListItemsRequest, ItemService_ListItemsServer) error
+	mustEmbedUnimplementedItemServiceServer()
+}
+
+// UnimplementedItemServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedItemServiceServer struct {
+}
+
+func (UnimplementedItemServiceServer) GetItem(context.Context, *GetItemRequest) (*Item, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItem not implemented")
+}
+func (UnimplementedItemServiceServer) ListItems(*ListItemsRequest, ItemService_ListItemsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListItems not implemented")
+}
+func (UnimplementedItemServiceServer) mustEmbedUnimplementedItemServiceServer() {}
+
+// UnsafeItemServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ItemServiceServer will
+// result in compilation errors.
+type UnsafeItemServiceServer interface {
+	mustEmbedUnimplementedItemServiceServer()
+}
+
+func RegisterItemServiceServer(s grpc.ServiceRegistrar, srv ItemServiceServer) {
+	s.RegisterService(&ItemService_ServiceDesc, srv)
+}
+
+func _ItemService_GetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).GetItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemService_GetItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).GetItem(ctx, req.(*GetItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_ListItems_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListItemsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ItemServiceServer).ListItems(m, &itemServiceListItemsServer{stream})
+}
+
+type ItemService_ListItemsServer interface {
+	Send(*Item) error
+	grpc.ServerStream
+}
+
+type itemServiceListItemsServer struct {
+	grpc.ServerStream
+}
+
+func (x *itemServiceListItemsServer) Send(m *Item) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// ItemService_ServiceDesc is the grpc.ServiceDesc for ItemService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ItemService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tarkov.v2.ItemService",
+	HandlerType: (*ItemServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetItem",
+			Handler:    _ItemService_GetItem_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListItems",
+			Handler:       _ItemService_ListItems_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "service.proto",
+}
+
+const (
+	LocationService_GetLocation_FullMethodName       = "/tarkov.v2.LocationService/GetLocation"
+	LocationService_ListLocations_FullMethodName     = "/tarkov.v2.LocationService/ListLocations"
+	LocationService_GetFeature_FullMethodName        = "/tarkov.v2.LocationService/GetFeature"
+	LocationService_ListFeatures_FullMethodName      = "/tarkov.v2.LocationService/ListFeatures"
+	LocationService_GetFeatureGroup_FullMethodName   = "/tarkov.v2.LocationService/GetFeatureGroup"
+	LocationService_ListFeatureGroups_FullMethodName = "/tarkov.v2.LocationService/ListFeatureGroups"
+)
+
+// LocationServiceClient is the client API for LocationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LocationServiceClient interface {
+	GetLocation(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Location, error)
+	ListLocations(ctx context.Context, in *ListLocationsRequest, opts ...grpc.CallOption) (LocationService_ListLocationsClient, error)
+	GetFeature(ctx context.Context, in *GetLocationEntityRequest, opts ...grpc.CallOption) (*Feature, error)
+	ListFeatures(ctx context.Context, in *ListFeaturesRequest, opts ...grpc.CallOption) (LocationService_ListFeaturesClient, error)
+	GetFeatureGroup(ctx context.Context, in *GetLocationEntityRequest, opts ...grpc.CallOption) (*FeatureGroup, error)
+	ListFeatureGroups(ctx context.Context, in *ListFeatureGroupsRequest, opts ...grpc.CallOption) (LocationService_ListFeatureGroupsClient, error)
+}
+
+type locationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLocationServiceClient(cc grpc.ClientConnInterface) LocationServiceClient {
+	return &locationServiceClient{cc}
+}
+
+func (c *locationServiceClient) GetLocation(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Location, error) {
+	out := new(Location)
+	err := c.cc.Invoke(ctx, LocationService_GetLocation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationServiceClient) ListLocations(ctx context.Context, in *ListLocationsRequest, opts ...grpc.CallOption) (LocationService_ListLocationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LocationService_ServiceDesc.Streams[0], LocationService_ListLocations_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &locationServiceListLocationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LocationService_ListLocationsClient interface {
+	Recv() (*Location, error)
+	grpc.ClientStream
+}
+
+type locationServiceListLocationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *locationServiceListLocationsClient) Recv() (*Location, error) {
+	m := new(Location)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *locationServiceClient) GetFeature(ctx context.Context, in *GetLocationEntityRequest, opts ...grpc.CallOption) (*Feature, error) {
+	out := new(Feature)
+	err := c.cc.Invoke(ctx, LocationService_GetFeature_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationServiceClient) ListFeatures(ctx context.Context, in *ListFeaturesRequest, opts ...grpc.CallOption) (LocationService_ListFeaturesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LocationService_ServiceDesc.Streams[1], LocationService_ListFeatures_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &locationServiceListFeaturesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LocationService_ListFeaturesClient interface {
+	Recv() (*Feature, error)
+	grpc.ClientStream
+}
+
+type locationServiceListFeaturesClient struct {
+	grpc.ClientStream
+}
+
+func (x *locationServiceListFeaturesClient) Recv() (*Feature, error) {
+	m := new(Feature)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *locationServiceClient) GetFeatureGroup(ctx context.Context, in *GetLocationEntityRequest, opts ...grpc.CallOption) (*FeatureGroup, error) {
+	out := new(FeatureGroup)
+	err := c.cc.Invoke(ctx, LocationService_GetFeatureGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationServiceClient) ListFeatureGroups(ctx context.Context, in *ListFeatureGroupsRequest, opts ...grpc.CallOption) (LocationService_ListFeatureGroupsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LocationService_ServiceDesc.Streams[2], LocationService_ListFeatureGroups_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &locationServiceListFeatureGroupsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LocationService_ListFeatureGroupsClient interface {
+	Recv() (*FeatureGroup, error)
+	grpc.ClientStream
+}
+
+type locationServiceListFeatureGroupsClient struct {
+	grpc.ClientStream
+}
+
+func (x *locationServiceListFeatureGroupsClient) Recv() (*FeatureGroup, error) {
+	m := new(FeatureGroup)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// LocationServiceServer is the server API for LocationService service.
+// All implementations must embed UnimplementedLocationServiceServer
+// for forward compatibility
+type LocationServiceServer interface {
+	GetLocation(context.Context, *GetRequest) (*Location, error)
+	ListLocations(*ListLocationsRequest, LocationService_ListLocationsServer) error
+	GetFeature(context.Context, *GetLocationEntityRequest) (*Feature, error)
+	ListFeatures(*ListFeaturesRequest, LocationService_ListFeaturesServer) error
+	GetFeatureGroup(context.Context, *GetLocationEntityRequest) (*FeatureGroup, error)
+	ListFeatureGroups(*ListFeatureGroupsRequest, LocationService_ListFeatureGroupsServer) error
+	mustEmbedUnimplementedLocationServiceServer()
+}
+
+// UnimplementedLocationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLocationServiceServer struct {
+}
+
+func (UnimplementedLocationServiceServer) GetLocation(context.Context, *GetRequest) (*Location, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocation not implemented")
+}
+func (UnimplementedLocationServiceServer) ListLocations(*ListLocationsRequest, LocationService_ListLocationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListLocations not implemented")
+}
+func (UnimplementedLocationServiceServer) GetFeature(context.Context, *GetLocationEntityRequest) (*Feature, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeature not implemented")
+}
+func (UnimplementedLocationServiceServer) ListFeatures(*ListFeaturesRequest, LocationService_ListFeaturesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListFeatures not implemented")
+}
+func (UnimplementedLocationServiceServer) GetFeatureGroup(context.Context, *GetLocationEntityRequest) (*FeatureGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeatureGroup not implemented")
+}
+func (UnimplementedLocationServiceServer) ListFeatureGroups(*ListFeatureGroupsRequest, LocationService_ListFeatureGroupsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListFeatureGroups not implemented")
+}
+func (UnimplementedLocationServiceServer) mustEmbedUnimplementedLocationServiceServer() {}
+
+// UnsafeLocationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LocationServiceServer will
+// result in compilation errors.
+type UnsafeLocationServiceServer interface {
+	mustEmbedUnimplementedLocationServiceServer()
+}
+
+func RegisterLocationServiceServer(s grpc.ServiceRegistrar, srv LocationServiceServer) {
+	s.RegisterService(&LocationService_ServiceDesc, srv)
+}
+
+func _LocationService_GetLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServiceServer).GetLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocationService_GetLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServiceServer).GetLocation(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationService_ListLocations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListLocationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LocationServiceServer).ListLocations(m, &locationServiceListLocationsServer{stream})
+}
+
+type LocationService_ListLocationsServer interface {
+	Send(*Location) error
+	grpc.ServerStream
+}
+
+type locationServiceListLocationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *locationServiceListLocationsServer) Send(m *Location) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LocationService_GetFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocationEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServiceServer).GetFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocationService_GetFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServiceServer).GetFeature(ctx, req.(*GetLocationEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationService_ListFeatures_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListFeaturesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LocationServiceServer).ListFeatures(m, &locationServiceListFeaturesServer{stream})
+}
+
+type LocationService_ListFeaturesServer interface {
+	Send(*Feature) error
+	grpc.ServerStream
+}
+
+type locationServiceListFeaturesServer struct {
+	grpc.ServerStream
+}
+
+func (x *locationServiceListFeaturesServer) Send(m *Feature) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LocationService_GetFeatureGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocationEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServiceServer).GetFeatureGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocationService_GetFeatureGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServiceServer).GetFeatureGroup(ctx, req.(*GetLocationEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationService_ListFeatureGroups_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListFeatureGroupsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LocationServiceServer).ListFeatureGroups(m, &locationServiceListFeatureGroupsServer{stream})
+}
+
+type LocationService_ListFeatureGroupsServer interface {
+	Send(*FeatureGroup) error
+	grpc.ServerStream
+}
+
+type locationServiceListFeatureGroupsServer struct {
+	grpc.ServerStream
+}
+
+func (x *locationServiceListFeatureGroupsServer) Send(m *FeatureGroup) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// LocationService_ServiceDesc is the grpc.ServiceDesc for LocationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LocationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tarkov.v2.LocationService",
+	HandlerType: (*LocationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLocation",
+			Handler:    _LocationService_GetLocation_Handler,
+		},
+		{
+			MethodName: "GetFeature",
+			Handler:    _LocationService_GetFeature_Handler,
+		},
+		{
+			MethodName: "GetFeatureGroup",
+			Handler:    _LocationService_GetFeatureGroup_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListLocations",
+			Handler:       _LocationService_ListLocations_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListFeatures",
+			Handler:       _LocationService_ListFeatures_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListFeatureGroups",
+			Handler:       _LocationService_ListFeatureGroups_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "service.proto",
+}
+
+const (
+	HideoutService_GetModule_FullMethodName       = "/tarkov.v2.HideoutService/GetModule"
+	HideoutService_ListModules_FullMethodName     = "/tarkov.v2.HideoutService/ListModules"
+	HideoutService_GetProduction_FullMethodName   = "/tarkov.v2.HideoutService/GetProduction"
+	HideoutService_ListProductions_FullMethodName = "/tarkov.v2.HideoutService/ListProductions"
+)
+
+// HideoutServiceClient is the client API for HideoutService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HideoutServiceClient interface {
+	GetModule(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Module, error)
+	ListModules(ctx context.Context, in *ListModulesRequest, opts ...grpc.CallOption) (HideoutService_ListModulesClient, error)
+	GetProduction(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Production, error)
+	ListProductions(ctx context.Context, in *ListProductionsRequest, opts ...grpc.CallOption) (HideoutService_ListProductionsClient, error)
+}
+
+type hideoutServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHideoutServiceClient(cc grpc.ClientConnInterface) HideoutServiceClient {
+	return &hideoutServiceClient{cc}
+}
+
+func (c *hideoutServiceClient) GetModule(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Module, error) {
+	out := new(Module)
+	err := c.cc.Invoke(ctx, HideoutService_GetModule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hideoutServiceClient) ListModules(ctx context.Context, in *ListModulesRequest, opts ...grpc.CallOption) (HideoutService_ListModulesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &HideoutService_ServiceDesc.Streams[0], HideoutService_ListModules_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &hideoutServiceListModulesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HideoutService_ListModulesClient interface {
+	Recv() (*Module, error)
+	grpc.ClientStream
+}
+
+type hideoutServiceListModulesClient struct {
+	grpc.ClientStream
+}
+
+func (x *hideoutServiceListModulesClient) Recv() (*Module, error) {
+	m := new(Module)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *hideoutServiceClient) GetProduction(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Production, error) {
+	out := new(Production)
+	err := c.cc.Invoke(ctx, HideoutService_GetProduction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hideoutServiceClient) ListProductions(ctx context.Context, in *ListProductionsRequest, opts ...grpc.CallOption) (HideoutService_ListProductionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &HideoutService_ServiceDesc.Streams[1], HideoutService_ListProductions_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &hideoutServiceListProductionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HideoutService_ListProductionsClient interface {
+	Recv() (*Production, error)
+	grpc.ClientStream
+}
+
+type hideoutServiceListProductionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *hideoutServiceListProductionsClient) Recv() (*Production, error) {
+	m := new(Production)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// HideoutServiceServer is the server API for HideoutService service.
+// All implementations must embed UnimplementedHideoutServiceServer
+// for forward compatibility
+type HideoutServiceServer interface {
+	GetModule(context.Context, *GetRequest) (*Module, error)
+	ListModules(*ListModulesRequest, HideoutService_ListModulesServer) error
+	GetProduction(context.Context, *GetRequest) (*Production, error)
+	ListProductions(*ListProductionsRequest, HideoutService_ListProductionsServer) error
+	mustEmbedUnimplementedHideoutServiceServer()
+}
+
+// UnimplementedHideoutServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedHideoutServiceServer struct {
+}
+
+func (UnimplementedHideoutServiceServer) GetModule(context.Context, *GetRequest) (*Module, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModule not implemented")
+}
+func (UnimplementedHideoutServiceServer) ListModules(*ListModulesRequest, HideoutService_ListModulesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListModules not implemented")
+}
+func (UnimplementedHideoutServiceServer) GetProduction(context.Context, *GetRequest) (*Production, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProduction not implemented")
+}
+func (UnimplementedHideoutServiceServer) ListProductions(*ListProductionsRequest, HideoutService_ListProductionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListProductions not implemented")
+}
+func (UnimplementedHideoutServiceServer) mustEmbedUnimplementedHideoutServiceServer() {}
+
+// UnsafeHideoutServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HideoutServiceServer will
+// result in compilation errors.
+type UnsafeHideoutServiceServer interface {
+	mustEmbedUnimplementedHideoutServiceServer()
+}
+
+func RegisterHideoutServiceServer(s grpc.ServiceRegistrar, srv HideoutServiceServer) {
+	s.RegisterService(&HideoutService_ServiceDesc, srv)
+}
+
+func _HideoutService_GetModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HideoutServiceServer).GetModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HideoutService_GetModule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HideoutServiceServer).GetModule(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HideoutService_ListModules_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListModulesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HideoutServiceServer).ListModules(m, &hideoutServiceListModulesServer{stream})
+}
+
+type HideoutService_ListModulesServer interface {
+	Send(*Module) error
+	grpc.ServerStream
+}
+
+type hideoutServiceListModulesServer struct {
+	grpc.ServerStream
+}
+
+func (x *hideoutServiceListModulesServer) Send(m *Module) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _HideoutService_GetProduction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HideoutServiceServer).GetProduction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HideoutService_GetProduction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HideoutServiceServer).GetProduction(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HideoutService_ListProductions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListProductionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HideoutServiceServer).ListProductions(m, &hideoutServiceListProductionsServer{stream})
+}
+
+type HideoutService_ListProductionsServer interface {
+	Send(*Production) error
+	grpc.ServerStream
+}
+
+type hideoutServiceListProductionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *hideoutServiceListProductionsServer) Send(m *Production) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// HideoutService_ServiceDesc is the grpc.ServiceDesc for HideoutService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HideoutService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tarkov.v2.HideoutService",
+	HandlerType: (*HideoutServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetModule",
+			Handler:    _HideoutService_GetModule_Handler,
+		},
+		{
+			MethodName: "GetProduction",
+			Handler:    _HideoutService_GetProduction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListModules",
+			Handler:       _HideoutService_ListModules_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListProductions",
+			Handler:       _HideoutService_ListProductions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "service.proto",
+}
+
+const (
+	StatisticService_GetAmmoDistanceStatistics_FullMethodName  = "/tarkov.v2.StatisticService/GetAmmoDistanceStatistics"
+	StatisticService_ListAmmoDistanceStatistics_FullMethodName = "/tarkov.v2.StatisticService/ListAmmoDistanceStatistics"
+	StatisticService_GetAmmoArmorStatistics_FullMethodName     = "/tarkov.v2.StatisticService/GetAmmoArmorStatistics"
+	StatisticService_ListAmmoArmorStatistics_FullMethodName    = "/tarkov.v2.StatisticService/ListAmmoArmorStatistics"
+)
+
+// StatisticServiceClient is the client API for StatisticService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StatisticServiceClient interface {
+	GetAmmoDistanceStatistics(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*AmmoDistanceStatistics, error)
+	ListAmmoDistanceStatistics(ctx context.Context, in *ListAmmoDistanceStatisticsRequest, opts ...grpc.CallOption) (StatisticService_ListAmmoDistanceStatisticsClient, error)
+	GetAmmoArmorStatistics(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*AmmoArmorStatistics, error)
+	ListAmmoArmorStatistics(ctx context.Context, in *ListAmmoArmorStatisticsRequest, opts ...grpc.CallOption) (StatisticService_ListAmmoArmorStatisticsClient, error)
+}
+
+type statisticServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStatisticServiceClient(cc grpc.ClientConnInterface) StatisticServiceClient {
+	return &statisticServiceClient{cc}
+}
+
+func (c *statisticServiceClient) GetAmmoDistanceStatistics(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*AmmoDistanceStatistics, error) {
+	out := new(AmmoDistanceStatistics)
+	err := c.cc.Invoke(ctx, StatisticService_GetAmmoDistanceStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statisticServiceClient) ListAmmoDistanceStatistics(ctx context.Context, in *ListAmmoDistanceStatisticsRequest, opts ...grpc.CallOption) (StatisticService_ListAmmoDistanceStatisticsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StatisticService_ServiceDesc.Streams[0], StatisticService_ListAmmoDistanceStatistics_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &statisticServiceListAmmoDistanceStatisticsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StatisticService_ListAmmoDistanceStatisticsClient interface {
+	Recv() (*AmmoDistanceStatistics, error)
+	grpc.ClientStream
+}
+
+type statisticServiceListAmmoDistanceStatisticsClient struct {
+	grpc.ClientStream
+}
+
+func (x *statisticServiceListAmmoDistanceStatisticsClient) Recv() (*AmmoDistanceStatistics, error) {
+	m := new(AmmoDistanceStatistics)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *statisticServiceClient) GetAmmoArmorStatistics(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*AmmoArmorStatistics, error) {
+	out := new(AmmoArmorStatistics)
+	err := c.cc.Invoke(ctx, StatisticService_GetAmmoArmorStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statisticServiceClient) ListAmmoArmorStatistics(ctx context.Context, in *ListAmmoArmorStatisticsRequest, opts ...grpc.CallOption) (StatisticService_ListAmmoArmorStatisticsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StatisticService_ServiceDesc.Streams[1], StatisticService_ListAmmoArmorStatistics_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &statisticServiceListAmmoArmorStatisticsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StatisticService_ListAmmoArmorStatisticsClient interface {
+	Recv() (*AmmoArmorStatistics, error)
+	grpc.ClientStream
+}
+
+type statisticServiceListAmmoArmorStatisticsClient struct {
+	grpc.ClientStream
+}
+
+func (x *statisticServiceListAmmoArmorStatisticsClient) Recv() (*AmmoArmorStatistics, error) {
+	m := new(AmmoArmorStatistics)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StatisticServiceServer is the server API for StatisticService service.
+// All implementations must embed UnimplementedStatisticServiceServer
+// for forward compatibility
+type StatisticServiceServer interface {
+	GetAmmoDistanceStatistics(context.Context, *GetRequest) (*AmmoDistanceStatistics, error)
+	ListAmmoDistanceStatistics(*ListAmmoDistanceStatisticsRequest, StatisticService_ListAmmoDistanceStatisticsServer) error
+	GetAmmoArmorStatistics(context.Context, *GetRequest) (*AmmoArmorStatistics, error)
+	ListAmmoArmorStatistics(*ListAmmoArmorStatisticsRequest, StatisticService_ListAmmoArmorStatisticsServer) error
+	mustEmbedUnimplementedStatisticServiceServer()
+}
+
+// UnimplementedStatisticServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedStatisticServiceServer struct {
+}
+
+func (UnimplementedStatisticServiceServer) GetAmmoDistanceStatistics(context.Context, *GetRequest) (*AmmoDistanceStatistics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAmmoDistanceStatistics not implemented")
+}
+func (UnimplementedStatisticServiceServer) ListAmmoDistanceStatistics(*ListAmmoDistanceStatisticsRequest, StatisticService_ListAmmoDistanceStatisticsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListAmmoDistanceStatistics not implemented")
+}
+func (UnimplementedStatisticServiceServer) GetAmmoArmorStatistics(context.Context, *GetRequest) (*AmmoArmorStatistics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAmmoArmorStatistics not implemented")
+}
+func (UnimplementedStatisticServiceServer) ListAmmoArmorStatistics(*ListAmmoArmorStatisticsRequest, StatisticService_ListAmmoArmorStatisticsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListAmmoArmorStatistics not implemented")
+}
+func (UnimplementedStatisticServiceServer) mustEmbedUnimplementedStatisticServiceServer() {}
+
+// UnsafeStatisticServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StatisticServiceServer will
+// result in compilation errors.
+type UnsafeStatisticServiceServer interface {
+	mustEmbedUnimplementedStatisticServiceServer()
+}
+
+func RegisterStatisticServiceServer(s grpc.ServiceRegistrar, srv StatisticServiceServer) {
+	s.RegisterService(&StatisticService_ServiceDesc, srv)
+}
+
+func _StatisticService_GetAmmoDistanceStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticServiceServer).GetAmmoDistanceStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatisticService_GetAmmoDistanceStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticServiceServer).GetAmmoDistanceStatistics(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatisticService_ListAmmoDistanceStatistics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAmmoDistanceStatisticsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StatisticServiceServer).ListAmmoDistanceStatistics(m, &statisticServiceListAmmoDistanceStatisticsServer{stream})
+}
+
+type StatisticService_ListAmmoDistanceStatisticsServer interface {
+	Send(*AmmoDistanceStatistics) error
+	grpc.ServerStream
+}
+
+type statisticServiceListAmmoDistanceStatisticsServer struct {
+	grpc.ServerStream
+}
+
+func (x *statisticServiceListAmmoDistanceStatisticsServer) Send(m *AmmoDistanceStatistics) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _StatisticService_GetAmmoArmorStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticServiceServer).GetAmmoArmorStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatisticService_GetAmmoArmorStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticServiceServer).GetAmmoArmorStatistics(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatisticService_ListAmmoArmorStatistics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAmmoArmorStatisticsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StatisticServiceServer).ListAmmoArmorStatistics(m, &statisticServiceListAmmoArmorStatisticsServer{stream})
+}
+
+type StatisticService_ListAmmoArmorStatisticsServer interface {
+	Send(*AmmoArmorStatistics) error
+	grpc.ServerStream
+}
+
+type statisticServiceListAmmoArmorStatisticsServer struct {
+	grpc.ServerStream
+}
+
+func (x *statisticServiceListAmmoArmorStatisticsServer) Send(m *AmmoArmorStatistics) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// StatisticService_ServiceDesc is the grpc.ServiceDesc for StatisticService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StatisticService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tarkov.v2.StatisticService",
+	HandlerType: (*StatisticServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAmmoDistanceStatistics",
+			Handler:    _StatisticService_GetAmmoDistanceStatistics_Handler,
+		},
+		{
+			MethodName: "GetAmmoArmorStatistics",
+			Handler:    _StatisticService_GetAmmoArmorStatistics_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListAmmoDistanceStatistics",
+			Handler:       _StatisticService_ListAmmoDistanceStatistics_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListAmmoArmorStatistics",
+			Handler:       _StatisticService_ListAmmoArmorStatistics_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "service.proto",
+}
@@ -0,0 +1,536 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: statistic.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AmmoDistanceStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string  `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Ammo             string  `protobuf:"bytes,2,opt,name=ammo,proto3" json:"ammo,omitempty"`
+	Distance         uint64  `protobuf:"varint,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	Velocity         float64 `protobuf:"fixed64,4,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	Damage           float64 `protobuf:"fixed64,5,opt,name=damage,proto3" json:"damage,omitempty"`
+	PenetrationPower float64 `protobuf:"fixed64,6,opt,name=penetration_power,json=penetrationPower,proto3" json:"penetration_power,omitempty"`
+	TimeOfFlight     float64 `protobuf:"fixed64,7,opt,name=time_of_flight,json=timeOfFlight,proto3" json:"time_of_flight,omitempty"`
+	Drop             float64 `protobuf:"fixed64,8,opt,name=drop,proto3" json:"drop,omitempty"`
+	Modified         int64   `protobuf:"varint,9,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+}
+
+func (x *AmmoDistanceStatistics) Reset() {
+	*x = AmmoDistanceStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_statistic_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmmoDistanceStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmmoDistanceStatistics) ProtoMessage() {}
+
+func (x *AmmoDistanceStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_statistic_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmmoDistanceStatistics.ProtoReflect.Descriptor instead.
+func (*AmmoDistanceStatistics) Descriptor() ([]byte, []int) {
+	return file_statistic_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AmmoDistanceStatistics) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AmmoDistanceStatistics) GetAmmo() string {
+	if x != nil {
+		return x.Ammo
+	}
+	return ""
+}
+
+func (x *AmmoDistanceStatistics) GetDistance() uint64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *AmmoDistanceStatistics) GetVelocity() float64 {
+	if x != nil {
+		return x.Velocity
+	}
+	return 0
+}
+
+func (x *AmmoDistanceStatistics) GetDamage() float64 {
+	if x != nil {
+		return x.Damage
+	}
+	return 0
+}
+
+func (x *AmmoDistanceStatistics) GetPenetrationPower() float64 {
+	if x != nil {
+		return x.PenetrationPower
+	}
+	return 0
+}
+
+func (x *AmmoDistanceStatistics) GetTimeOfFlight() float64 {
+	if x != nil {
+		return x.TimeOfFlight
+	}
+	return 0
+}
+
+func (x *AmmoDistanceStatistics) GetDrop() float64 {
+	if x != nil {
+		return x.Drop
+	}
+	return 0
+}
+
+func (x *AmmoDistanceStatistics) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+type ArmorItemRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+}
+
+func (x *ArmorItemRef) Reset() {
+	*x = ArmorItemRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_statistic_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArmorItemRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArmorItemRef) ProtoMessage() {}
+
+func (x *ArmorItemRef) ProtoReflect() protoreflect.Message {
+	mi := &file_statistic_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArmorItemRef.ProtoReflect.Descriptor instead.
+func (*ArmorItemRef) Descriptor() ([]byte, []int) {
+	return file_statistic_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ArmorItemRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ArmorItemRef) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type ArmorStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min    float64 `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max    float64 `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+	Mean   float64 `protobuf:"fixed64,3,opt,name=mean,proto3" json:"mean,omitempty"`
+	Median float64 `protobuf:"fixed64,4,opt,name=median,proto3" json:"median,omitempty"`
+	StdDev float64 `protobuf:"fixed64,5,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+}
+
+func (x *ArmorStatistics) Reset() {
+	*x = ArmorStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_statistic_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArmorStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArmorStatistics) ProtoMessage() {}
+
+func (x *ArmorStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_statistic_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArmorStatistics.ProtoReflect.Descriptor instead.
+func (*ArmorStatistics) Descriptor() ([]byte, []int) {
+	return file_statistic_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ArmorStatistics) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *ArmorStatistics) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *ArmorStatistics) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *ArmorStatistics) GetMedian() float64 {
+	if x != nil {
+		return x.Median
+	}
+	return 0
+}
+
+func (x *ArmorStatistics) GetStdDev() float64 {
+	if x != nil {
+		return x.StdDev
+	}
+	return 0
+}
+
+type AmmoArmorStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 string           `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Ammo               string           `protobuf:"bytes,2,opt,name=ammo,proto3" json:"ammo,omitempty"`
+	Armor              *ArmorItemRef    `protobuf:"bytes,3,opt,name=armor,proto3" json:"armor,omitempty"`
+	Distance           uint64           `protobuf:"varint,4,opt,name=distance,proto3" json:"distance,omitempty"`
+	PenetrationChance  []float64        `protobuf:"fixed64,5,rep,packed,name=penetration_chance,json=penetrationChance,proto3" json:"penetration_chance,omitempty"`
+	AvgShotsToDestruct *ArmorStatistics `protobuf:"bytes,6,opt,name=avg_shots_to_destruct,json=avgShotsToDestruct,proto3" json:"avg_shots_to_destruct,omitempty"`
+	AvgShotsTo50Damage *ArmorStatistics `protobuf:"bytes,7,opt,name=avg_shots_to50_damage,json=avgShotsTo50Damage,proto3" json:"avg_shots_to50_damage,omitempty"`
+	Modified           int64            `protobuf:"varint,8,opt,name=modified,json=_modified,proto3" json:"modified,omitempty"`
+}
+
+func (x *AmmoArmorStatistics) Reset() {
+	*x = AmmoArmorStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_statistic_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmmoArmorStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmmoArmorStatistics) ProtoMessage() {}
+
+func (x *AmmoArmorStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_statistic_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmmoArmorStatistics.ProtoReflect.Descriptor instead.
+func (*AmmoArmorStatistics) Descriptor() ([]byte, []int) {
+	return file_statistic_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AmmoArmorStatistics) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AmmoArmorStatistics) GetAmmo() string {
+	if x != nil {
+		return x.Ammo
+	}
+	return ""
+}
+
+func (x *AmmoArmorStatistics) GetArmor() *ArmorItemRef {
+	if x != nil {
+		return x.Armor
+	}
+	return nil
+}
+
+func (x *AmmoArmorStatistics) GetDistance() uint64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *AmmoArmorStatistics) GetPenetrationChance() []float64 {
+	if x != nil {
+		return x.PenetrationChance
+	}
+	return nil
+}
+
+func (x *AmmoArmorStatistics) GetAvgShotsToDestruct() *ArmorStatistics {
+	if x != nil {
+		return x.AvgShotsToDestruct
+	}
+	return nil
+}
+
+func (x *AmmoArmorStatistics) GetAvgShotsTo50Damage() *ArmorStatistics {
+	if x != nil {
+		return x.AvgShotsTo50Damage
+	}
+	return nil
+}
+
+func (x *AmmoArmorStatistics) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+var File_statistic_proto protoreflect.FileDescriptor
+
+var file_statistic_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x09, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x22, 0x91, 0x02, 0x0a,
+	0x16, 0x41, 0x6d, 0x6d, 0x6f, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x6d, 0x6d, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x6d, 0x6d, 0x6f, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x6c, 0x6f,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x6f,
+	0x63, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x70, 0x65, 0x6e, 0x65, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x65, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x64,
+	0x72, 0x6f, 0x70, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x22, 0x32, 0x0a, 0x0c, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x66,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x22, 0x7a, 0x0a, 0x0f, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d,
+	0x65, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x64, 0x5f, 0x64,
+	0x65, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x74, 0x64, 0x44, 0x65, 0x76,
+	0x22, 0xef, 0x02, 0x0a, 0x13, 0x41, 0x6d, 0x6d, 0x6f, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x6d, 0x6d,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x6d, 0x6d, 0x6f, 0x12, 0x2d, 0x0a,
+	0x05, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74,
+	0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x66, 0x52, 0x05, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x65, 0x6e, 0x65,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x01, 0x52, 0x11, 0x70, 0x65, 0x6e, 0x65, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x15, 0x61, 0x76, 0x67, 0x5f, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e,
+	0x76, 0x32, 0x2e, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x12, 0x61, 0x76, 0x67, 0x53, 0x68, 0x6f, 0x74, 0x73, 0x54, 0x6f, 0x44, 0x65,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x4d, 0x0a, 0x15, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x5f, 0x74, 0x6f, 0x35, 0x30, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2e, 0x76,
+	0x32, 0x2e, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x52, 0x12, 0x61, 0x76, 0x67, 0x53, 0x68, 0x6f, 0x74, 0x73, 0x54, 0x6f, 0x35, 0x30, 0x44,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x74, 0x61, 0x72, 0x6b, 0x6f, 0x76, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x2f, 0x72, 0x65, 0x73, 0x74, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x72,
+	0x70, 0x63, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_statistic_proto_rawDescOnce sync.Once
+	file_statistic_proto_rawDescData = file_statistic_proto_rawDesc
+)
+
+func file_statistic_proto_rawDescGZIP() []byte {
+	file_statistic_proto_rawDescOnce.Do(func() {
+		file_statistic_proto_rawDescData = protoimpl.X.CompressGZIP(file_statistic_proto_rawDescData)
+	})
+	return file_statistic_proto_rawDescData
+}
+
+var file_statistic_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_statistic_proto_goTypes = []interface{}{
+	(*AmmoDistanceStatistics)(nil), // 0: tarkov.v2.AmmoDistanceStatistics
+	(*ArmorItemRef)(nil),           // 1: tarkov.v2.ArmorItemRef
+	(*ArmorStatistics)(nil),        // 2: tarkov.v2.ArmorStatistics
+	(*AmmoArmorStatistics)(nil),    // 3: tarkov.v2.AmmoArmorStatistics
+}
+var file_statistic_proto_depIdxs = []int32{
+	1, // 0: tarkov.v2.AmmoArmorStatistics.armor:type_name -> tarkov.v2.ArmorItemRef
+	2, // 1: tarkov.v2.AmmoArmorStatistics.avg_shots_to_destruct:type_name -> tarkov.v2.ArmorStatistics
+	2, // 2: tarkov.v2.AmmoArmorStatistics.avg_shots_to50_damage:type_name -> tarkov.v2.ArmorStatistics
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_statistic_proto_init() }
+func file_statistic_proto_init() {
+	if File_statistic_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_statistic_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmmoDistanceStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_statistic_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArmorItemRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_statistic_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArmorStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_statistic_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmmoArmorStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_statistic_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_statistic_proto_goTypes,
+		DependencyIndexes: file_statistic_proto_depIdxs,
+		MessageInfos:      file_statistic_proto_msgTypes,
+	}.Build()
+	File_statistic_proto = out.File
+	file_statistic_proto_rawDesc = nil
+	file_statistic_proto_goTypes = nil
+	file_statistic_proto_depIdxs = nil
+}
@@ -0,0 +1,189 @@
+package rpc
+
+import (
+	"context"
+	"io"
+	"log"
+	"net"
+	"testing"
+
+	"github.com/tarkov-database/rest-api/core/config"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/core/rpc/pb"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model/item"
+	"github.com/tarkov-database/rest-api/model/location"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func init() {
+	logger.SetOutput(io.Discard)
+
+	c := config.Default().JWT
+	c.Key, c.Audience = "testing-secret", "testing"
+
+	if err := jwt.Configure(c); err != nil {
+		log.Fatalf("JWT configuration error: %s", err)
+	}
+}
+
+func dial(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+
+	srv := NewServer()
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Dialing server failed: %s", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+func withToken(t *testing.T, scope ...string) context.Context {
+	t.Helper()
+
+	token, err := jwt.SignToken(&jwt.Claims{Scope: scope}, nil)
+	if err != nil {
+		t.Fatalf("Token creation failed: %s", err)
+	}
+
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+func TestAuthorization(t *testing.T) {
+	client := pb.NewItemServiceClient(dial(t))
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		code codes.Code
+	}{
+		{"NoToken", context.Background(), codes.Unauthenticated},
+		{"BadHeader", metadata.AppendToOutgoingContext(context.Background(), "authorization", "Basic abc"), codes.Unauthenticated},
+		{"BadToken", metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer abc"), codes.Unauthenticated},
+		{"WrongScope", withToken(t, jwt.ScopeLocationRead), codes.PermissionDenied},
+		{"Scope", withToken(t, jwt.ScopeItemRead), codes.InvalidArgument},
+		{"AllScope", withToken(t, jwt.ScopeAllRead), codes.InvalidArgument},
+		{"QualifiedScope", withToken(t, jwt.QualifyScope(jwt.ScopeItemRead, "armor")), codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// An invalid kind is rejected before any database access
+			_, err := client.GetItem(tt.ctx, &pb.GetItemRequest{Kind: "invalid"})
+			if c := status.Code(err); c != tt.code {
+				t.Errorf("Unary call failed: expected code %s, got %s (%v)", tt.code, c, err)
+			}
+
+			stream, err := client.ListItems(tt.ctx, &pb.ListItemsRequest{Kind: "invalid"})
+			if err == nil {
+				_, err = stream.Recv()
+			}
+			if c := status.Code(err); c != tt.code {
+				t.Errorf("Stream call failed: expected code %s, got %s (%v)", tt.code, c, err)
+			}
+		})
+	}
+}
+
+func TestQualifiedScope(t *testing.T) {
+	client := pb.NewItemServiceClient(dial(t))
+
+	// The scope of another kind is rejected before any database access
+	ctx := withToken(t, jwt.QualifyScope(jwt.ScopeItemRead, "armor"))
+
+	_, err := client.GetItem(ctx, &pb.GetItemRequest{Kind: item.KindAmmunition.String()})
+	if c := status.Code(err); c != codes.PermissionDenied {
+		t.Errorf("Unary call failed: expected code %s, got %s (%v)", codes.PermissionDenied, c, err)
+	}
+
+	stream, err := client.ListItems(ctx, &pb.ListItemsRequest{Kind: item.KindAmmunition.String()})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if c := status.Code(err); c != codes.PermissionDenied {
+		t.Errorf("Stream call failed: expected code %s, got %s (%v)", codes.PermissionDenied, c, err)
+	}
+
+	locations := pb.NewLocationServiceClient(dial(t))
+
+	ctx = withToken(t, jwt.QualifyScope(jwt.ScopeLocationRead, primitive.NewObjectID().Hex()))
+
+	_, err = locations.GetFeature(ctx, &pb.GetLocationEntityRequest{Location: primitive.NewObjectID().Hex()})
+	if c := status.Code(err); c != codes.PermissionDenied {
+		t.Errorf("Unary call failed: expected code %s, got %s (%v)", codes.PermissionDenied, c, err)
+	}
+
+	lstream, err := locations.ListLocations(ctx, &pb.ListLocationsRequest{})
+	if err == nil {
+		_, err = lstream.Recv()
+	}
+	if c := status.Code(err); c != codes.PermissionDenied {
+		t.Errorf("Stream call failed: expected code %s, got %s (%v)", codes.PermissionDenied, c, err)
+	}
+}
+
+func TestToItem(t *testing.T) {
+	for _, k := range item.KindList {
+		e, err := k.GetEntity()
+		if err != nil {
+			t.Fatalf("Getting entity failed: %s", err)
+		}
+
+		e.SetID(primitive.NewObjectID())
+		e.SetKind(k)
+
+		m, err := toItem(e)
+		if err != nil {
+			t.Fatalf("Converting %s failed: %s", k, err)
+		}
+
+		if m.GetKind() == nil {
+			t.Errorf("Converting %s failed: kind is not set", k)
+		}
+	}
+
+	ammo := &item.Ammunition{Caliber: "9x19mm"}
+	ammo.SetID(primitive.NewObjectID())
+	ammo.SetKind(item.KindAmmunition)
+
+	m, err := toItem(ammo)
+	if err != nil {
+		t.Fatalf("Converting ammunition failed: %s", err)
+	}
+
+	if got := m.GetAmmunition(); got.GetCaliber() != ammo.Caliber || got.GetId() != ammo.ID.Hex() || got.GetKind() != "ammunition" {
+		t.Errorf("Converting ammunition failed: unexpected message %v", got)
+	}
+}
+
+func TestToMessage(t *testing.T) {
+	loc := &location.Location{Name: "Customs", Available: true}
+
+	m := &pb.Location{}
+	if err := toMessage(loc, m); err != nil {
+		t.Fatalf("Converting location failed: %s", err)
+	}
+
+	if m.GetName() != loc.Name || !m.GetAvailable() {
+		t.Errorf("Converting location failed: unexpected message %v", m)
+	}
+}
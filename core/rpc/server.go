@@ -0,0 +1,73 @@
+package rpc
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/core/reload"
+	"github.com/tarkov-database/rest-api/core/rpc/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// NewServer creates a gRPC server with all services registered
+func NewServer(opts ...grpc.ServerOption) *grpc.Server {
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(unaryAuth),
+		grpc.ChainStreamInterceptor(streamAuth),
+	)
+
+	srv := grpc.NewServer(opts...)
+
+	pb.RegisterItemServiceServer(srv, &itemServer{})
+	pb.RegisterLocationServiceServer(srv, &locationServer{})
+	pb.RegisterHideoutServiceServer(srv, &hideoutServer{})
+	pb.RegisterStatisticServiceServer(srv, &statisticServer{})
+
+	return srv
+}
+
+// ListenAndServe starts the gRPC server
+func ListenAndServe() error {
+	var opts []grpc.ServerOption
+
+	if cfg.TLS {
+		kp, err := reload.NewKeyPair("gRPC server certificate", cfg.Certificate, cfg.PrivateKey)
+		if err != nil {
+			return fmt.Errorf("gRPC server credentials: %w", err)
+		}
+
+		creds := credentials.NewTLS(&tls.Config{GetCertificate: kp.GetCertificate})
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%v", cfg.Port))
+	if err != nil {
+		return err
+	}
+
+	srv := NewServer(opts...)
+
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+		<-sig
+
+		logger.Info("gRPC server is shutting down...")
+		srv.GracefulStop()
+	}()
+
+	if cfg.TLS {
+		logger.Infof("gRPC server (TLS) listen and serve on *:%v\n", cfg.Port)
+	} else {
+		logger.Infof("gRPC server listen and serve on *:%v\n", cfg.Port)
+	}
+
+	return srv.Serve(lis)
+}
@@ -0,0 +1,114 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/tarkov-database/rest-api/core/rpc/pb"
+	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/model/statistic/ammunition/armor"
+	"github.com/tarkov-database/rest-api/model/statistic/ammunition/distance"
+)
+
+type statisticServer struct {
+	pb.UnimplementedStatisticServiceServer
+}
+
+// GetAmmoDistanceStatistics implements the StatisticService
+func (s *statisticServer) GetAmmoDistanceStatistics(ctx context.Context, req *pb.GetRequest) (*pb.AmmoDistanceStatistics, error) {
+	stats, err := distance.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	m := &pb.AmmoDistanceStatistics{}
+	if err := toMessage(stats, m); err != nil {
+		return nil, conversionError(err)
+	}
+
+	return m, nil
+}
+
+// ListAmmoDistanceStatistics implements the StatisticService
+func (s *statisticServer) ListAmmoDistanceStatistics(req *pb.ListAmmoDistanceStatisticsRequest, stream pb.StatisticService_ListAmmoDistanceStatisticsServer) error {
+	ctx := stream.Context()
+
+	ammo := req.GetAmmo()
+	if err := checkIDs(ammo); err != nil {
+		return err
+	}
+	if len(ammo) == 0 {
+		ammo = nil
+	}
+
+	var gte, lte *uint64
+	if r := req.GetRange(); r != nil {
+		gte, lte = r.Min, r.Max
+	}
+
+	fetch := func(limit, offset int64) (*model.Result, error) {
+		opts := &distance.Options{Sort: getSort("distance", req.GetOptions()), Limit: limit, Offset: offset}
+		return distance.GetByRefsAndRange(ctx, ammo, gte, lte, opts)
+	}
+
+	return streamList(stream, req.GetOptions(), fetch, func(v interface{}) error {
+		m := &pb.AmmoDistanceStatistics{}
+		if err := toMessage(v, m); err != nil {
+			return conversionError(err)
+		}
+
+		return stream.Send(m)
+	})
+}
+
+// GetAmmoArmorStatistics implements the StatisticService
+func (s *statisticServer) GetAmmoArmorStatistics(ctx context.Context, req *pb.GetRequest) (*pb.AmmoArmorStatistics, error) {
+	stats, err := armor.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	m := &pb.AmmoArmorStatistics{}
+	if err := toMessage(stats, m); err != nil {
+		return nil, conversionError(err)
+	}
+
+	return m, nil
+}
+
+// ListAmmoArmorStatistics implements the StatisticService
+func (s *statisticServer) ListAmmoArmorStatistics(req *pb.ListAmmoArmorStatisticsRequest, stream pb.StatisticService_ListAmmoArmorStatisticsServer) error {
+	ctx := stream.Context()
+
+	ammo, armorIDs := req.GetAmmo(), req.GetArmor()
+	if err := checkIDs(ammo); err != nil {
+		return err
+	}
+	if err := checkIDs(armorIDs); err != nil {
+		return err
+	}
+	if len(ammo) == 0 {
+		ammo = nil
+	}
+	if len(armorIDs) == 0 {
+		armorIDs = nil
+	}
+
+	rng := &armor.RangeOptions{}
+	if r := req.GetRange(); r != nil {
+		rng.GTE, rng.LTE = r.Min, r.Max
+	}
+
+	fetch := func(limit, offset int64) (*model.Result, error) {
+		opts := &armor.Options{Sort: getSort("distance", req.GetOptions()), Limit: limit, Offset: offset}
+		return armor.GetByRefs(ctx, ammo, armorIDs, rng, opts)
+	}
+
+	return streamList(stream, req.GetOptions(), fetch, func(v interface{}) error {
+		m := &pb.AmmoArmorStatistics{}
+		if err := toMessage(v, m); err != nil {
+			return conversionError(err)
+		}
+
+		return stream.Send(m)
+	})
+}
@@ -1,63 +1,49 @@
 package server
 
 import (
-	"errors"
-	"log"
-	"os"
-	"strconv"
-)
-
-var cfg *config
+	"crypto/tls"
+	"time"
 
-func init() {
-	var err error
+	"github.com/tarkov-database/rest-api/core/config"
+)
 
-	cfg, err = newConfig()
-	if err != nil {
-		log.Printf("Configuration error: %s\n", err)
-		os.Exit(2)
-	}
-}
+var cfg = newConfig(config.Default().Server)
 
-type config struct {
+type settings struct {
 	Port        int
 	TLS         bool
 	Certificate string
 	PrivateKey  string
-}
+	ClientAuth  tls.ClientAuthType
 
-func newConfig() (*config, error) {
-	c := &config{Port: 8080}
+	// ShutdownDelay is the time between failing readiness and closing the
+	// listener, so that load balancers stop routing new requests
+	ShutdownDelay time.Duration
+}
 
-	if env := os.Getenv("SERVER_PORT"); len(env) > 1 {
-		if i, err := strconv.Atoi(env); err == nil {
-			c.Port = i
-		} else {
-			return c, errors.New("server port is not an integer")
-		}
-	}
+// Configure applies the configuration to the server
+func Configure(c config.Server) {
+	cfg = newConfig(c)
+}
 
-	if env := os.Getenv("SERVER_TLS"); len(env) > 3 {
-		if b, err := strconv.ParseBool(env); err == nil {
-			c.TLS = b
-		} else {
-			return c, errors.New("invalid boolean in environment variable")
-		}
+func newConfig(c config.Server) *settings {
+	s := &settings{
+		Port:          c.Port,
+		TLS:           c.TLS,
+		Certificate:   c.Certificate,
+		PrivateKey:    c.PrivateKey,
+		ShutdownDelay: c.ShutdownDelay.Duration,
 	}
 
-	if c.TLS {
-		if env := os.Getenv("SERVER_CERT"); len(env) > 0 {
-			c.Certificate = env
-		} else {
-			return c, errors.New("server certificate missing")
-		}
-
-		if env := os.Getenv("SERVER_KEY"); len(env) > 0 {
-			c.PrivateKey = env
-		} else {
-			return c, errors.New("server private key missing")
-		}
+	// Client certificates are verified against the JWT root certificates
+	switch c.ClientAuth {
+	case "optional":
+		s.ClientAuth = tls.VerifyClientCertIfGiven
+	case "require":
+		s.ClientAuth = tls.RequireAndVerifyClientCert
+	default:
+		s.ClientAuth = tls.NoClientCert
 	}
 
-	return c, nil
+	return s
 }
@@ -2,15 +2,21 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
+	"github.com/tarkov-database/rest-api/core/health"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/core/reload"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
 	"github.com/tarkov-database/rest-api/route"
-
-	"github.com/google/logger"
 )
 
 // ListenAndServe starts the HTTP server
@@ -22,6 +28,33 @@ func ListenAndServe() error {
 		Handler: mux,
 	}
 
+	if cfg.TLS {
+		kp, err := reload.NewKeyPair("server certificate", cfg.Certificate, cfg.PrivateKey)
+		if err != nil {
+			return fmt.Errorf("certificate loading error: %s", err)
+		}
+
+		srv.TLSConfig = &tls.Config{GetCertificate: kp.GetCertificate}
+	}
+
+	if cfg.ClientAuth != tls.NoClientCert {
+		if jwt.ClientCAs() == nil {
+			return errors.New("no root certificates for client authentication")
+		}
+
+		srv.TLSConfig.ClientAuth = cfg.ClientAuth
+
+		// The root certificates are looked up per handshake, so that
+		// reloaded ones apply to new connections
+		base := srv.TLSConfig.Clone()
+		srv.TLSConfig.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			c := base.Clone()
+			c.ClientCAs = jwt.ClientCAs()
+
+			return c, nil
+		}
+	}
+
 	idleConnsClosed := make(chan struct{})
 	go func() {
 		sig := make(chan os.Signal, 1)
@@ -30,22 +63,33 @@ func ListenAndServe() error {
 
 		fmt.Println()
 		logger.Info("HTTP server is shutting down...")
+
+		health.SetReady(false)
+		time.Sleep(cfg.ShutdownDelay)
+
 		if err := srv.Shutdown(context.Background()); err != nil {
-			logger.Fatalf("HTTP server Shutdown: %v", err)
+			logger.Errorf("HTTP server shutdown error: %s", err)
 		}
 
 		close(idleConnsClosed)
 	}()
 
+	ln, err := net.Listen("tcp", srv.Addr)
+	if err != nil {
+		return fmt.Errorf("listen error: %s", err)
+	}
+
+	health.SetReady(true)
+
 	if cfg.TLS {
 		logger.Infof("HTTPS server listen and serve on *:%v\n\n", cfg.Port)
-		if err := srv.ListenAndServeTLS(cfg.Certificate, cfg.PrivateKey); err != http.ErrServerClosed {
-			logger.Fatalf("HTTP server ListenAndServe: %v", err)
+		if err := srv.ServeTLS(ln, "", ""); err != http.ErrServerClosed {
+			return fmt.Errorf("serve error: %s", err)
 		}
 	} else {
 		logger.Infof("HTTP server listen and serve on *:%v\n\n", cfg.Port)
-		if err := srv.ListenAndServe(); err != http.ErrServerClosed {
-			logger.Fatalf("HTTP server ListenAndServe: %v", err)
+		if err := srv.Serve(ln); err != http.ErrServerClosed {
+			return fmt.Errorf("serve error: %s", err)
 		}
 	}
 
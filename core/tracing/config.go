@@ -0,0 +1,11 @@
+package tracing
+
+import "github.com/tarkov-database/rest-api/core/config"
+
+var cfg = &config.Default().Tracing
+
+// Configure applies the configuration to the tracing. It has to be called
+// before Init.
+func Configure(c config.Tracing) {
+	cfg = &c
+}
@@ -0,0 +1,76 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// commandMonitor keeps the spans of started commands until they finish
+type commandMonitor struct {
+	spans sync.Map
+}
+
+// CommandMonitor returns a monitor starting a client span for each operation
+// of the database client. Operations issued with a request context become
+// children of the request span.
+func CommandMonitor() *event.CommandMonitor {
+	m := &commandMonitor{}
+
+	return &event.CommandMonitor{
+		Started:   m.start,
+		Succeeded: m.succeed,
+		Failed:    m.fail,
+	}
+}
+
+func (m *commandMonitor) start(ctx context.Context, e *event.CommandStartedEvent) {
+	if !cfg.Enabled {
+		return
+	}
+
+	attrs := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemMongoDB,
+			semconv.DBName(e.DatabaseName),
+			semconv.DBOperation(e.CommandName),
+		),
+	}
+
+	name := e.CommandName
+	if coll, ok := e.Command.Lookup(e.CommandName).StringValueOK(); ok {
+		name = coll + "." + e.CommandName
+		attrs = append(attrs, trace.WithAttributes(semconv.DBMongoDBCollection(coll)))
+	}
+
+	_, span := tracer().Start(ctx, name, attrs...)
+
+	m.spans.Store(e.RequestID, span)
+}
+
+func (m *commandMonitor) finish(id int64, err string) {
+	v, ok := m.spans.LoadAndDelete(id)
+	if !ok {
+		return
+	}
+
+	span := v.(trace.Span)
+	if err != "" {
+		span.SetStatus(codes.Error, err)
+	}
+
+	span.End()
+}
+
+func (m *commandMonitor) succeed(_ context.Context, e *event.CommandSucceededEvent) {
+	m.finish(e.RequestID, "")
+}
+
+func (m *commandMonitor) fail(_ context.Context, e *event.CommandFailedEvent) {
+	m.finish(e.RequestID, e.Failure)
+}
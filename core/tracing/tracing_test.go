@@ -0,0 +1,145 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// useRecorder enables tracing with a provider recording all spans
+func useRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	rec := tracetest.NewSpanRecorder()
+
+	prevProvider, prevPropagator, prevEnabled := otel.GetTracerProvider(), otel.GetTextMapPropagator(), cfg.Enabled
+
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	cfg.Enabled = true
+
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+		cfg.Enabled = prevEnabled
+	})
+
+	return rec
+}
+
+func TestHandler(t *testing.T) {
+	rec := useRecorder(t)
+
+	const (
+		traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+		spanID  = "00f067aa0ba902b7"
+	)
+
+	var handlerCtx context.Context
+
+	h := Handler("/v2/item/:id", func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+		handlerCtx = r.Context()
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/item/abc", nil)
+	req.Header.Set("traceparent", "00-"+traceID+"-"+spanID+"-01")
+
+	h(httptest.NewRecorder(), req, nil)
+
+	spans := rec.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("Number of spans is %v, expected 1", len(spans))
+	}
+
+	span := spans[0]
+
+	if span.Name() != "GET /v2/item/:id" {
+		t.Errorf("Span name is %q, expected %q", span.Name(), "GET /v2/item/:id")
+	}
+	if span.SpanKind() != trace.SpanKindServer {
+		t.Errorf("Span kind is %v, expected %v", span.SpanKind(), trace.SpanKindServer)
+	}
+	if id := span.SpanContext().TraceID().String(); id != traceID {
+		t.Errorf("Trace ID is %s, expected %s", id, traceID)
+	}
+	if id := span.Parent().SpanID().String(); id != spanID {
+		t.Errorf("Parent span ID is %s, expected %s", id, spanID)
+	}
+	if span.Status().Code != codes.Error {
+		t.Errorf("Span status is %v, expected %v", span.Status().Code, codes.Error)
+	}
+
+	var status int64
+	for _, a := range span.Attributes() {
+		if a.Key == "http.response.status_code" {
+			status = a.Value.AsInt64()
+		}
+	}
+	if status != http.StatusInternalServerError {
+		t.Errorf("Status code attribute is %v, expected %v", status, http.StatusInternalServerError)
+	}
+
+	if !trace.SpanContextFromContext(handlerCtx).Equal(span.SpanContext()) {
+		t.Error("Request context does not carry the span")
+	}
+}
+
+func TestCommandMonitor(t *testing.T) {
+	rec := useRecorder(t)
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "request")
+
+	cmd, _ := bson.Marshal(bson.D{{Key: "find", Value: "items"}})
+
+	m := CommandMonitor()
+	m.Started(ctx, &event.CommandStartedEvent{Command: cmd, CommandName: "find", DatabaseName: "tarkov", RequestID: 1})
+	m.Started(ctx, &event.CommandStartedEvent{Command: cmd, CommandName: "find", DatabaseName: "tarkov", RequestID: 2})
+	m.Succeeded(ctx, &event.CommandSucceededEvent{CommandFinishedEvent: event.CommandFinishedEvent{CommandName: "find", RequestID: 1}})
+	m.Failed(ctx, &event.CommandFailedEvent{CommandFinishedEvent: event.CommandFinishedEvent{CommandName: "find", RequestID: 2}, Failure: "timeout"})
+
+	parent.End()
+
+	spans := rec.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("Number of spans is %v, expected 3", len(spans))
+	}
+
+	for i, span := range spans[:2] {
+		if span.Name() != "items.find" {
+			t.Errorf("Span name is %q, expected %q", span.Name(), "items.find")
+		}
+		if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("Span %v is not a child of the request span", i)
+		}
+
+		var coll string
+		for _, a := range span.Attributes() {
+			if a.Key == attribute.Key("db.mongodb.collection") {
+				coll = a.Value.AsString()
+			}
+		}
+		if coll != "items" {
+			t.Errorf("Collection attribute is %q, expected %q", coll, "items")
+		}
+	}
+
+	if spans[0].Status().Code == codes.Error {
+		t.Error("Succeeded operation has error status")
+	}
+	if spans[1].Status().Code != codes.Error {
+		t.Error("Failed operation has no error status")
+	}
+}
@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress indicates that a webhook URL refers to a loopback,
+// link-local or private address
+var ErrForbiddenAddress = errors.New("address is not allowed")
+
+// isAllowedIP checks if deliveries to the address are allowed
+func isAllowedIP(ip net.IP) bool {
+	if cfg.AllowPrivate {
+		return true
+	}
+
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsUnspecified() &&
+		!ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast()
+}
+
+// CheckURL checks if a webhook URL refers to an allowed address. Other host
+// names than localhost are checked by the addresses they resolve to on
+// delivery.
+func CheckURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+
+	host := strings.ToLower(u.Hostname())
+
+	if ip := net.ParseIP(host); ip != nil && !isAllowedIP(ip) {
+		return ErrForbiddenAddress
+	}
+
+	if (host == "localhost" || strings.HasSuffix(host, ".localhost")) && !cfg.AllowPrivate {
+		return ErrForbiddenAddress
+	}
+
+	return nil
+}
+
+// newClient returns the client of the deliveries, which checks the address
+// of every connection, since the address of a host can change after its URL
+// has been checked and redirects can lead to other hosts
+func newClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 30 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			if ip := net.ParseIP(host); ip == nil || !isAllowedIP(ip) {
+				return ErrForbiddenAddress
+			}
+
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{Timeout: cfg.Timeout, Transport: transport}
+}
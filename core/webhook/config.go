@@ -0,0 +1,37 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/config"
+)
+
+var cfg = newConfig(config.Default().Webhook)
+
+type settings struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	Timeout     time.Duration
+	QueueSize   int
+	Workers     int
+
+	AllowPrivate bool
+}
+
+// Configure applies the configuration to the delivery of webhooks. It has to
+// be called before Init.
+func Configure(c config.Webhook) {
+	cfg = newConfig(c)
+}
+
+func newConfig(c config.Webhook) *settings {
+	return &settings{
+		MaxAttempts: c.MaxAttempts,
+		Backoff:     c.Backoff.Duration,
+		Timeout:     c.Timeout.Duration,
+		QueueSize:   c.QueueSize,
+		Workers:     c.Workers,
+
+		AllowPrivate: c.AllowPrivate,
+	}
+}
@@ -0,0 +1,242 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/event"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/model/webhook"
+)
+
+const (
+	// HeaderDelivery holds the ID of the delivery
+	HeaderDelivery = "X-Webhook-Delivery"
+
+	// HeaderEvent holds the resource and operation of the event
+	HeaderEvent = "X-Webhook-Event"
+
+	// HeaderSignature holds the HMAC-SHA256 signature of the body
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+const maxBackoff = 30 * time.Minute
+
+// Payload represents the body of a delivery
+type Payload struct {
+	Delivery string       `json:"delivery"`
+	Webhook  string       `json:"webhook"`
+	Event    *event.Event `json:"event"`
+}
+
+// job is the delivery of an event to a webhook
+type job struct {
+	hook     *webhook.Webhook
+	delivery *webhook.Delivery
+	body     []byte
+}
+
+var (
+	queue  chan *event.Event
+	jobs   chan *job
+	client *http.Client
+
+	// record persists the state of a delivery
+	record = func(d *webhook.Delivery) {
+		if err := webhook.UpdateDelivery(context.Background(), d); err != nil {
+			logger.Errorf("Error while updating webhook delivery %s: %s", d.ID.Hex(), err)
+		}
+	}
+)
+
+// Init starts the webhook dispatcher and the delivery workers, which stop
+// when the context is done
+func Init(ctx context.Context) {
+	queue = make(chan *event.Event, cfg.QueueSize)
+	jobs = make(chan *job, cfg.QueueSize)
+	client = newClient()
+
+	event.AddHandler(enqueue)
+
+	go dispatcher(ctx)
+
+	for i := 0; i < cfg.Workers; i++ {
+		go worker(ctx)
+	}
+}
+
+func enqueue(e *event.Event) {
+	select {
+	case queue <- e:
+	default:
+		logger.Warningf("Webhook queue is full, dropping %s event of %s %s", e.Operation, e.Resource, e.Document)
+	}
+}
+
+// dispatcher creates the deliveries of the events. It waits for the workers
+// if all of them are busy, so that further events are dropped by enqueue.
+func dispatcher(ctx context.Context) {
+	for {
+		var e *event.Event
+
+		select {
+		case e = <-queue:
+		case <-ctx.Done():
+			return
+		}
+
+		hooks, err := webhook.GetByEvent(ctx, e)
+		if err != nil {
+			logger.Errorf("Error while getting webhooks: %s", err)
+			continue
+		}
+
+		for _, h := range hooks {
+			j, err := newJob(ctx, h, e)
+			if err != nil {
+				logger.Errorf("Error while creating webhook delivery: %s", err)
+				continue
+			}
+
+			select {
+			case jobs <- j:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+func newJob(ctx context.Context, h *webhook.Webhook, e *event.Event) (*job, error) {
+	d := &webhook.Delivery{
+		Webhook: h.ID,
+		Event:   e,
+		Status:  webhook.DeliveryPending,
+	}
+
+	if err := webhook.CreateDelivery(ctx, d); err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(&Payload{
+		Delivery: d.ID.Hex(),
+		Webhook:  h.ID.Hex(),
+		Event:    e,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &job{hook: h, delivery: d, body: body}, nil
+}
+
+func worker(ctx context.Context) {
+	for {
+		select {
+		case j := <-jobs:
+			deliver(ctx, j)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// deliver makes the next attempt of a delivery. A failed attempt is retried
+// after the backoff without occupying the worker in the meantime.
+func deliver(ctx context.Context, j *job) {
+	d := j.delivery
+	attempt := d.Attempts + 1
+
+	code, err := send(ctx, j.hook, d, j.body)
+
+	d.Attempts = attempt
+	d.StatusCode = code
+	d.Error = ""
+
+	switch {
+	case err == nil:
+		d.Status = webhook.DeliverySucceeded
+	case attempt >= cfg.MaxAttempts:
+		d.Status = webhook.DeliveryFailed
+		logger.Warningf("Webhook delivery %s failed after %d attempts: %s", d.ID.Hex(), attempt, err)
+	}
+
+	if err != nil {
+		d.Error = err.Error()
+	}
+
+	record(d)
+
+	if d.Status != webhook.DeliveryPending {
+		return
+	}
+
+	time.AfterFunc(backoff(attempt), func() { retry(ctx, j) })
+}
+
+// retry queues the next attempt of a delivery, which fails if the queue is
+// full
+func retry(ctx context.Context, j *job) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	select {
+	case jobs <- j:
+	default:
+		d := j.delivery
+		d.Status = webhook.DeliveryFailed
+		d.Error = "delivery queue is full"
+
+		logger.Warningf("Webhook delivery %s failed after %d attempts: %s", d.ID.Hex(), d.Attempts, d.Error)
+
+		record(d)
+	}
+}
+
+func send(ctx context.Context, h *webhook.Webhook, d *webhook.Delivery, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderDelivery, d.ID.Hex())
+	req.Header.Set(HeaderEvent, fmt.Sprintf("%s.%s", d.Event.Resource, d.Event.Operation))
+	req.Header.Set(HeaderSignature, "sha256="+sign(h.Secret, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func backoff(attempt int) time.Duration {
+	d := cfg.Backoff << (attempt - 1)
+	if d <= 0 || d > maxBackoff {
+		return maxBackoff
+	}
+
+	return d
+}
@@ -0,0 +1,166 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/config"
+	"github.com/tarkov-database/rest-api/core/event"
+	"github.com/tarkov-database/rest-api/model/webhook"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// next returns the retry of a delivery
+func next(t *testing.T) *job {
+	t.Helper()
+
+	select {
+	case j := <-jobs:
+		return j
+	case <-time.After(time.Second):
+		t.Fatal("Delivering webhook failed: attempt not retried")
+		return nil
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	cfg.MaxAttempts = 3
+	cfg.Backoff = time.Millisecond
+	cfg.Timeout = time.Second
+	cfg.AllowPrivate = true
+	t.Cleanup(func() { cfg = newConfig(config.Default().Webhook) })
+
+	client = newClient()
+	jobs = make(chan *job, 1)
+
+	ctx := context.Background()
+
+	var records []webhook.Delivery
+	record = func(d *webhook.Delivery) {
+		records = append(records, *d)
+	}
+
+	hook := &webhook.Webhook{ID: primitive.NewObjectID(), Secret: "secret"}
+
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		body, _ := io.ReadAll(r.Body)
+
+		sig := strings.TrimPrefix(r.Header.Get(HeaderSignature), "sha256=")
+		if !hmac.Equal([]byte(sig), []byte(sign(hook.Secret, body))) {
+			t.Error("Delivering webhook failed: signature mismatch")
+		}
+		if r.Header.Get(HeaderEvent) != "item.create" {
+			t.Errorf("Delivering webhook failed: unexpected event header %s", r.Header.Get(HeaderEvent))
+		}
+
+		payload := &Payload{}
+		if err := json.Unmarshal(body, payload); err != nil {
+			t.Errorf("Delivering webhook failed: %s", err)
+		}
+
+		if calls == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	hook.URL = srv.URL
+
+	d := &webhook.Delivery{
+		ID:      primitive.NewObjectID(),
+		Webhook: hook.ID,
+		Event:   &event.Event{Operation: event.OperationCreate, Resource: event.ResourceItem},
+		Status:  webhook.DeliveryPending,
+	}
+
+	deliver(ctx, &job{hook: hook, delivery: d, body: []byte(`{"delivery":"test"}`)})
+	deliver(ctx, next(t))
+
+	if len(records) != 2 {
+		t.Fatalf("Delivering webhook failed: expected 2 attempts, got %d", len(records))
+	}
+	if records[0].Status != webhook.DeliveryPending || records[0].StatusCode != http.StatusInternalServerError {
+		t.Errorf("Delivering webhook failed: unexpected first attempt %v", records[0])
+	}
+	if records[1].Status != webhook.DeliverySucceeded || records[1].Attempts != 2 {
+		t.Errorf("Delivering webhook failed: unexpected second attempt %v", records[1])
+	}
+
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	})
+
+	records = nil
+	d.Status = webhook.DeliveryPending
+	d.Attempts = 0
+
+	deliver(ctx, &job{hook: hook, delivery: d, body: []byte(`{}`)})
+	for d.Status == webhook.DeliveryPending {
+		deliver(ctx, next(t))
+	}
+
+	if last := records[len(records)-1]; last.Status != webhook.DeliveryFailed || last.Attempts != cfg.MaxAttempts {
+		t.Errorf("Delivering webhook failed: unexpected last attempt %v", last)
+	}
+}
+
+func TestPrivateAddress(t *testing.T) {
+	cfg.Timeout = time.Second
+	t.Cleanup(func() { cfg = newConfig(config.Default().Webhook) })
+
+	tests := []struct {
+		url     string
+		allowed bool
+	}{
+		{"https://203.0.113.7/hook", true},
+		{"https://example.com/hook", true},
+		{"http://127.0.0.1:8080/hook", false},
+		{"http://[::1]/hook", false},
+		{"http://10.0.0.1/hook", false},
+		{"http://192.168.1.1/hook", false},
+		{"http://169.254.169.254/latest/meta-data", false},
+		{"http://localhost/hook", false},
+	}
+
+	for _, tt := range tests {
+		if err := CheckURL(tt.url); (err == nil) != tt.allowed {
+			t.Errorf("Checking URL %s failed: unexpected error %v", tt.url, err)
+		}
+	}
+
+	// Host names are checked by their address on delivery
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("Delivering webhook failed: private address reached")
+	}))
+	defer srv.Close()
+
+	client = newClient()
+
+	hook := &webhook.Webhook{URL: strings.Replace(srv.URL, "127.0.0.1", "localhost", 1)}
+	d := &webhook.Delivery{ID: primitive.NewObjectID(), Event: &event.Event{}}
+
+	if _, err := send(context.Background(), hook, d, []byte(`{}`)); !errors.Is(err, ErrForbiddenAddress) {
+		t.Errorf("Delivering webhook failed: expected forbidden address, got %v", err)
+	}
+
+	cfg.AllowPrivate = true
+
+	if err := CheckURL(srv.URL); err != nil {
+		t.Errorf("Checking URL %s failed: private address not allowed", srv.URL)
+	}
+}
@@ -0,0 +1,74 @@
+// Package httputil provides the helpers shared by the HTTP middlewares
+package httputil
+
+import (
+	"bytes"
+	"net/http"
+)
+
+// Recorder is a response writer keeping the status code and the size of the
+// response written through it
+type Recorder struct {
+	http.ResponseWriter
+
+	// Status is the status code of the response, which is 200 until it is
+	// written otherwise
+	Status int
+
+	// Bytes is the number of bytes written to the body
+	Bytes int64
+
+	// Body receives a copy of the body if it is set
+	Body *bytes.Buffer
+
+	// OnHeader is called with the status code before the header is written
+	OnHeader func(status int)
+
+	written bool
+}
+
+// NewRecorder returns a recorder of the response writer
+func NewRecorder(w http.ResponseWriter) *Recorder {
+	return &Recorder{ResponseWriter: w, Status: http.StatusOK}
+}
+
+// WriteHeader implements the http.ResponseWriter interface
+func (r *Recorder) WriteHeader(code int) {
+	if !r.written {
+		r.Status, r.written = code, true
+
+		if r.OnHeader != nil {
+			r.OnHeader(code)
+		}
+	}
+
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// Write implements the http.ResponseWriter interface
+func (r *Recorder) Write(b []byte) (int, error) {
+	if !r.written {
+		r.WriteHeader(http.StatusOK)
+	}
+
+	n, err := r.ResponseWriter.Write(b)
+	r.Bytes += int64(n)
+
+	if r.Body != nil {
+		r.Body.Write(b[:n])
+	}
+
+	return n, err
+}
+
+// Flush sends buffered data of streamed responses to the client
+func (r *Recorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the underlying response writer
+func (r *Recorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,56 @@
+package httputil
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRecorder(t *testing.T) {
+	w := httptest.NewRecorder()
+	rec := NewRecorder(w)
+
+	var header int
+	rec.Body = new(bytes.Buffer)
+	rec.OnHeader = func(status int) {
+		header++
+		rec.Header().Set("X-Status", http.StatusText(status))
+	}
+
+	rec.WriteHeader(http.StatusNotFound)
+	rec.WriteHeader(http.StatusOK)
+	rec.Write([]byte("not "))
+	rec.Write([]byte("found"))
+	rec.Flush()
+
+	if rec.Status != http.StatusNotFound {
+		t.Errorf("Status is %v, expected %v", rec.Status, http.StatusNotFound)
+	}
+	if rec.Bytes != 9 || rec.Body.String() != "not found" {
+		t.Errorf("Body is %q of %v bytes, expected %q", rec.Body, rec.Bytes, "not found")
+	}
+	if header != 1 || w.Header().Get("X-Status") != "Not Found" {
+		t.Errorf("Header hook is called %v times, expected once", header)
+	}
+	if !w.Flushed {
+		t.Error("Response is not flushed")
+	}
+}
+
+func TestRecorderImplicitStatus(t *testing.T) {
+	w := httptest.NewRecorder()
+	rec := NewRecorder(w)
+
+	var status int
+	rec.OnHeader = func(s int) { status = s }
+
+	rec.Write([]byte("ok"))
+
+	if rec.Status != http.StatusOK || status != http.StatusOK {
+		t.Errorf("Status is %v and %v in the hook, expected %v", rec.Status, status, http.StatusOK)
+	}
+	if rec.Unwrap() != w {
+		t.Error("Unwrapped writer is not the underlying one")
+	}
+}
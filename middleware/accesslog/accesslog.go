@@ -0,0 +1,68 @@
+package accesslog
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/internal/httputil"
+	"github.com/tarkov-database/rest-api/middleware/ratelimit"
+	"github.com/tarkov-database/rest-api/middleware/requestid"
+
+	"github.com/julienschmidt/httprouter"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// entry holds the values of a request known only to inner handlers
+type entry struct {
+	subject string
+}
+
+type contextKey struct{}
+
+// SetSubject records the authenticated client of the request
+func SetSubject(r *http.Request, subject string) {
+	if e, ok := r.Context().Value(contextKey{}).(*entry); ok {
+		e.subject = subject
+	}
+}
+
+// Handler returns a handler logging each request of the route as a
+// structured entry after it has been served
+func Handler(route string, h httprouter.Handle) httprouter.Handle {
+	if !cfg.Enabled {
+		return h
+	}
+
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		start := time.Now()
+
+		r, id := requestid.Ensure(w, r)
+
+		e := &entry{}
+		rec := httputil.NewRecorder(w)
+
+		h(rec, r.WithContext(context.WithValue(r.Context(), contextKey{}, e)), ps)
+
+		attrs := []slog.Attr{
+			slog.String("method", r.Method),
+			slog.String("route", route),
+			slog.String("path", r.URL.Path),
+			slog.Int("status", rec.Status),
+			slog.Float64("latency", time.Since(start).Seconds()),
+			slog.Int64("bytes", rec.Bytes),
+			slog.String("address", ratelimit.ClientAddr(r)),
+			slog.String("requestId", id),
+		}
+		if e.subject != "" {
+			attrs = append(attrs, slog.String("subject", e.subject))
+		}
+		if sc := trace.SpanContextFromContext(r.Context()); sc.IsValid() {
+			attrs = append(attrs, slog.String("traceId", sc.TraceID().String()))
+		}
+
+		logger.Log(r.Context(), slog.LevelInfo, "request", attrs...)
+	}
+}
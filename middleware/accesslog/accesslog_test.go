@@ -0,0 +1,54 @@
+package accesslog
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/requestid"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestHandler(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger.SetOutput(buf)
+	t.Cleanup(func() { logger.SetOutput(os.Stdout) })
+
+	h := Handler("/v2/item/:id", func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+		SetSubject(r, "5f1b8f3f9d1b2c3a4d5e6f70")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("not found"))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/item/abc", nil)
+	req.Header.Set(requestid.Header, "request-1")
+
+	w := httptest.NewRecorder()
+	h(w, req, nil)
+
+	if id := w.Header().Get(requestid.Header); id != "request-1" {
+		t.Errorf("Response request ID is %q, expected %q", id, "request-1")
+	}
+
+	out := buf.String()
+	for _, field := range []string{
+		"msg=request",
+		"method=GET",
+		"route=/v2/item/:id",
+		"path=/v2/item/abc",
+		"status=404",
+		"bytes=9",
+		"requestId=request-1",
+		"subject=5f1b8f3f9d1b2c3a4d5e6f70",
+		"latency=",
+	} {
+		if !strings.Contains(out, field) {
+			t.Errorf("Entry %q is missing %q", out, field)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package accesslog
+
+import "github.com/tarkov-database/rest-api/core/config"
+
+var cfg = &config.Default().AccessLog
+
+// Configure applies the configuration to the access log
+func Configure(c config.AccessLog) {
+	cfg = &c
+}
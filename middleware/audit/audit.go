@@ -0,0 +1,140 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/internal/httputil"
+	"github.com/tarkov-database/rest-api/middleware/accesslog"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/middleware/ratelimit"
+	"github.com/tarkov-database/rest-api/middleware/requestid"
+	"github.com/tarkov-database/rest-api/model/audit"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// HeaderRequestID holds the ID of a request
+const HeaderRequestID = requestid.Header
+
+var (
+	queue chan *audit.Entry
+
+	// store persists an entry
+	store = audit.Create
+)
+
+// Init starts the writer of the audit log
+func Init() {
+	if !cfg.Enabled {
+		return
+	}
+
+	queue = make(chan *audit.Entry, cfg.QueueSize)
+
+	go writer()
+}
+
+func writer() {
+	for e := range queue {
+		write(e)
+	}
+}
+
+// write persists an entry. Entries are written independently of the request,
+// so that a disconnecting client does not prevent its entry.
+func write(e *audit.Entry) {
+	if err := store(context.Background(), e); err != nil {
+		logger.Errorf("Error while recording %s %s of request %s: %s", e.Method, e.Route, e.RequestID, err)
+	}
+}
+
+// record queues an entry. If the queue is full, the entry is written
+// directly, so that no entry gets lost.
+func record(e *audit.Entry) {
+	select {
+	case queue <- e:
+	default:
+		write(e)
+	}
+}
+
+type contextKey struct{}
+
+func fromContext(ctx context.Context) (*audit.Entry, bool) {
+	e, ok := ctx.Value(contextKey{}).(*audit.Entry)
+	return e, ok
+}
+
+// SetActor records the subject and scope of the claims as the client of the
+// request, also for the access log. It is used by handlers that authenticate
+// the client themselves.
+func SetActor(r *http.Request, clm *jwt.Claims) {
+	accesslog.SetSubject(r, clm.Subject)
+
+	if e, ok := fromContext(r.Context()); ok {
+		e.Subject, e.Scope = clm.Subject, clm.Scope
+	}
+}
+
+// SetResource records the ID of the resource affected by the request
+func SetResource(r *http.Request, id string) {
+	if e, ok := fromContext(r.Context()); ok {
+		e.Resource = id
+	}
+}
+
+// Actor returns a handler recording the client of an authorized request.
+// It has to be wrapped by the authorization handler.
+func Actor(h httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		if clm, ok := jwt.FromContext(r.Context()); ok {
+			SetActor(r, clm)
+		}
+
+		h(w, r, ps)
+	}
+}
+
+// Handler returns a handler recording the requests of the route in the
+// audit log, including those rejected by inner handlers
+func Handler(route string, h httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		if queue == nil {
+			h(w, r, ps)
+			return
+		}
+
+		r, id := requestid.Ensure(w, r)
+
+		e := &audit.Entry{
+			Address:   ratelimit.ClientAddr(r),
+			Method:    r.Method,
+			Route:     route,
+			Resource:  resourceParam(ps),
+			RequestID: id,
+			Time:      time.Now(),
+		}
+
+		rec := httputil.NewRecorder(w)
+
+		h(rec, r.WithContext(context.WithValue(r.Context(), contextKey{}, e)), ps)
+
+		e.Status = rec.Status
+
+		record(e)
+	}
+}
+
+// resourceParam returns the most specific ID of the route parameters
+func resourceParam(ps httprouter.Params) string {
+	for _, name := range []string{"key", "cert"} {
+		if v := ps.ByName(name); v != "" {
+			return v
+		}
+	}
+
+	return ps.ByName("id")
+}
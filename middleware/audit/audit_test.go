@@ -0,0 +1,116 @@
+package audit
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model/audit"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func init() {
+	logger.SetOutput(io.Discard)
+}
+
+// useRecorder replaces the store, where entries are written directly as the
+// queue has no capacity and no writer
+func useRecorder(t *testing.T) *[]*audit.Entry {
+	prevQueue, prevStore := queue, store
+
+	entries := make([]*audit.Entry, 0)
+
+	queue = make(chan *audit.Entry)
+	store = func(_ context.Context, e *audit.Entry) error {
+		entries = append(entries, e)
+		return nil
+	}
+
+	t.Cleanup(func() { queue, store = prevQueue, prevStore })
+
+	return &entries
+}
+
+func TestHandler(t *testing.T) {
+	entries := useRecorder(t)
+
+	clm := &jwt.Claims{Scope: []string{jwt.ScopeItemWrite}}
+	clm.Subject = "5c9f4e7c9e5e3b0001e5c1a1"
+
+	// Claims are set by the authorization handler within the recorded handler
+	authorize := func(h httprouter.Handle) httprouter.Handle {
+		return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+			h(w, r.WithContext(jwt.NewContext(r.Context(), clm)), ps)
+		}
+	}
+
+	h := Handler("/v2/item/:kind", authorize(Actor(func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+		SetResource(r, "5c9f4e7c9e5e3b0001e5c1b2")
+		w.WriteHeader(http.StatusCreated)
+	})))
+
+	req := httptest.NewRequest("POST", "http://example.com/v2/item/ammunition", nil)
+	req.RemoteAddr = "192.0.2.1:1234"
+	req.Header.Set(HeaderRequestID, "request-1")
+
+	w := httptest.NewRecorder()
+
+	h(w, req, httprouter.Params{{Key: "kind", Value: "ammunition"}})
+
+	if len(*entries) != 1 {
+		t.Fatalf("Recording request failed: %d entries recorded", len(*entries))
+	}
+
+	e := (*entries)[0]
+
+	if e.Subject != clm.Subject || len(e.Scope) != 1 || e.Scope[0] != jwt.ScopeItemWrite {
+		t.Errorf("Recording request failed: unexpected actor %s %v", e.Subject, e.Scope)
+	}
+
+	if e.Method != "POST" || e.Route != "/v2/item/:kind" || e.Address != "192.0.2.1" {
+		t.Errorf("Recording request failed: unexpected request %s %s from %s", e.Method, e.Route, e.Address)
+	}
+
+	if e.Resource != "5c9f4e7c9e5e3b0001e5c1b2" || e.Status != http.StatusCreated {
+		t.Errorf("Recording request failed: unexpected outcome %s %d", e.Resource, e.Status)
+	}
+
+	if e.RequestID != "request-1" || w.Header().Get(HeaderRequestID) != "request-1" {
+		t.Errorf("Recording request failed: unexpected request ID %s", e.RequestID)
+	}
+}
+
+func TestHandlerRejected(t *testing.T) {
+	entries := useRecorder(t)
+
+	h := Handler("/v2/user/:id", func(w http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest("DELETE", "http://example.com/v2/user/5c9f4e7c9e5e3b0001e5c1a1", nil)
+	req.Header.Set(HeaderRequestID, "invalid request id")
+
+	w := httptest.NewRecorder()
+
+	h(w, req, httprouter.Params{{Key: "id", Value: "5c9f4e7c9e5e3b0001e5c1a1"}})
+
+	if len(*entries) != 1 {
+		t.Fatalf("Recording request failed: %d entries recorded", len(*entries))
+	}
+
+	e := (*entries)[0]
+
+	if e.Subject != "" || e.Resource != "5c9f4e7c9e5e3b0001e5c1a1" || e.Status != http.StatusUnauthorized {
+		t.Errorf("Recording request failed: unexpected entry %+v", e)
+	}
+
+	if len(e.RequestID) != 32 || e.RequestID != w.Header().Get(HeaderRequestID) {
+		t.Errorf("Recording request failed: unexpected request ID %s", e.RequestID)
+	}
+}
@@ -0,0 +1,11 @@
+package audit
+
+import "github.com/tarkov-database/rest-api/core/config"
+
+var cfg = &config.Default().Audit
+
+// Configure applies the configuration to the audit log. It has to be called
+// before Init.
+func Configure(c config.Audit) {
+	cfg = &c
+}
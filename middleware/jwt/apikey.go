@@ -0,0 +1,49 @@
+package jwt
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+var (
+	// ErrInvalidAPIKey indicates that an API key is unknown, expired or of a locked user
+	ErrInvalidAPIKey = errors.New("invalid api key")
+)
+
+// HeaderAPIKey is the request header holding an API key
+const HeaderAPIKey = "X-API-Key"
+
+// APIKeyFunc authenticates an API key and returns the claims it grants
+type APIKeyFunc func(ctx context.Context, key string) (*Claims, error)
+
+var apiKeyFunc APIKeyFunc = func(context.Context, string) (*Claims, error) { return nil, ErrInvalidAPIKey }
+
+// SetAPIKeyFunc sets the function used to authenticate API keys
+func SetAPIKeyFunc(f APIKeyFunc) {
+	apiKeyFunc = f
+}
+
+// AuthenticateAPIKey authenticates an API key and returns the claims it grants
+func AuthenticateAPIKey(ctx context.Context, key string) (*Claims, error) {
+	return apiKeyFunc(ctx, key)
+}
+
+// AuthenticateRequest authenticates the API key or bearer token of a request.
+// Without both, a verified client certificate is authenticated instead.
+func AuthenticateRequest(r *http.Request) (*Claims, error) {
+	if key := r.Header.Get(HeaderAPIKey); len(key) > 0 {
+		return AuthenticateAPIKey(r.Context(), key)
+	}
+
+	if cert, ok := clientCert(r); ok && r.Header.Get("Authorization") == "" {
+		return clientCertFunc(r.Context(), cert)
+	}
+
+	token, err := ExtractToken(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return Authenticate(r.Context(), token)
+}
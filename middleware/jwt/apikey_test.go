@@ -0,0 +1,51 @@
+package jwt
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestAPIKeyHeader(t *testing.T) {
+	defer SetAPIKeyFunc(func(context.Context, string) (*Claims, error) { return nil, ErrInvalidAPIKey })
+
+	SetAPIKeyFunc(func(_ context.Context, key string) (*Claims, error) {
+		if key != "valid" {
+			return nil, ErrInvalidAPIKey
+		}
+		return &Claims{Scope: []string{ScopeUserRead}}, nil
+	})
+
+	tests := []struct {
+		name         string
+		key          string
+		expectedCode int
+	}{
+		{name: "valid key", key: "valid", expectedCode: http.StatusOK},
+		{name: "invalid key", key: "invalid", expectedCode: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Add(HeaderAPIKey, tt.key)
+
+			handle := AuhtorizationHandler(ScopeUserRead, func(w http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			handle(w, &http.Request{Header: header}, httprouter.Params{})
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.expectedCode {
+				t.Fatalf("Authorization handler failed: unexpected response code %v", resp.StatusCode)
+			}
+		})
+	}
+}
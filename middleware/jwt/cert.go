@@ -7,7 +7,6 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
-	"log"
 	"os"
 	"sync"
 
@@ -19,33 +18,57 @@ var store = certStore{
 	certs: make(map[string]*x509.Certificate),
 }
 
-func init() {
-	if path := os.Getenv("JWT_ROOT_CERTS"); path != "" {
+// parseRootCerts returns a pool of the CA certificates of the PEM file at
+// path. Without a path, the pool is empty.
+func parseRootCerts(path string) (*x509.CertPool, int, error) {
+	roots, count := x509.NewCertPool(), 0
+
+	if path != "" {
 		certs, err := parseCertsFromPEM(path)
 		if err != nil {
-			log.Printf("failed to parse root certificates: %v", err)
-			os.Exit(2)
-		}
-
-		if len(certs) == 0 {
-			log.Printf("no root certificates")
-			os.Exit(2)
+			return nil, 0, fmt.Errorf("failed to parse root certificates: %w", err)
 		}
 
 		for _, cert := range certs {
 			if cert.IsCA {
-				store.roots.AddCert(cert)
+				roots.AddCert(cert)
+				count++
 			}
 		}
+
+		if count == 0 {
+			return nil, 0, errors.New("no root certificates")
+		}
 	}
+
+	return roots, count, nil
 }
 
 type certStore struct {
 	roots *x509.CertPool
+	count int
 	certs map[string]*x509.Certificate
 	sync.RWMutex
 }
 
+// setRoots replaces the root certificates. The verified certificates are
+// dropped, as they might not be trusted anymore.
+func (s *certStore) setRoots(roots *x509.CertPool, count int) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.roots, s.count = roots, count
+	s.certs = make(map[string]*x509.Certificate)
+}
+
+// rootPool returns the current root certificates
+func (s *certStore) rootPool() *x509.CertPool {
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.roots
+}
+
 func (s *certStore) get(fingerprint string) (*x509.Certificate, bool) {
 	s.RLock()
 	defer s.RUnlock()
@@ -69,6 +92,15 @@ func (s *certStore) remove(fingerprint string) {
 	delete(s.certs, fingerprint)
 }
 
+// CertificateCount returns the number of root certificates and the number of
+// verified certificates kept in the store
+func CertificateCount() (roots, certs int) {
+	store.RLock()
+	defer store.RUnlock()
+
+	return store.count, len(store.certs)
+}
+
 func parseCertsFromPEM(path string) ([]*x509.Certificate, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -0,0 +1,46 @@
+package jwt
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"net/http"
+)
+
+var (
+	// ErrInvalidClientCert indicates that a client certificate is not mapped or of a locked user
+	ErrInvalidClientCert = errors.New("invalid client certificate")
+)
+
+// ClientCertFunc authenticates a verified client certificate and returns the claims it grants
+type ClientCertFunc func(ctx context.Context, cert *x509.Certificate) (*Claims, error)
+
+var clientCertFunc ClientCertFunc = func(context.Context, *x509.Certificate) (*Claims, error) { return nil, ErrInvalidClientCert }
+
+// SetClientCertFunc sets the function used to authenticate client certificates
+func SetClientCertFunc(f ClientCertFunc) {
+	clientCertFunc = f
+}
+
+// ClientCAs returns the root certificates used to verify client certificates,
+// which are the ones of JWT_ROOT_CERTS, or nil if there are none. The pool is
+// replaced when the root certificates are reloaded.
+func ClientCAs() *x509.CertPool {
+	store.RLock()
+	defer store.RUnlock()
+
+	if store.count == 0 {
+		return nil
+	}
+
+	return store.roots
+}
+
+// clientCert returns the leaf of the verified certificate chain of a request
+func clientCert(r *http.Request) (*x509.Certificate, bool) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+
+	return r.TLS.VerifiedChains[0][0], true
+}
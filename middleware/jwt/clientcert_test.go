@@ -0,0 +1,62 @@
+package jwt
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestClientCert(t *testing.T) {
+	defer SetClientCertFunc(func(context.Context, *x509.Certificate) (*Claims, error) { return nil, ErrInvalidClientCert })
+
+	SetClientCertFunc(func(_ context.Context, cert *x509.Certificate) (*Claims, error) {
+		if cert.Subject.CommonName != "ingest" {
+			return nil, ErrInvalidClientCert
+		}
+		return &Claims{Scope: []string{ScopeItemWrite}}, nil
+	})
+
+	chain := func(cn string) *tls.ConnectionState {
+		cert := &x509.Certificate{Subject: pkix.Name{CommonName: cn}}
+		return &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+	}
+
+	tests := []struct {
+		name         string
+		tls          *tls.ConnectionState
+		header       string
+		expectedCode int
+	}{
+		{name: "mapped certificate", tls: chain("ingest"), expectedCode: http.StatusOK},
+		{name: "unmapped certificate", tls: chain("unknown"), expectedCode: http.StatusUnauthorized},
+		{name: "unverified certificate", tls: &tls.ConnectionState{}, expectedCode: http.StatusUnauthorized},
+		{name: "certificate and token", tls: chain("ingest"), header: "Bearer invalid", expectedCode: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handle := AuhtorizationHandler(ScopeItemWrite, func(w http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest("POST", "https://example.com/v2/item/ammunition", nil)
+			req.TLS = tt.tls
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+
+			w := httptest.NewRecorder()
+			handle(w, req, httprouter.Params{})
+
+			if w.Code != tt.expectedCode {
+				t.Fatalf("Authorization handler failed: unexpected response code %v", w.Code)
+			}
+		})
+	}
+}
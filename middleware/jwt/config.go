@@ -1,47 +1,101 @@
 package jwt
 
 import (
+	"crypto"
 	"errors"
 	"fmt"
-	"log"
-	"os"
 	"strings"
+	"sync/atomic"
 	"time"
 
+	"github.com/tarkov-database/rest-api/core/config"
+	"github.com/tarkov-database/rest-api/core/logger"
+
 	"github.com/golang-jwt/jwt/v5"
 )
 
-var cfg *config
+// cfg has no key until Configure is called.
+// It is replaced as a whole, so that keys can be reloaded while in use.
+var cfg atomic.Pointer[settings]
 
 func init() {
-	var err error
-
-	cfg, err = newConfig()
-	if err != nil {
-		log.Printf("Configuration error: %s\n", err)
-		os.Exit(2)
-	}
+	s, _ := newConfig(config.Default().JWT)
+	cfg.Store(s)
 }
 
-type config struct {
+type settings struct {
 	SigningAlgorithm jwt.SigningMethod
-	SigningKey       []byte
+	SigningKey       interface{}
+	KeyID            string
+	VerificationKeys []*verificationKey
 	Audience         string
 	ExpirationTime   time.Duration
+	RefreshTime      time.Duration
 	Leeway           time.Duration
 }
 
-func newConfig() (*config, error) {
-	c := &config{}
+// Configure applies the configuration to the signing and verification of
+// tokens and loads the root certificates. It can be called again to reload
+// the key and certificate files, which are only replaced if all are valid.
+func Configure(c config.JWT) error {
+	s, err := newConfig(c)
+	if err != nil {
+		return err
+	}
+
+	roots, count, err := parseRootCerts(c.RootCerts)
+	if err != nil {
+		return err
+	}
+
+	store.setRoots(roots, count)
+	cfg.Store(s)
+
+	return nil
+}
+
+func newConfig(s config.JWT) (*settings, error) {
+	c := &settings{
+		Audience:       s.Audience,
+		ExpirationTime: s.Expiration.Duration,
+		RefreshTime:    s.RefreshExpiration.Duration,
+		Leeway:         s.Leeway.Duration,
+	}
+
+	alg := s.Algorithm
+
+	if path := s.KeyFile; len(path) > 0 {
+		key, err := parseKeyFile(path)
+		if err != nil {
+			return c, fmt.Errorf("jwt key file is not valid: %s", err)
+		}
+
+		vk, err := newVerificationKey(key, alg)
+		if err != nil {
+			return c, fmt.Errorf("jwt key is not valid: %s", err)
+		}
 
-	if key := os.Getenv("JWT_KEY"); len(key) > 0 {
-		switch strings.ToLower(os.Getenv("JWT_ALG")) {
+		if _, ok := key.(crypto.Signer); !ok {
+			return c, errors.New("jwt key file does not contain a private key")
+		}
+
+		if c.SigningAlgorithm, err = signingMethod(alg, vk.Key); err != nil {
+			return c, err
+		}
+
+		c.SigningKey = key
+		c.KeyID = vk.ID
+		c.VerificationKeys = append(c.VerificationKeys, vk)
+	} else if key := s.Key; len(key) > 0 {
+		switch strings.ToLower(alg) {
 		case "hs256":
 			c.SigningAlgorithm = jwt.SigningMethodHS256
+		case "hs384":
+			c.SigningAlgorithm = jwt.SigningMethodHS384
 		case "hs512":
 			c.SigningAlgorithm = jwt.SigningMethodHS512
 		default:
-			log.Println("No valid JWT algorithm set, using HS256")
+			logger.Warning("No valid JWT algorithm set, using HS256")
 			c.SigningAlgorithm = jwt.SigningMethodHS256
 		}
 		c.SigningKey = []byte(key)
@@ -49,31 +103,25 @@ func newConfig() (*config, error) {
 		return c, errors.New("jwt key is not set")
 	}
 
-	if env := os.Getenv("JWT_AUDIENCE"); len(env) >= 3 {
-		c.Audience = env
-	} else {
-		return c, errors.New("jwt audience is not set or too short")
-	}
-
-	if env := os.Getenv("JWT_EXPIRATION"); len(env) > 0 {
-		d, err := time.ParseDuration(env)
+	// Previous keys remain valid for verification during a key rotation
+	for _, path := range s.VerifyKeys {
+		key, err := parseKeyFile(path)
 		if err != nil {
-			return c, fmt.Errorf("jwt expiration value is not valid: %s", err)
+			return c, fmt.Errorf("jwt verification key %s is not valid: %s", path, err)
 		}
-		c.ExpirationTime = d
-	} else {
-		c.ExpirationTime = 30 * time.Minute
-	}
 
-	if env := os.Getenv("JWT_LEEWAY"); len(env) > 0 {
-		d, err := time.ParseDuration(env)
+		vk, err := newVerificationKey(key, alg)
 		if err != nil {
-			return c, fmt.Errorf("jwt leeway value is not valid: %s", err)
+			return c, fmt.Errorf("jwt verification key %s is not valid: %s", path, err)
 		}
-		c.Leeway = d
-	} else {
-		c.Leeway = 30 * time.Second
+
+		c.VerificationKeys = append(c.VerificationKeys, vk)
 	}
 
 	return c, nil
 }
+
+// RefreshExpirationTime returns the lifetime of refresh tokens
+func RefreshExpirationTime() time.Duration {
+	return cfg.Load().RefreshTime
+}
@@ -0,0 +1,16 @@
+package jwt
+
+import "context"
+
+type contextKey struct{}
+
+// NewContext returns a new context that carries the given claims
+func NewContext(ctx context.Context, c *Claims) context.Context {
+	return context.WithValue(ctx, contextKey{}, c)
+}
+
+// FromContext returns the claims stored in the context, if any
+func FromContext(ctx context.Context) (*Claims, bool) {
+	c, ok := ctx.Value(contextKey{}).(*Claims)
+	return c, ok
+}
@@ -13,8 +13,15 @@ import (
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/julienschmidt/httprouter"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
+const instrumentation = "github.com/tarkov-database/rest-api/middleware/jwt"
+
 var (
 	// ErrInvalidScope indicates that a scope value is not valid
 	ErrInvalidScope = errors.New("no or invalid scopes")
@@ -85,42 +92,19 @@ const (
 
 	// ScopeTokenWrite represents the token write permission scope
 	ScopeTokenWrite = "write:token"
-)
 
-func isScopeValid(s string) bool {
-	var valid bool
-
-	switch s {
-	case ScopeAllRead:
-		valid = true
-	case ScopeAllWrite:
-		valid = true
-	case ScopeItemRead:
-		valid = true
-	case ScopeItemWrite:
-		valid = true
-	case ScopeHideoutRead:
-		valid = true
-	case ScopeHideoutWrite:
-		valid = true
-	case ScopeLocationRead:
-		valid = true
-	case ScopeLocationWrite:
-		valid = true
-	case ScopeStatisticRead:
-		valid = true
-	case ScopeStatisticWrite:
-		valid = true
-	case ScopeUserRead:
-		valid = true
-	case ScopeUserWrite:
-		valid = true
-	case ScopeTokenWrite:
-		valid = true
-	}
+	// ScopeWebhookRead represents the webhook read permission scope
+	ScopeWebhookRead = "read:webhook"
 
-	return valid
-}
+	// ScopeWebhookWrite represents the webhook write permission scope
+	ScopeWebhookWrite = "write:webhook"
+
+	// ScopeAuditRead represents the audit log read permission scope
+	ScopeAuditRead = "read:audit"
+
+	// ScopeDatabaseRead represents the database read permission scope
+	ScopeDatabaseRead = "read:database"
+)
 
 // Claims represents the claims of a token
 type Claims struct {
@@ -139,10 +123,48 @@ func (c *Claims) Validate() error {
 	return nil
 }
 
+// HasScope checks if the claims grant the given scope directly, through a
+// wildcard or through the corresponding global scope
+func (c *Claims) HasScope(scope string) bool {
+	if scope == "" {
+		return true
+	}
+
+	for _, s := range c.Scope {
+		if scopeMatches(s, scope) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Covers checks if the claims include every permission of the given scope,
+// so that it can be granted to someone else
+func (c *Claims) Covers(scope string) bool {
+	for _, s := range c.Scope {
+		if scopeCovers(s, scope) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // SignToken signs a token
 func SignToken(c *Claims, d *time.Duration) (string, error) {
 	now := time.Now()
 
+	if c.ID == "" {
+		id, err := newTokenID()
+		if err != nil {
+			return "", err
+		}
+		c.ID = id
+	}
+
+	cfg := cfg.Load()
+
 	c.Audience = append(c.Audience, cfg.Audience)
 	c.IssuedAt = jwt.NewNumericDate(now)
 
@@ -153,6 +175,10 @@ func SignToken(c *Claims, d *time.Duration) (string, error) {
 	}
 
 	token := jwt.NewWithClaims(cfg.SigningAlgorithm, c)
+	if cfg.KeyID != "" {
+		token.Header["kid"] = cfg.KeyID
+	}
+
 	s, err := token.SignedString(cfg.SigningKey)
 	if err != nil {
 		return "", err
@@ -179,6 +205,7 @@ func ExtractToken(r *http.Request) (string, error) {
 // VerifyToken verifies a token
 func VerifyToken(tokenStr string) (*Claims, error) {
 	claims := &Claims{}
+	cfg := cfg.Load()
 
 	leeway := jwt.WithLeeway(cfg.Leeway)
 	audience := jwt.WithAudience(cfg.Audience)
@@ -218,11 +245,25 @@ func keyFunc(token *jwt.Token) (interface{}, error) {
 	case jwt.SigningMethodEdDSA.Alg():
 	// HMAC algorithms
 	case jwt.SigningMethodHS256.Alg(), jwt.SigningMethodHS384.Alg(), jwt.SigningMethodHS512.Alg():
-		return cfg.SigningKey, nil
+		if key, ok := cfg.Load().SigningKey.([]byte); ok {
+			return key, nil
+		}
+		return nil, fmt.Errorf("unsupported signing algorithm: %s", alg)
 	default:
 		return nil, fmt.Errorf("unsupported signing algorithm: %s", alg)
 	}
 
+	// Tokens of own keys are selected by their key ID
+	if kid, ok := token.Header["kid"].(string); ok {
+		if key, ok := getVerificationKey(kid); ok {
+			if key.Algorithm != token.Method.Alg() {
+				return nil, fmt.Errorf("signing algorithm %s does not match key", token.Method.Alg())
+			}
+
+			return key.Key, nil
+		}
+	}
+
 	fingerprint, ok := token.Header["x5t#S256"].(string)
 	if !ok {
 		return nil, errors.New("invalid fingerprint")
@@ -247,7 +288,7 @@ func keyFunc(token *jwt.Token) (interface{}, error) {
 			intermediates = certs[1:]
 		}
 
-		if err := verifyCert(leaf, intermediates, store.roots); err != nil {
+		if err := verifyCert(leaf, intermediates, store.rootPool()); err != nil {
 			return nil, fmt.Errorf("failed to verify certificate: %w", err)
 		}
 
@@ -258,47 +299,53 @@ func keyFunc(token *jwt.Token) (interface{}, error) {
 	return cert.PublicKey, nil
 }
 
-// AuhtorizationHandler returns a JWT authorization handler
+// AuhtorizationHandler returns a JWT authorization handler. Segments of the
+// scope in the form "{name}" are replaced by the route parameter of that name.
 func AuhtorizationHandler(scope string, h httprouter.Handle) httprouter.Handle {
 	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-		token, err := ExtractToken(r)
-		if err != nil {
-			AddAuthenticateHeader(w, err, scope)
-			statusHandler(err.Error(), http.StatusUnauthorized, w)
-			return
-		}
+		scope := resolveScope(scope, ps)
 
 		var allScope string
 		if scope != "" {
 			allScope = fmt.Sprintf("%s:all", strings.SplitN(scope, ":", 2)[0])
 		}
 
-		claims, err := VerifyToken(token)
+		ctx, span := otel.Tracer(instrumentation).Start(r.Context(), "authorize",
+			trace.WithAttributes(attribute.String("auth.scope", scope)))
+
+		claims, err := AuthenticateRequest(r.WithContext(ctx))
+
+		outcome := outcomeOf(err)
+		if err == nil && !claims.HasScope(scope) {
+			outcome = OutcomeInsufficientScope
+		}
+		observeOutcome(outcome)
+
+		span.SetAttributes(attribute.String("auth.outcome", outcome))
+		if err == nil {
+			span.SetAttributes(semconv.EnduserID(claims.Subject))
+		} else if outcome == OutcomeError {
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+		if errors.Is(err, model.ErrInternalError) {
+			statusHandler("Backend error", http.StatusInternalServerError, w)
+			return
+		}
 		if err != nil {
 			AddAuthenticateHeader(w, err, scope, allScope)
 			statusHandler(err.Error(), http.StatusUnauthorized, w)
 			return
 		}
 
-		var ok bool
-		if scope == "" {
-			ok = true
-		} else {
-			for _, s := range claims.Scope {
-				if s == scope || s == allScope {
-					ok = true
-					break
-				}
-			}
-		}
-
-		if !ok {
+		if !claims.HasScope(scope) {
 			AddAuthenticateHeader(w, ErrInvalidScope, scope, allScope)
 			statusHandler("Insufficient permissions", http.StatusForbidden, w)
 			return
 		}
 
-		h(w, r, ps)
+		h(w, r.WithContext(NewContext(r.Context(), claims)), ps)
 	}
 }
 
@@ -312,7 +359,7 @@ func AddAuthenticateHeader(w http.ResponseWriter, err error, scopes ...string) {
 	value := fmt.Sprintf("Bearer scope=\"%s\"", strings.Join(scopes, " "))
 
 	switch err {
-	case ErrExpiredToken, ErrNotBefore, ErrInvalidAudience, ErrInvalidSubject, ErrMalformed, ErrInvalidToken:
+	case ErrExpiredToken, ErrNotBefore, ErrInvalidAudience, ErrInvalidSubject, ErrMalformed, ErrInvalidToken, ErrRevokedToken, ErrInvalidAPIKey, ErrInvalidClientCert:
 		value += fmt.Sprintf(", error=\"%s\"", authenticateInvalid)
 	case ErrInvalidScope:
 		value += fmt.Sprintf(", error=\"%s\"", authenticateInsufficient)
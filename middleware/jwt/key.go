@@ -0,0 +1,343 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	// ErrUnsupportedKey indicates that the type of a key is not supported
+	ErrUnsupportedKey = errors.New("unsupported key type")
+)
+
+// JWK represents a public JSON Web Key as defined in RFC 7517
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use,omitempty"`
+	KeyID     string `json:"kid,omitempty"`
+	Algorithm string `json:"alg,omitempty"`
+
+	// RSA parameters
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+
+	// EC and OKP parameters
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+	Y     string `json:"y,omitempty"`
+}
+
+// JWKS represents a JSON Web Key Set
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// verificationKey is a public key used to verify tokens with the matching key ID
+type verificationKey struct {
+	ID        string
+	Algorithm string
+	Key       crypto.PublicKey
+}
+
+// KeySet returns the public keys of all active signing and verification keys
+func KeySet() *JWKS {
+	keys := cfg.Load().VerificationKeys
+	set := &JWKS{Keys: make([]JWK, 0, len(keys))}
+
+	for _, k := range keys {
+		jwk, err := newJWK(k.Key)
+		if err != nil {
+			continue
+		}
+
+		jwk.Use, jwk.KeyID, jwk.Algorithm = "sig", k.ID, k.Algorithm
+
+		set.Keys = append(set.Keys, *jwk)
+	}
+
+	return set
+}
+
+func newJWK(key crypto.PublicKey) (*JWK, error) {
+	enc := base64.RawURLEncoding
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return &JWK{
+			KeyType: "RSA",
+			N:       enc.EncodeToString(k.N.Bytes()),
+			E:       enc.EncodeToString(big.NewInt(int64(k.E)).Bytes()),
+		}, nil
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8
+		return &JWK{
+			KeyType: "EC",
+			Curve:   k.Curve.Params().Name,
+			X:       enc.EncodeToString(k.X.FillBytes(make([]byte, size))),
+			Y:       enc.EncodeToString(k.Y.FillBytes(make([]byte, size))),
+		}, nil
+	case ed25519.PublicKey:
+		return &JWK{
+			KeyType: "OKP",
+			Curve:   "Ed25519",
+			X:       enc.EncodeToString(k),
+		}, nil
+	default:
+		return nil, ErrUnsupportedKey
+	}
+}
+
+// PublicKey returns the public key described by the JWK
+func (j *JWK) PublicKey() (crypto.PublicKey, error) {
+	dec := base64.RawURLEncoding
+
+	switch j.KeyType {
+	case "RSA":
+		n, err := dec.DecodeString(j.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %w", err)
+		}
+
+		e, err := dec.DecodeString(j.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent: %w", err)
+		}
+
+		exp := new(big.Int).SetBytes(e)
+		if !exp.IsInt64() || exp.Int64() < 3 || exp.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid exponent")
+		}
+
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exp.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch j.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, ErrUnsupportedKey
+		}
+
+		x, err := dec.DecodeString(j.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x coordinate: %w", err)
+		}
+
+		y, err := dec.DecodeString(j.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y coordinate: %w", err)
+		}
+
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, errors.New("point is not on curve")
+		}
+
+		return key, nil
+	case "OKP":
+		if j.Curve != "Ed25519" {
+			return nil, ErrUnsupportedKey
+		}
+
+		x, err := dec.DecodeString(j.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid public key")
+		}
+
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, ErrUnsupportedKey
+	}
+}
+
+// Thumbprint returns the JWK thumbprint of a public key as defined in RFC 7638
+func Thumbprint(key crypto.PublicKey) (string, error) {
+	jwk, err := newJWK(key)
+	if err != nil {
+		return "", err
+	}
+
+	// The required members in lexicographic order
+	var members interface{}
+	switch jwk.KeyType {
+	case "RSA":
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.KeyType, jwk.N}
+	case "EC":
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		}{jwk.Curve, jwk.KeyType, jwk.X, jwk.Y}
+	case "OKP":
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{jwk.Curve, jwk.KeyType, jwk.X}
+	}
+
+	b, err := json.Marshal(members)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// parseKeyFile parses the first private key, public key or certificate of a PEM file
+func parseKeyFile(path string) (interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, errors.New("no key found")
+		}
+
+		switch block.Type {
+		case "PRIVATE KEY":
+			return x509.ParsePKCS8PrivateKey(block.Bytes)
+		case "RSA PRIVATE KEY":
+			return x509.ParsePKCS1PrivateKey(block.Bytes)
+		case "EC PRIVATE KEY":
+			return x509.ParseECPrivateKey(block.Bytes)
+		case "PUBLIC KEY":
+			return x509.ParsePKIXPublicKey(block.Bytes)
+		case "RSA PUBLIC KEY":
+			return x509.ParsePKCS1PublicKey(block.Bytes)
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, err
+			}
+			return cert.PublicKey, nil
+		}
+	}
+}
+
+// publicKey returns the public key of a private or public key
+func publicKey(key interface{}) (crypto.PublicKey, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return &k.PublicKey, nil
+	case *ecdsa.PrivateKey:
+		return &k.PublicKey, nil
+	case ed25519.PrivateKey:
+		return k.Public(), nil
+	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+		return k, nil
+	default:
+		return nil, ErrUnsupportedKey
+	}
+}
+
+// signingMethod returns the signing method of the algorithm name, or
+// the default method of the key type if the name is empty
+func signingMethod(alg string, key crypto.PublicKey) (jwt.SigningMethod, error) {
+	if alg == "" {
+		switch k := key.(type) {
+		case *rsa.PublicKey:
+			return jwt.SigningMethodRS256, nil
+		case *ecdsa.PublicKey:
+			switch k.Curve {
+			case elliptic.P384():
+				return jwt.SigningMethodES384, nil
+			case elliptic.P521():
+				return jwt.SigningMethodES512, nil
+			default:
+				return jwt.SigningMethodES256, nil
+			}
+		case ed25519.PublicKey:
+			return jwt.SigningMethodEdDSA, nil
+		default:
+			return nil, ErrUnsupportedKey
+		}
+	}
+
+	m := jwt.GetSigningMethod(strings.ToUpper(alg))
+	if strings.EqualFold(alg, jwt.SigningMethodEdDSA.Alg()) {
+		m = jwt.SigningMethodEdDSA
+	}
+	if m == nil {
+		return nil, fmt.Errorf("unsupported signing algorithm: %s", alg)
+	}
+
+	var ok bool
+	switch m.(type) {
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+		_, ok = key.(*rsa.PublicKey)
+	case *jwt.SigningMethodECDSA:
+		_, ok = key.(*ecdsa.PublicKey)
+	case *jwt.SigningMethodEd25519:
+		_, ok = key.(ed25519.PublicKey)
+	}
+	if !ok {
+		return nil, fmt.Errorf("key does not match signing algorithm %s", m.Alg())
+	}
+
+	return m, nil
+}
+
+// newVerificationKey returns the verification key of a private or public key.
+// The algorithm falls back to the default of the key type if it does not match.
+func newVerificationKey(key interface{}, alg string) (*verificationKey, error) {
+	pub, err := publicKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := signingMethod(alg, pub)
+	if err != nil {
+		if m, err = signingMethod("", pub); err != nil {
+			return nil, err
+		}
+	}
+
+	id, err := Thumbprint(pub)
+	if err != nil {
+		return nil, err
+	}
+
+	return &verificationKey{ID: id, Algorithm: m.Alg(), Key: pub}, nil
+}
+
+// getVerificationKey returns the verification key of the key ID
+func getVerificationKey(id string) (*verificationKey, bool) {
+	for _, k := range cfg.Load().VerificationKeys {
+		if k.ID == id {
+			return k, true
+		}
+	}
+
+	return nil, false
+}
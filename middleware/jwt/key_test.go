@@ -0,0 +1,254 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestThumbprint(t *testing.T) {
+	// Example key of RFC 7638, section 3.1
+	n, err := base64.RawURLEncoding.DecodeString("0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw")
+	if err != nil {
+		t.Fatalf("Decoding modulus failed: %v", err)
+	}
+
+	key := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: 65537}
+
+	tp, err := Thumbprint(key)
+	if err != nil {
+		t.Fatalf("Thumbprint failed: %v", err)
+	}
+
+	if expected := "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs"; tp != expected {
+		t.Errorf("Thumbprint failed: expected %s, got %s", expected, tp)
+	}
+}
+
+func TestJWKPublicKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := []crypto.PublicKey{&rsaKey.PublicKey, &ecKey.PublicKey, edKey}
+
+	for _, key := range keys {
+		jwk, err := newJWK(key)
+		if err != nil {
+			t.Fatalf("JWK creation failed: %v", err)
+		}
+
+		pub, err := jwk.PublicKey()
+		if err != nil {
+			t.Fatalf("JWK parsing failed: %v", err)
+		}
+
+		if !pub.(interface{ Equal(crypto.PublicKey) bool }).Equal(key) {
+			t.Errorf("JWK parsing failed: %s key does not match", jwk.KeyType)
+		}
+	}
+
+	invalid := []JWK{
+		{KeyType: "oct"},
+		{KeyType: "EC", Curve: "P-256", X: "AQ", Y: "AQ"},
+		{KeyType: "OKP", Curve: "Ed25519", X: "AQ"},
+		{KeyType: "RSA", N: "AQ", E: "AQ"},
+	}
+
+	for _, jwk := range invalid {
+		if _, err := jwk.PublicKey(); err == nil {
+			t.Errorf("JWK parsing failed: invalid %s key parsed", jwk.KeyType)
+		}
+	}
+}
+
+func writeTestingKey(t *testing.T, key crypto.PrivateKey) string {
+	t.Helper()
+
+	b, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("Marshaling key failed: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "key.pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: b}), 0600); err != nil {
+		t.Fatalf("Writing key failed: %v", err)
+	}
+
+	return path
+}
+
+// useConfig replaces the configuration for the duration of the test
+func useConfig(t *testing.T, keyFile, alg string, verifyKeys ...string) {
+	t.Helper()
+
+	s := testingConfig()
+	s.Key, s.KeyFile, s.Algorithm, s.VerifyKeys = "", keyFile, alg, verifyKeys
+
+	c, err := newConfig(s)
+	if err != nil {
+		t.Fatalf("Configuration failed: %v", err)
+	}
+
+	prev := cfg.Swap(c)
+	t.Cleanup(func() { cfg.Store(prev) })
+}
+
+func TestAsymmetricSigning(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		key  crypto.PrivateKey
+		alg  string
+		kty  string
+	}{
+		{name: "RS256", key: rsaKey, kty: "RSA"},
+		{name: "PS512", key: rsaKey, alg: "ps512", kty: "RSA"},
+		{name: "ES384", key: ecKey, kty: "EC"},
+		{name: "EdDSA", key: edKey, kty: "OKP"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useConfig(t, writeTestingKey(t, tt.key), tt.alg)
+
+			if alg := cfg.Load().SigningAlgorithm.Alg(); alg != tt.name {
+				t.Fatalf("Configuration failed: expected algorithm %s, got %s", tt.name, alg)
+			}
+
+			token, err := SignToken(&Claims{}, nil)
+			if err != nil {
+				t.Fatalf("Token creation failed: %v", err)
+			}
+
+			if _, err := VerifyToken(token); err != nil {
+				t.Fatalf("Token verification failed: %v", err)
+			}
+
+			set := KeySet()
+			if len(set.Keys) != 1 {
+				t.Fatalf("Key set failed: unexpected number of keys %v", len(set.Keys))
+			}
+
+			if k := set.Keys[0]; k.KeyID != cfg.Load().KeyID || k.KeyType != tt.kty || k.Algorithm != tt.name {
+				t.Errorf("Key set failed: unexpected key %+v", k)
+			}
+		})
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	oldKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	oldPath, newPath := writeTestingKey(t, oldKey), writeTestingKey(t, newKey)
+
+	useConfig(t, oldPath, "")
+
+	oldToken, err := SignToken(&Claims{}, nil)
+	if err != nil {
+		t.Fatalf("Token creation failed: %v", err)
+	}
+
+	useConfig(t, newPath, "")
+
+	if _, err := VerifyToken(oldToken); err == nil {
+		t.Error("Token verification failed: token of unknown key verified as valid")
+	}
+
+	useConfig(t, newPath, "", oldPath)
+
+	if _, err := VerifyToken(oldToken); err != nil {
+		t.Errorf("Token verification failed: token of previous key: %v", err)
+	}
+
+	if n := len(KeySet().Keys); n != 2 {
+		t.Errorf("Key set failed: unexpected number of keys %v", n)
+	}
+
+	// HMAC tokens must not be accepted without a shared key
+	hmacToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &Claims{}).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatalf("Token creation failed: %v", err)
+	}
+
+	if _, err := VerifyToken(hmacToken); err == nil {
+		t.Error("Token verification failed: HMAC token verified as valid")
+	}
+}
+
+func TestReconfigure(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	prev := cfg.Load()
+	t.Cleanup(func() {
+		if err := Configure(testingConfig()); err != nil {
+			t.Errorf("Restoring configuration failed: %v", err)
+		}
+		cfg.Store(prev)
+	})
+
+	c := testingConfig()
+	c.Key, c.KeyFile = "", writeTestingKey(t, key)
+
+	if err := Configure(c); err != nil {
+		t.Fatalf("Configuration failed: %v", err)
+	}
+
+	id := cfg.Load().KeyID
+
+	c.RootCerts = filepath.Join(t.TempDir(), "missing.crt")
+
+	if err := Configure(c); err == nil {
+		t.Fatal("Configuration with missing root certificates succeeded")
+	}
+
+	if cfg.Load().KeyID != id {
+		t.Error("Failed configuration replaced the key")
+	}
+	if roots, _ := CertificateCount(); roots == 0 {
+		t.Error("Failed configuration replaced the root certificates")
+	}
+}
@@ -0,0 +1,66 @@
+package jwt
+
+import (
+	"errors"
+
+	"github.com/tarkov-database/rest-api/model"
+)
+
+// Outcomes of a request authorization
+const (
+	OutcomeGranted           = "granted"
+	OutcomeMissing           = "missing"
+	OutcomeExpired           = "expired"
+	OutcomeNotBefore         = "not_before"
+	OutcomeInvalidAudience   = "invalid_audience"
+	OutcomeInvalidSubject    = "invalid_subject"
+	OutcomeMalformed         = "malformed"
+	OutcomeRevoked           = "revoked"
+	OutcomeInvalidAPIKey     = "invalid_api_key"
+	OutcomeInvalidClientCert = "invalid_client_cert"
+	OutcomeInvalid           = "invalid"
+	OutcomeInsufficientScope = "insufficient_scope"
+	OutcomeError             = "error"
+)
+
+// OutcomeFunc observes the outcome of a request authorization
+type OutcomeFunc func(outcome string)
+
+var observeOutcome OutcomeFunc = func(string) {}
+
+// SetOutcomeFunc sets the function observing the outcomes of request authorizations
+func SetOutcomeFunc(f OutcomeFunc) {
+	observeOutcome = f
+}
+
+// outcomeOf returns the authorization outcome of an authentication error
+func outcomeOf(err error) string {
+	switch {
+	case err == nil:
+		return OutcomeGranted
+	case errors.Is(err, model.ErrInternalError):
+		return OutcomeError
+	case errors.Is(err, ErrNoAuthHeader):
+		return OutcomeMissing
+	case errors.Is(err, ErrExpiredToken):
+		return OutcomeExpired
+	case errors.Is(err, ErrNotBefore):
+		return OutcomeNotBefore
+	case errors.Is(err, ErrInvalidAudience):
+		return OutcomeInvalidAudience
+	case errors.Is(err, ErrInvalidSubject):
+		return OutcomeInvalidSubject
+	case errors.Is(err, ErrMalformed), errors.Is(err, ErrInvalidAuthHeader):
+		return OutcomeMalformed
+	case errors.Is(err, ErrRevokedToken):
+		return OutcomeRevoked
+	case errors.Is(err, ErrInvalidAPIKey):
+		return OutcomeInvalidAPIKey
+	case errors.Is(err, ErrInvalidClientCert):
+		return OutcomeInvalidClientCert
+	case errors.Is(err, ErrInvalidScope):
+		return OutcomeInsufficientScope
+	default:
+		return OutcomeInvalid
+	}
+}
@@ -0,0 +1,63 @@
+package jwt
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestOutcome(t *testing.T) {
+	defer SetOutcomeFunc(func(string) {})
+
+	var outcome string
+	SetOutcomeFunc(func(o string) { outcome = o })
+
+	valid, err := SignToken(&Claims{Scope: []string{ScopeUserRead}}, nil)
+	if err != nil {
+		t.Fatalf("Token creation failed: %v", err)
+	}
+
+	d := -time.Minute
+	expired, err := SignToken(&Claims{Scope: []string{ScopeUserRead}}, &d)
+	if err != nil {
+		t.Fatalf("Token creation failed: %v", err)
+	}
+
+	unscoped, err := SignToken(&Claims{Scope: []string{ScopeItemRead}}, nil)
+	if err != nil {
+		t.Fatalf("Token creation failed: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{name: "valid token", header: "Bearer " + valid, expected: OutcomeGranted},
+		{name: "expired token", header: "Bearer " + expired, expected: OutcomeExpired},
+		{name: "insufficient scope", header: "Bearer " + unscoped, expected: OutcomeInsufficientScope},
+		{name: "malformed token", header: "Bearer invalid", expected: OutcomeMalformed},
+		{name: "missing token", expected: OutcomeMissing},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outcome = ""
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+
+			h := AuhtorizationHandler(ScopeUserRead, func(http.ResponseWriter, *http.Request, httprouter.Params) {})
+			h(httptest.NewRecorder(), req, nil)
+
+			if outcome != tt.expected {
+				t.Errorf("Outcome is %q, expected %q", outcome, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package jwt
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrRevokedToken indicates that the token has been revoked
+	ErrRevokedToken = errors.New("token is revoked")
+)
+
+// RevocationFunc reports whether the token of the claims has been revoked
+type RevocationFunc func(ctx context.Context, c *Claims) (bool, error)
+
+var isRevoked RevocationFunc = func(context.Context, *Claims) (bool, error) { return false, nil }
+
+// SetRevocationFunc sets the function used to check tokens against the revocation list
+func SetRevocationFunc(f RevocationFunc) {
+	isRevoked = f
+}
+
+// CheckRevocation returns ErrRevokedToken if the token of the claims has been revoked
+func CheckRevocation(ctx context.Context, c *Claims) error {
+	revoked, err := isRevoked(ctx, c)
+	if err != nil {
+		return fmt.Errorf("revocation check failed: %w", err)
+	}
+
+	if revoked {
+		return ErrRevokedToken
+	}
+
+	return nil
+}
+
+// Authenticate verifies a token and checks it against the revocation list
+func Authenticate(ctx context.Context, tokenStr string) (*Claims, error) {
+	claims, err := VerifyToken(tokenStr)
+	if err != nil {
+		return claims, err
+	}
+
+	if err := CheckRevocation(ctx, claims); err != nil {
+		return claims, err
+	}
+
+	return claims, nil
+}
+
+// newTokenID returns a random token ID
+func newTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
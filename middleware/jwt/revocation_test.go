@@ -0,0 +1,77 @@
+package jwt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestRevocation(t *testing.T) {
+	defer SetRevocationFunc(func(context.Context, *Claims) (bool, error) { return false, nil })
+
+	revoked, err := SignToken(&Claims{Scope: []string{ScopeUserRead}}, nil)
+	if err != nil {
+		t.Fatalf("Token creation failed: %v", err)
+	}
+
+	valid, err := SignToken(&Claims{Scope: []string{ScopeUserRead}}, nil)
+	if err != nil {
+		t.Fatalf("Token creation failed: %v", err)
+	}
+
+	revokedClaims, err := VerifyToken(revoked)
+	if err != nil {
+		t.Fatalf("Token verification failed: %v", err)
+	}
+
+	if revokedClaims.ID == "" {
+		t.Fatal("Token creation failed: token ID is missing")
+	}
+
+	SetRevocationFunc(func(_ context.Context, c *Claims) (bool, error) {
+		return c.ID == revokedClaims.ID, nil
+	})
+
+	if _, err := Authenticate(context.Background(), revoked); !errors.Is(err, ErrRevokedToken) {
+		t.Errorf("Authentication failed: expected error %v, got %v", ErrRevokedToken, err)
+	}
+
+	if _, err := Authenticate(context.Background(), valid); err != nil {
+		t.Errorf("Authentication failed: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		token        string
+		expectedCode int
+	}{
+		{name: "valid token", token: valid, expectedCode: http.StatusOK},
+		{name: "revoked token", token: revoked, expectedCode: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Add("Authorization", fmt.Sprintf("Bearer %s", tt.token))
+
+			handle := AuhtorizationHandler(ScopeUserRead, func(w http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			handle(w, &http.Request{Header: header}, httprouter.Params{})
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.expectedCode {
+				t.Fatalf("Authorization handler failed: unexpected response code %v", resp.StatusCode)
+			}
+		})
+	}
+}
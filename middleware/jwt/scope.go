@@ -0,0 +1,157 @@
+package jwt
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// ScopeWildcard matches any value of a scope segment
+const ScopeWildcard = "*"
+
+const (
+	actionRead  = "read"
+	actionWrite = "write"
+
+	resourceAll = "all"
+)
+
+// scopeResources maps the resources of a scope to the pattern of their
+// qualifier, where nil means the resource can't be qualified
+var scopeResources = map[string]*regexp.Regexp{
+	"item":      regexp.MustCompile(`^[a-zA-Z]+\*?$`),
+	"hideout":   nil,
+	"location":  regexp.MustCompile(`^[0-9a-f]{24}$`),
+	"statistic": nil,
+	"user":      nil,
+	"token":     nil,
+	"webhook":   nil,
+	"audit":     nil,
+	"database":  nil,
+}
+
+// isScopeValid checks if a scope has the form "<action>:<resource>[:<qualifier>]".
+// The qualifier restricts a resource to a kind or an ID and may be a wildcard.
+func isScopeValid(s string) bool {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return false
+	}
+
+	if parts[0] != actionRead && parts[0] != actionWrite {
+		return false
+	}
+
+	if parts[1] == resourceAll || parts[1] == ScopeWildcard {
+		return len(parts) == 2
+	}
+
+	re, ok := scopeResources[parts[1]]
+	if !ok {
+		return false
+	}
+
+	if len(parts) == 2 {
+		return true
+	}
+
+	if re == nil {
+		return false
+	}
+
+	return parts[2] == ScopeWildcard || re.MatchString(parts[2])
+}
+
+// splitScope returns the segments of a scope, where a trailing wildcard
+// qualifier is dropped as it is equal to the unqualified scope
+func splitScope(s string) []string {
+	parts := strings.Split(s, ":")
+	if len(parts) > 2 && parts[len(parts)-1] == ScopeWildcard {
+		parts = parts[:len(parts)-1]
+	}
+
+	return parts
+}
+
+// scopeMatches checks if the granted scope covers the required scope.
+// A granted scope covers all scopes it is a prefix of, so "write:item" covers
+// "write:item:ammunition". A wildcard in the required scope is matched by any
+// value, and a trailing wildcard in a granted segment matches by prefix.
+func scopeMatches(granted, required string) bool {
+	g, r := splitScope(granted), strings.Split(required, ":")
+	if len(g) > len(r) {
+		return false
+	}
+
+	for i := range g {
+		switch {
+		case g[i] == r[i], r[i] == ScopeWildcard, g[i] == ScopeWildcard:
+		case i == 1 && g[i] == resourceAll:
+		case strings.HasSuffix(g[i], ScopeWildcard) && strings.HasPrefix(r[i], strings.TrimSuffix(g[i], ScopeWildcard)):
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// scopeCovers checks if the granted scope includes every permission of the
+// requested scope. Unlike scopeMatches, a wildcard in the requested scope is
+// only covered by a wildcard of at least the same breadth.
+func scopeCovers(granted, requested string) bool {
+	g, r := splitScope(granted), splitScope(requested)
+	if len(g) > len(r) {
+		return false
+	}
+
+	for i := range g {
+		switch {
+		case g[i] == r[i], g[i] == ScopeWildcard:
+		case i == 1 && g[i] == resourceAll:
+		case strings.HasSuffix(g[i], ScopeWildcard) && strings.HasPrefix(r[i], strings.TrimSuffix(g[i], ScopeWildcard)):
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// QualifyScope restricts a scope to the given kind or ID
+func QualifyScope(scope, qualifier string) string {
+	return scope + ":" + qualifier
+}
+
+// QualifyScopeValue restricts a scope to the kind or ID given by a request.
+// Values that can't be used as qualifier are dropped, so that only the
+// unqualified scope grants access.
+func QualifyScopeValue(scope, value string) string {
+	if value == "" || strings.ContainsAny(value, ":"+ScopeWildcard) {
+		return scope
+	}
+
+	return QualifyScope(scope, value)
+}
+
+// ScopeParam returns a scope qualified by the route parameter of the given name
+func ScopeParam(scope, param string) string {
+	return QualifyScope(scope, "{"+param+"}")
+}
+
+// resolveScope replaces the parameter segments of a scope by the values of
+// the route parameters
+func resolveScope(scope string, ps httprouter.Params) string {
+	i := strings.LastIndexByte(scope, ':')
+	if i < 0 {
+		return scope
+	}
+
+	seg := scope[i+1:]
+	if !strings.HasPrefix(seg, "{") || !strings.HasSuffix(seg, "}") {
+		return scope
+	}
+
+	return QualifyScopeValue(scope[:i], ps.ByName(seg[1:len(seg)-1]))
+}
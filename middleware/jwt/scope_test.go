@@ -0,0 +1,124 @@
+package jwt
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestScopeValid(t *testing.T) {
+	tests := []struct {
+		scope string
+		valid bool
+	}{
+		{ScopeAllRead, true},
+		{ScopeItemWrite, true},
+		{"write:item:ammunition", true},
+		{"write:item:modification*", true},
+		{"write:item:*", true},
+		{"write:location:5c0d56a986f774449d5de529", true},
+		{"read:*", true},
+		{"write:location:ammunition", false},
+		{"write:user:5c0d56a986f774449d5de529", false},
+		{"write:all:item", false},
+		{"write:item:ammunition:5c0d56a986f774449d5de529", false},
+		{"delete:item", false},
+		{"write:unknown", false},
+		{"write", false},
+	}
+
+	for _, tt := range tests {
+		if valid := isScopeValid(tt.scope); valid != tt.valid {
+			t.Errorf("Scope validation failed: %s valid is %v", tt.scope, valid)
+		}
+	}
+}
+
+func TestHasScope(t *testing.T) {
+	tests := []struct {
+		granted  string
+		required string
+		match    bool
+	}{
+		{ScopeItemWrite, ScopeItemWrite, true},
+		{ScopeAllWrite, "write:item:ammunition", true},
+		{ScopeItemWrite, "write:item:ammunition", true},
+		{"write:item:ammunition", "write:item:ammunition", true},
+		{"write:item:ammunition", "write:item:*", true},
+		{"write:item:modification*", "write:item:modificationBarrel", true},
+		{"write:item:*", "write:item:firearm", true},
+		{"write:*", ScopeLocationWrite, true},
+		{"write:item:ammunition", "write:item:firearm", false},
+		{"write:item:ammunition", ScopeItemWrite, false},
+		{"write:item:modification*", "write:item:ammunition", false},
+		{ScopeAllRead, ScopeItemWrite, false},
+		{"read:item:ammunition", "write:item:ammunition", false},
+	}
+
+	for _, tt := range tests {
+		c := &Claims{Scope: []string{tt.granted}}
+		if match := c.HasScope(tt.required); match != tt.match {
+			t.Errorf("Scope matching failed: %s covers %s is %v", tt.granted, tt.required, match)
+		}
+	}
+}
+
+func TestScopeParam(t *testing.T) {
+	handle := AuhtorizationHandler(ScopeParam(ScopeItemWrite, "kind"), func(w http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	token, err := SignToken(&Claims{Scope: []string{"write:item:ammunition"}}, nil)
+	if err != nil {
+		t.Fatalf("Token creation failed: %v", err)
+	}
+
+	tests := []struct {
+		kind string
+		code int
+	}{
+		{"ammunition", http.StatusOK},
+		{"firearm", http.StatusForbidden},
+		{"ammunition:x", http.StatusForbidden},
+		{"*", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodPut, "/", nil)
+		r.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		handle(w, r, httprouter.Params{{Key: "kind", Value: tt.kind}})
+
+		if w.Code != tt.code {
+			t.Errorf("Authorization handler failed: kind %s returned code %v", tt.kind, w.Code)
+		}
+	}
+}
+
+func TestCovers(t *testing.T) {
+	tests := []struct {
+		granted   string
+		requested string
+		covered   bool
+	}{
+		{ScopeAllWrite, "write:item:*", true},
+		{ScopeItemWrite, "write:item:*", true},
+		{"write:item:*", ScopeItemWrite, true},
+		{"write:item:modification*", "write:item:modificationBarrel", true},
+		{"write:item:ammunition", "write:item:*", false},
+		{"write:item:modification*", "write:item:*", false},
+		{ScopeItemWrite, ScopeAllWrite, false},
+		{ScopeItemWrite, "write:*", false},
+		{ScopeAllRead, ScopeItemWrite, false},
+	}
+
+	for _, tt := range tests {
+		c := &Claims{Scope: []string{tt.granted}}
+		if covered := c.Covers(tt.requested); covered != tt.covered {
+			t.Errorf("Scope coverage failed: %s covers %s is %v", tt.granted, tt.requested, covered)
+		}
+	}
+}
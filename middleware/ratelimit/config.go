@@ -0,0 +1,103 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tarkov-database/rest-api/core/config"
+)
+
+var cfg, _ = newConfig(config.Default().RateLimit)
+
+type settings struct {
+	Enabled      bool
+	Shared       bool
+	TrustProxy   bool
+	ProxyHops    int
+	Tiers        map[Tier]Limit
+	DefaultQuota int64
+}
+
+// Configure applies the configuration to the rate limiting. It has to be
+// called before Init.
+func Configure(c config.RateLimit) error {
+	s, err := newConfig(c)
+	if err != nil {
+		return err
+	}
+
+	cfg = s
+
+	return nil
+}
+
+func newConfig(c config.RateLimit) (*settings, error) {
+	s := &settings{
+		Enabled:      c.Enabled,
+		Shared:       c.Store == "database",
+		TrustProxy:   c.TrustProxy,
+		ProxyHops:    c.ProxyHops,
+		DefaultQuota: c.Quota,
+		Tiers: map[Tier]Limit{
+			TierAnonymous: {Rate: 2, Burst: 40},
+			TierRead:      {Rate: 10, Burst: 100},
+			TierWrite:     {Rate: 20, Burst: 200},
+			TierAdmin:     {},
+			TierAddress:   {Rate: 50, Burst: 500},
+		},
+	}
+
+	// Tiers are given as "<tier>:<rate>/<burst>", where a rate of zero disables the limit
+	var errs []error
+	for _, t := range c.Tiers {
+		tier, l, err := parseTier(strings.TrimSpace(t))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rate limit tier %q is not valid: %s", t, err))
+			continue
+		}
+
+		s.Tiers[tier] = l
+	}
+
+	return s, errors.Join(errs...)
+}
+
+func parseTier(s string) (Tier, Limit, error) {
+	var l Limit
+
+	name, value, ok := strings.Cut(s, ":")
+	if !ok {
+		return "", l, errors.New("missing limit")
+	}
+
+	tier := Tier(name)
+	if !tier.IsValid() {
+		return "", l, errors.New("unknown tier")
+	}
+
+	rate, burst, ok := strings.Cut(value, "/")
+	if !ok {
+		burst = "0"
+	}
+
+	var err error
+
+	if l.Rate, err = strconv.ParseFloat(rate, 64); err != nil || l.Rate < 0 {
+		return "", l, errors.New("rate is not a positive number")
+	}
+
+	if l.Burst, err = strconv.ParseInt(burst, 10, 64); err != nil || l.Burst < 0 {
+		return "", l, errors.New("burst is not a positive integer")
+	}
+
+	if l.Rate > 0 && l.Burst == 0 {
+		l.Burst = int64(l.Rate)
+		if l.Burst < 1 {
+			l.Burst = 1
+		}
+	}
+
+	return tier, l, nil
+}
@@ -0,0 +1,104 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collection indicates the MongoDB collection of the shared limiter state
+const Collection = "rateLimits"
+
+// databaseStore shares the limiter state between instances through the database
+type databaseStore struct{}
+
+// Take implements the Store interface. The bucket is refilled and taken from
+// in a single atomic update.
+func (databaseStore) Take(ctx context.Context, key string, l Limit, now time.Time) (*Result, error) {
+	c := database.GetDB().Collection(Collection)
+
+	elapsed := bson.M{"$divide": bson.A{
+		bson.M{"$subtract": bson.A{now, bson.M{"$ifNull": bson.A{"$last", now}}}},
+		1000,
+	}}
+
+	pipeline := bson.A{
+		bson.M{"$set": bson.M{
+			"tokens": bson.M{"$min": bson.A{
+				float64(l.Burst),
+				bson.M{"$add": bson.A{
+					bson.M{"$ifNull": bson.A{"$tokens", float64(l.Burst)}},
+					bson.M{"$multiply": bson.A{elapsed, l.Rate}},
+				}},
+			}},
+			"last":    now,
+			"expires": now.Add(idleTime),
+		}},
+		bson.M{"$set": bson.M{"allowed": bson.M{"$gte": bson.A{"$tokens", 1}}}},
+		bson.M{"$set": bson.M{"tokens": bson.M{"$cond": bson.A{
+			"$allowed", bson.M{"$subtract": bson.A{"$tokens", 1}}, "$tokens",
+		}}}},
+	}
+
+	opts := options.FindOneAndUpdate()
+	opts.SetUpsert(true)
+	opts.SetReturnDocument(options.After)
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var doc struct {
+		Tokens  float64 `bson:"tokens"`
+		Allowed bool    `bson:"allowed"`
+	}
+
+	if err := c.FindOneAndUpdate(ctx, bson.M{"_id": "bucket:" + key}, pipeline, opts).Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	return &Result{Allowed: doc.Allowed, Remaining: doc.Tokens}, nil
+}
+
+// Count implements the Store interface
+func (databaseStore) Count(ctx context.Context, key string, day time.Time) (int64, error) {
+	c := database.GetDB().Collection(Collection)
+
+	id := "quota:" + key + ":" + day.Format("2006-01-02")
+	update := bson.M{
+		"$inc":         bson.M{"count": int64(1)},
+		"$setOnInsert": bson.M{"expires": day.Add(48 * time.Hour)},
+	}
+
+	opts := options.FindOneAndUpdate()
+	opts.SetUpsert(true)
+	opts.SetReturnDocument(options.After)
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var doc struct {
+		Count int64 `bson:"count"`
+	}
+
+	if err := c.FindOneAndUpdate(ctx, bson.M{"_id": id}, update, opts).Decode(&doc); err != nil {
+		return 0, err
+	}
+
+	return doc.Count, nil
+}
+
+// cleanup removes expired buckets and counters
+func (databaseStore) cleanup(ctx context.Context, now time.Time) error {
+	c := database.GetDB().Collection(Collection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	_, err := c.DeleteMany(ctx, bson.M{"expires": bson.M{"$lt": now}})
+
+	return err
+}
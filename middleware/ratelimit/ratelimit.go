@@ -0,0 +1,354 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/view"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// Tier represents a group of clients sharing the same limit
+type Tier string
+
+const (
+	// TierAnonymous applies to requests without a token
+	TierAnonymous Tier = "anonymous"
+
+	// TierRead applies to tokens with read scopes only
+	TierRead Tier = "read"
+
+	// TierWrite applies to tokens with at least one write scope
+	TierWrite Tier = "write"
+
+	// TierAdmin applies to tokens with the scope "write:all"
+	TierAdmin Tier = "admin"
+
+	// TierAddress applies to all requests of a client address before they
+	// are authorized
+	TierAddress Tier = "address"
+)
+
+// TierList is a list of all tiers
+var TierList = [...]Tier{
+	TierAnonymous,
+	TierRead,
+	TierWrite,
+	TierAdmin,
+	TierAddress,
+}
+
+// IsValid checks if the tier is valid
+func (t Tier) IsValid() bool {
+	for _, v := range TierList {
+		if v == t {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Limit describes a token bucket which holds up to Burst tokens and is
+// refilled at Rate tokens per second. A rate of zero disables the limit.
+type Limit struct {
+	Rate  float64
+	Burst int64
+}
+
+// IsUnlimited reports whether the limit is disabled
+func (l Limit) IsUnlimited() bool {
+	return l.Rate <= 0
+}
+
+// idleTime is the duration after which the state of an unused bucket is dropped
+const idleTime = time.Hour
+
+const (
+	headerLimit     = "RateLimit-Limit"
+	headerRemaining = "RateLimit-Remaining"
+	headerReset     = "RateLimit-Reset"
+	headerPolicy    = "RateLimit-Policy"
+	headerRetry     = "Retry-After"
+)
+
+var store Store
+
+// Init initializes the store of the limiter state
+func Init() {
+	if !cfg.Enabled {
+		return
+	}
+
+	var s interface {
+		Store
+		cleanup(context.Context, time.Time) error
+	}
+
+	if cfg.Shared {
+		s = databaseStore{}
+	} else {
+		s = newMemoryStore()
+	}
+
+	store = s
+
+	go func() {
+		for now := range time.Tick(10 * time.Minute) {
+			if err := s.cleanup(context.Background(), now); err != nil {
+				logger.Errorf("Rate limit cleanup error: %s", err)
+			}
+		}
+	}()
+}
+
+// Enabled reports whether rate limiting is enabled
+func Enabled() bool {
+	return cfg.Enabled && store != nil
+}
+
+// QuotaFunc returns the daily request quota of a subject, where zero means the default quota
+type QuotaFunc func(ctx context.Context, subject string) (int64, error)
+
+var quotaFunc QuotaFunc = func(context.Context, string) (int64, error) { return 0, nil }
+
+// SetQuotaFunc sets the function used to look up the daily quota of a subject
+func SetQuotaFunc(f QuotaFunc) {
+	quotaFunc = f
+}
+
+type cachedQuota struct {
+	quota   int64
+	expires time.Time
+}
+
+var quotas = struct {
+	sync.Mutex
+	m map[string]cachedQuota
+}{m: make(map[string]cachedQuota)}
+
+// quotaOf returns the daily quota of a subject, where zero means unlimited
+func quotaOf(ctx context.Context, subject string, now time.Time) (int64, error) {
+	quotas.Lock()
+	q, ok := quotas.m[subject]
+	quotas.Unlock()
+
+	if ok && now.Before(q.expires) {
+		return q.quota, nil
+	}
+
+	quota, err := quotaFunc(ctx, subject)
+	if err != nil {
+		return 0, err
+	}
+	if quota == 0 {
+		quota = cfg.DefaultQuota
+	}
+
+	quotas.Lock()
+	for k, v := range quotas.m {
+		if now.After(v.expires) {
+			delete(quotas.m, k)
+		}
+	}
+	quotas.m[subject] = cachedQuota{quota: quota, expires: now.Add(time.Minute)}
+	quotas.Unlock()
+
+	return quota, nil
+}
+
+// tierOf returns the tier of the given claims
+func tierOf(c *jwt.Claims) Tier {
+	if c == nil {
+		return TierAnonymous
+	}
+
+	tier := TierRead
+	for _, s := range c.Scope {
+		if s == jwt.ScopeAllWrite {
+			return TierAdmin
+		}
+		if strings.HasPrefix(s, "write:") {
+			tier = TierWrite
+		}
+	}
+
+	return tier
+}
+
+// ClientAddr returns the address of the client of a request. Behind trusted
+// proxies it is the entry of X-Forwarded-For added by the outermost of them,
+// since all entries left of it are set by the client.
+func ClientAddr(r *http.Request) string {
+	if cfg.TrustProxy {
+		if addr := forwardedAddr(r.Header.Values("X-Forwarded-For"), cfg.ProxyHops); len(addr) > 0 {
+			return addr
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+// forwardedAddr returns the entry of the X-Forwarded-For values which is the
+// given number of hops away from the right
+func forwardedAddr(values []string, hops int) string {
+	var addrs []string
+	for _, v := range values {
+		for _, addr := range strings.Split(v, ",") {
+			if addr = strings.TrimSpace(addr); len(addr) > 0 {
+				addrs = append(addrs, addr)
+			}
+		}
+	}
+
+	if len(addrs) == 0 {
+		return ""
+	}
+
+	i := len(addrs) - hops
+	if i < 0 {
+		i = 0
+	}
+
+	return addrs[i]
+}
+
+// AddrHandler returns a handler limiting the requests of a client address
+// regardless of their authorization. It has to wrap the authorization
+// handler, so that requests with invalid credentials are limited as well.
+func AddrHandler(h httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		if !Enabled() {
+			h(w, r, ps)
+			return
+		}
+
+		if !take(w, r, "addr:"+ClientAddr(r), cfg.Tiers[TierAddress], time.Now()) {
+			return
+		}
+
+		h(w, r, ps)
+	}
+}
+
+// Handler returns a handler limiting the requests of a client. Clients are
+// identified by the subject of the token set by the authorization handler and
+// otherwise by their address.
+func Handler(h httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		if !Enabled() {
+			h(w, r, ps)
+			return
+		}
+
+		now := time.Now()
+
+		claims, _ := jwt.FromContext(r.Context())
+
+		var key string
+		if claims != nil && len(claims.Subject) > 0 {
+			key = "sub:" + claims.Subject
+		} else {
+			claims = nil
+			key = "ip:" + ClientAddr(r)
+		}
+
+		if !take(w, r, key, cfg.Tiers[tierOf(claims)], now) {
+			return
+		}
+
+		if claims != nil {
+			ok, err := checkQuota(r.Context(), claims.Subject, now, w)
+			if err != nil {
+				logger.Errorf("Quota error: %s", err)
+			} else if !ok {
+				statusHandler("Daily quota exceeded", w)
+				return
+			}
+		}
+
+		h(w, r, ps)
+	}
+}
+
+// take takes a token from the bucket of the key and reports whether the
+// request is allowed. A rejected request is answered, while errors of the
+// store are only logged and let the request pass.
+func take(w http.ResponseWriter, r *http.Request, key string, l Limit, now time.Time) bool {
+	if l.IsUnlimited() {
+		return true
+	}
+
+	res, err := store.Take(r.Context(), key, l, now)
+	if err != nil {
+		logger.Errorf("Rate limit error: %s", err)
+		return true
+	}
+
+	setHeaders(w, l, res)
+
+	if !res.Allowed {
+		retry := math.Ceil((1 - res.Remaining) / l.Rate)
+		w.Header().Set(headerRetry, strconv.FormatFloat(retry, 'f', 0, 64))
+		statusHandler("Rate limit exceeded", w)
+		return false
+	}
+
+	return true
+}
+
+// checkQuota counts the request against the daily quota of the subject
+func checkQuota(ctx context.Context, subject string, now time.Time, w http.ResponseWriter) (bool, error) {
+	quota, err := quotaOf(ctx, subject, now)
+	if err != nil || quota <= 0 {
+		return true, err
+	}
+
+	day := startOfDay(now)
+
+	count, err := store.Count(ctx, subject, day)
+	if err != nil {
+		return true, err
+	}
+
+	if count > quota {
+		reset := day.Add(24 * time.Hour).Sub(now)
+		w.Header().Set(headerRetry, strconv.FormatFloat(math.Ceil(reset.Seconds()), 'f', 0, 64))
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// setHeaders sets the RateLimit headers based on the state of the bucket
+func setHeaders(w http.ResponseWriter, l Limit, res *Result) {
+	remaining := math.Max(0, math.Floor(res.Remaining))
+	reset := math.Ceil((float64(l.Burst) - res.Remaining) / l.Rate)
+	window := math.Ceil(float64(l.Burst) / l.Rate)
+
+	w.Header().Set(headerLimit, strconv.FormatInt(l.Burst, 10))
+	w.Header().Set(headerRemaining, strconv.FormatFloat(remaining, 'f', 0, 64))
+	w.Header().Set(headerReset, strconv.FormatFloat(reset, 'f', 0, 64))
+	w.Header().Set(headerPolicy, fmt.Sprintf("%d;w=%.0f", l.Burst, window))
+}
+
+func statusHandler(msg string, w http.ResponseWriter) {
+	res := model.NewResponse(msg, http.StatusTooManyRequests)
+	view.RenderJSON(res, http.StatusTooManyRequests, w)
+}
@@ -0,0 +1,286 @@
+package ratelimit
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func init() {
+	logger.SetOutput(io.Discard)
+}
+
+func useStore(t *testing.T, tiers map[Tier]Limit, quota int64) {
+	prevCfg, prevStore := *cfg, store
+
+	cfg.Enabled = true
+	cfg.Tiers = tiers
+	cfg.DefaultQuota = quota
+	store = newMemoryStore()
+
+	t.Cleanup(func() {
+		*cfg, store = prevCfg, prevStore
+		quotas.m = make(map[string]cachedQuota)
+	})
+}
+
+func TestBucket(t *testing.T) {
+	l := Limit{Rate: 1, Burst: 2}
+	ctx := context.Background()
+	s := newMemoryStore()
+	now := time.Now()
+
+	for i, allowed := range []bool{true, true, false} {
+		res, err := s.Take(ctx, "key", l, now)
+		if err != nil {
+			t.Fatalf("Taking token failed: %s", err)
+		}
+		if res.Allowed != allowed {
+			t.Fatalf("Taking token failed: request %d allowed is %v", i, res.Allowed)
+		}
+	}
+
+	res, _ := s.Take(ctx, "key", l, now.Add(time.Second))
+	if !res.Allowed {
+		t.Error("Taking token failed: bucket not refilled")
+	}
+
+	res, _ = s.Take(ctx, "key", l, now.Add(time.Hour))
+	if !res.Allowed || res.Remaining != 1 {
+		t.Errorf("Taking token failed: bucket exceeds burst with %v remaining", res.Remaining)
+	}
+
+	if err := s.cleanup(ctx, now.Add(3*time.Hour)); err != nil || len(s.buckets) != 0 {
+		t.Error("Cleanup failed: idle bucket not removed")
+	}
+}
+
+func TestTierOf(t *testing.T) {
+	tests := []struct {
+		claims *jwt.Claims
+		tier   Tier
+	}{
+		{nil, TierAnonymous},
+		{&jwt.Claims{Scope: []string{jwt.ScopeItemRead}}, TierRead},
+		{&jwt.Claims{Scope: []string{jwt.ScopeItemRead, jwt.ScopeItemWrite}}, TierWrite},
+		{&jwt.Claims{Scope: []string{jwt.ScopeAllWrite}}, TierAdmin},
+	}
+
+	for _, tt := range tests {
+		if tier := tierOf(tt.claims); tier != tt.tier {
+			t.Errorf("Getting tier failed: expected %s, got %s", tt.tier, tier)
+		}
+	}
+}
+
+func TestParseTier(t *testing.T) {
+	tests := []struct {
+		value string
+		tier  Tier
+		limit Limit
+		err   bool
+	}{
+		{"read:5/50", TierRead, Limit{Rate: 5, Burst: 50}, false},
+		{"write:0.5", TierWrite, Limit{Rate: 0.5, Burst: 1}, false},
+		{"admin:0", TierAdmin, Limit{}, false},
+		{"unknown:5/50", "", Limit{}, true},
+		{"read", "", Limit{}, true},
+		{"read:-1/5", "", Limit{}, true},
+	}
+
+	for _, tt := range tests {
+		tier, l, err := parseTier(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Errorf("Parsing tier failed: %q parsed as valid", tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Parsing tier failed: %s", err)
+			continue
+		}
+		if tier != tt.tier || l != tt.limit {
+			t.Errorf("Parsing tier failed: %q parsed as %s %v", tt.value, tier, l)
+		}
+	}
+}
+
+func TestClientAddr(t *testing.T) {
+	prev := *cfg
+	t.Cleanup(func() { *cfg = prev })
+
+	tests := []struct {
+		trust bool
+		hops  int
+		fwd   []string
+		addr  string
+	}{
+		{false, 1, []string{"203.0.113.7"}, "192.0.2.1"},
+		{true, 1, nil, "192.0.2.1"},
+		{true, 1, []string{"203.0.113.7"}, "203.0.113.7"},
+		// The client prepends a spoofed address, the proxy appends the real one
+		{true, 1, []string{"198.51.100.1, 203.0.113.7"}, "203.0.113.7"},
+		{true, 1, []string{"198.51.100.1", "203.0.113.7"}, "203.0.113.7"},
+		{true, 2, []string{"198.51.100.1, 203.0.113.7, 10.0.0.2"}, "203.0.113.7"},
+		{true, 3, []string{"203.0.113.7, 10.0.0.2"}, "203.0.113.7"},
+	}
+
+	for _, tt := range tests {
+		cfg.TrustProxy, cfg.ProxyHops = tt.trust, tt.hops
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = "192.0.2.1:1234"
+		for _, v := range tt.fwd {
+			r.Header.Add("X-Forwarded-For", v)
+		}
+
+		if addr := ClientAddr(r); addr != tt.addr {
+			t.Errorf("Client address of %q with %d hops is %s, expected %s", tt.fwd, tt.hops, addr, tt.addr)
+		}
+	}
+}
+
+func TestHandler(t *testing.T) {
+	useStore(t, map[Tier]Limit{
+		TierAnonymous: {Rate: 1, Burst: 2},
+		TierRead:      {Rate: 1, Burst: 5},
+		TierAdmin:     {},
+	}, 0)
+
+	handle := Handler(func(w http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	do := func(claims *jwt.Claims, addr string) *http.Response {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = addr
+		if claims != nil {
+			r = r.WithContext(jwt.NewContext(r.Context(), claims))
+		}
+
+		w := httptest.NewRecorder()
+		handle(w, r, httprouter.Params{})
+
+		return w.Result()
+	}
+
+	for _, code := range []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests} {
+		resp := do(nil, "192.0.2.1:1234")
+		if resp.StatusCode != code {
+			t.Fatalf("Rate limit handler failed: expected code %v, got %v", code, resp.StatusCode)
+		}
+		if resp.Header.Get(headerLimit) != "2" {
+			t.Fatalf("Rate limit handler failed: unexpected limit header %q", resp.Header.Get(headerLimit))
+		}
+	}
+
+	resp := do(nil, "192.0.2.1:1234")
+	if resp.Header.Get(headerRetry) != "1" || resp.Header.Get(headerRemaining) != "0" {
+		t.Errorf("Rate limit handler failed: unexpected headers %v", resp.Header)
+	}
+
+	if resp := do(nil, "192.0.2.2:1234"); resp.StatusCode != http.StatusOK {
+		t.Errorf("Rate limit handler failed: address shares bucket of another address")
+	}
+
+	reader := &jwt.Claims{Scope: []string{jwt.ScopeItemRead}}
+	reader.Subject = "reader"
+
+	resp = do(reader, "192.0.2.1:1234")
+	if resp.StatusCode != http.StatusOK || resp.Header.Get(headerLimit) != "5" {
+		t.Errorf("Rate limit handler failed: subject not limited by its tier")
+	}
+
+	admin := &jwt.Claims{Scope: []string{jwt.ScopeAllWrite}}
+	admin.Subject = "admin"
+
+	for i := 0; i < 10; i++ {
+		if resp := do(admin, "192.0.2.1:1234"); resp.StatusCode != http.StatusOK || resp.Header.Get(headerLimit) != "" {
+			t.Fatalf("Rate limit handler failed: unlimited tier was limited")
+		}
+	}
+}
+
+func TestAddrHandler(t *testing.T) {
+	useStore(t, map[Tier]Limit{TierAddress: {Rate: 1, Burst: 2}}, 0)
+
+	// The wrapped handler rejects the request like a failed authorization
+	handle := AddrHandler(func(w http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	do := func(addr string) *http.Response {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = addr
+		r.Header.Set("Authorization", "Bearer invalid")
+
+		w := httptest.NewRecorder()
+		handle(w, r, httprouter.Params{})
+
+		return w.Result()
+	}
+
+	for _, code := range []int{http.StatusUnauthorized, http.StatusUnauthorized, http.StatusTooManyRequests} {
+		if resp := do("192.0.2.1:1234"); resp.StatusCode != code {
+			t.Fatalf("Address rate limit handler failed: expected code %v, got %v", code, resp.StatusCode)
+		}
+	}
+
+	if resp := do("192.0.2.2:1234"); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Address rate limit handler failed: address shares bucket of another address")
+	}
+}
+
+func TestQuota(t *testing.T) {
+	useStore(t, map[Tier]Limit{}, 2)
+
+	SetQuotaFunc(func(_ context.Context, sub string) (int64, error) {
+		if sub == "custom" {
+			return 3, nil
+		}
+		return 0, nil
+	})
+	t.Cleanup(func() { SetQuotaFunc(func(context.Context, string) (int64, error) { return 0, nil }) })
+
+	handle := Handler(func(w http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	do := func(sub string) *http.Response {
+		claims := &jwt.Claims{Scope: []string{jwt.ScopeItemRead}}
+		claims.Subject = sub
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r = r.WithContext(jwt.NewContext(r.Context(), claims))
+
+		w := httptest.NewRecorder()
+		handle(w, r, httprouter.Params{})
+
+		return w.Result()
+	}
+
+	for sub, allowed := range map[string]int{"default": 2, "custom": 3} {
+		for i := 0; i < allowed; i++ {
+			if resp := do(sub); resp.StatusCode != http.StatusOK {
+				t.Fatalf("Quota failed: request %d of %s rejected", i, sub)
+			}
+		}
+
+		resp := do(sub)
+		if resp.StatusCode != http.StatusTooManyRequests {
+			t.Fatalf("Quota failed: quota of %s not enforced", sub)
+		}
+		if len(resp.Header.Get(headerRetry)) == 0 {
+			t.Errorf("Quota failed: missing %s header", headerRetry)
+		}
+	}
+}
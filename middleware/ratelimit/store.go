@@ -0,0 +1,120 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Result represents the state of a bucket after taking a token
+type Result struct {
+	Allowed   bool
+	Remaining float64
+}
+
+// Store describes the storage of the limiter state
+type Store interface {
+	// Take takes a token of the bucket of the key
+	Take(ctx context.Context, key string, l Limit, now time.Time) (*Result, error)
+
+	// Count increments the request count of the key for the day and returns it
+	Count(ctx context.Context, key string, day time.Time) (int64, error)
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// take refills the bucket at the rate of the limit and takes a token
+func (b *bucket) take(l Limit, now time.Time) *Result {
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * l.Rate
+	}
+
+	b.tokens = math.Min(b.tokens, float64(l.Burst))
+	b.last = now
+
+	if b.tokens < 1 {
+		return &Result{Remaining: b.tokens}
+	}
+
+	b.tokens--
+
+	return &Result{Allowed: true, Remaining: b.tokens}
+}
+
+type counter struct {
+	day   time.Time
+	count int64
+}
+
+// memoryStore holds the limiter state in memory
+type memoryStore struct {
+	mu       sync.Mutex
+	buckets  map[string]*bucket
+	counters map[string]*counter
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		buckets:  make(map[string]*bucket),
+		counters: make(map[string]*counter),
+	}
+}
+
+// Take implements the Store interface
+func (s *memoryStore) Take(ctx context.Context, key string, l Limit, now time.Time) (*Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(l.Burst)}
+		s.buckets[key] = b
+	}
+
+	return b.take(l, now), nil
+}
+
+// Count implements the Store interface
+func (s *memoryStore) Count(ctx context.Context, key string, day time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.counters[key]
+	if !ok || !c.day.Equal(day) {
+		c = &counter{day: day}
+		s.counters[key] = c
+	}
+
+	c.count++
+
+	return c.count, nil
+}
+
+// cleanup removes idle buckets and counters of past days
+func (s *memoryStore) cleanup(_ context.Context, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for k, b := range s.buckets {
+		if now.Sub(b.last) > idleTime {
+			delete(s.buckets, k)
+		}
+	}
+
+	day := startOfDay(now)
+	for k, c := range s.counters {
+		if c.day.Before(day) {
+			delete(s.counters, k)
+		}
+	}
+
+	return nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
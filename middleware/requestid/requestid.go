@@ -0,0 +1,61 @@
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"regexp"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// Header holds the ID of a request
+const Header = "X-Request-ID"
+
+var pattern = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,64}$`)
+
+type contextKey struct{}
+
+// FromContext returns the request ID of the context
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// Ensure returns the request with its ID in the context. The ID is taken
+// from an outer handler, the request header if valid, or newly generated,
+// and is set as response header.
+func Ensure(w http.ResponseWriter, r *http.Request) (*http.Request, string) {
+	if id := FromContext(r.Context()); id != "" {
+		return r, id
+	}
+
+	id := r.Header.Get(Header)
+	if !pattern.MatchString(id) {
+		id = newID()
+	}
+
+	w.Header().Set(Header, id)
+
+	return r.WithContext(context.WithValue(r.Context(), contextKey{}, id)), id
+}
+
+// Handler returns a handler assigning an ID to each request
+func Handler(h httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		r, _ = Ensure(w, r)
+
+		h(w, r, ps)
+	}
+}
+
+// newID returns a random request ID
+func newID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(b)
+}
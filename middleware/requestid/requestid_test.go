@@ -0,0 +1,55 @@
+package requestid
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{name: "client ID", header: "request-1", expected: "request-1"},
+		{name: "invalid client ID", header: "invalid request id"},
+		{name: "no client ID"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var inner string
+
+			h := Handler(func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+				// Nested handlers keep the ID of the outer handler
+				r, inner = Ensure(w, r)
+				if id := FromContext(r.Context()); id != inner {
+					t.Errorf("Context ID is %q, expected %q", id, inner)
+				}
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(Header, tt.header)
+			}
+
+			w := httptest.NewRecorder()
+			h(w, req, nil)
+
+			id := w.Header().Get(Header)
+			if id != inner {
+				t.Errorf("Response ID is %q, handler ID is %q", id, inner)
+			}
+
+			if tt.expected != "" && id != tt.expected {
+				t.Errorf("Request ID is %q, expected %q", id, tt.expected)
+			}
+			if tt.expected == "" && len(id) != 32 {
+				t.Errorf("Request ID %q is not generated", id)
+			}
+		})
+	}
+}
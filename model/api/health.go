@@ -1,13 +1,23 @@
 package api
 
 import (
+	"context"
+
+	"github.com/tarkov-database/rest-api/core/database"
 	"github.com/tarkov-database/rest-api/core/health"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
 )
 
 // Health represents the object of the health root endpoint
 type Health struct {
-	OK      bool     `json:"ok"`
-	Service *Service `json:"service"`
+	OK           bool                `json:"ok"`
+	Ready        bool                `json:"ready"`
+	Version      string              `json:"version"`
+	Service      *Service            `json:"service"`
+	Checks       []health.Result     `json:"checks"`
+	Indexes      map[string][]string `json:"indexes"`
+	Certificates *Certificates       `json:"certificates"`
 }
 
 // Service holds all services with their respective status
@@ -15,19 +25,36 @@ type Service struct {
 	Database health.Status `json:"database"`
 }
 
-// GetHealth performs a self-check and returns the result
-func GetHealth() *Health {
-	svc := &Service{}
+// Certificates holds the size of the certificate store
+type Certificates struct {
+	Roots  int `json:"roots"`
+	Cached int `json:"cached"`
+}
 
+// GetHealth performs a self-check and returns the result
+func GetHealth(ctx context.Context) *Health {
 	h := &Health{
 		OK:      true,
-		Service: svc,
+		Ready:   health.Ready(),
+		Version: Version,
+		Service: &Service{Database: health.DatabaseStatus()},
+		Checks:  health.Results(),
 	}
 
-	svc.Database = health.DatabaseStatus()
-	if svc.Database != health.OK {
-		h.OK = false
+	for _, r := range h.Checks {
+		if r.Status != health.OK {
+			h.OK = false
+		}
 	}
 
+	indexes, err := database.Indexes(ctx)
+	if err != nil {
+		logger.Errorf("Error while listing indexes: %s", err)
+	}
+	h.Indexes = indexes
+
+	h.Certificates = &Certificates{}
+	h.Certificates.Roots, h.Certificates.Cached = jwt.CertificateCount()
+
 	return h
 }
@@ -0,0 +1,399 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/model/role"
+	"github.com/tarkov-database/rest-api/model/user"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	// ErrInvalidLabel indicates that the label of a key is not valid
+	ErrInvalidLabel = errors.New("invalid label")
+)
+
+// keyPrefix marks a string as API key of this service
+const keyPrefix = "tdb_"
+
+// lastUsedInterval is the minimum interval between updates of the last use
+const lastUsedInterval = time.Minute
+
+type objectID = model.ObjectID
+
+type timestamp = model.Timestamp
+
+// Key describes the entity of an API key. Only the hash of the key is stored.
+type Key struct {
+	ID       objectID   `json:"_id" bson:"_id"`
+	User     objectID   `json:"user" bson:"user"`
+	Label    string     `json:"label" bson:"label"`
+	Prefix   string     `json:"prefix" bson:"prefix"`
+	Hash     string     `json:"-" bson:"hash"`
+	Scope    []string   `json:"scope" bson:"scope"`
+	Expires  *timestamp `json:"expires,omitempty" bson:"expires,omitempty"`
+	LastUsed *timestamp `json:"lastUsed,omitempty" bson:"lastUsed,omitempty"`
+	Created  timestamp  `json:"created" bson:"created"`
+	Modified timestamp  `json:"_modified" bson:"_modified"`
+}
+
+// Validate validates the fields of a key
+func (k *Key) Validate() error {
+	if l := len(k.Label); l < 1 || l > 64 {
+		return ErrInvalidLabel
+	}
+
+	if len(k.Scope) == 0 {
+		return jwt.ErrInvalidScope
+	}
+
+	return k.Claims().Validate()
+}
+
+// IsExpired checks if the key is expired
+func (k *Key) IsExpired() bool {
+	return k.Expires != nil && time.Now().After(k.Expires.Time)
+}
+
+// Claims returns the claims granted by the key
+func (k *Key) Claims() *jwt.Claims {
+	c := &jwt.Claims{Scope: k.Scope}
+	c.Subject = k.User.Hex()
+
+	return c
+}
+
+// Generate sets a new random key and returns it
+func (k *Key) Generate() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	s := keyPrefix + base64.RawURLEncoding.EncodeToString(b)
+
+	k.Hash = hashKey(s)
+	k.Prefix = s[:len(keyPrefix)+6]
+
+	return s, nil
+}
+
+func hashKey(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// Request represents the body of a key creation or update request
+type Request struct {
+	Label     string   `json:"label"`
+	Scope     []string `json:"scope"`
+	ExpiresIn string   `json:"expiresIn,omitempty"`
+}
+
+// Apply sets the fields of the request to the key
+func (r *Request) Apply(k *Key) error {
+	k.Label = r.Label
+	k.Scope = r.Scope
+	k.Expires = nil
+
+	if r.ExpiresIn != "" {
+		d, err := time.ParseDuration(r.ExpiresIn)
+		if err != nil {
+			return err
+		}
+		if d <= 0 {
+			return errors.New("expiration must be positive")
+		}
+
+		k.Expires = &timestamp{Time: time.Now().Add(d)}
+	}
+
+	return nil
+}
+
+// Created represents a newly created key including the key itself,
+// which is not retrievable afterwards
+type Created struct {
+	*Key
+	Secret string `json:"key"`
+}
+
+// Collection indicates the MongoDB API key collection
+const Collection = "apiKeys"
+
+func init() {
+	database.RegisterIndexes(
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "hash", Value: 1}}, Unique: true},
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "user", Value: 1}}},
+	)
+}
+
+func getOneByFilter(ctx context.Context, filter interface{}) (*Key, error) {
+	c := database.GetDB().Collection(Collection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	k := &Key{}
+
+	if err := c.FindOne(ctx, filter).Decode(k); err != nil {
+		if err != mongo.ErrNoDocuments {
+			logger.Error(err)
+		}
+		return k, model.MongoToAPIError(err)
+	}
+
+	return k, nil
+}
+
+// GetByID returns the entity of the given ID and user
+func GetByID(ctx context.Context, id, usr string) (*Key, error) {
+	objID, err := model.ToObjectID(id)
+	if err != nil {
+		return &Key{}, err
+	}
+
+	userID, err := model.ToObjectID(usr)
+	if err != nil {
+		return &Key{}, err
+	}
+
+	return getOneByFilter(ctx, bson.M{"_id": objID, "user": userID})
+}
+
+// Options represents the options for a database operation
+type Options struct {
+	Sort   bson.D
+	Limit  int64
+	Offset int64
+}
+
+func getManyByFilter(ctx context.Context, filter interface{}, opts *Options) (*model.Result, error) {
+	c := database.GetDB().Collection(Collection)
+
+	findOpts := options.Find()
+	findOpts.SetLimit(opts.Limit)
+	findOpts.SetSkip(opts.Offset)
+	findOpts.SetSort(opts.Sort)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var err error
+
+	r := &model.Result{}
+
+	r.Count, err = c.CountDocuments(ctx, filter)
+	if err != nil {
+		logger.Error(err)
+		return r, model.MongoToAPIError(err)
+	}
+
+	if r.Count == 0 {
+		return r, nil
+	}
+
+	cur, err := c.Find(ctx, filter, findOpts)
+	if err != nil {
+		if err != mongo.ErrNoDocuments {
+			logger.Error(err)
+		}
+		return r, model.MongoToAPIError(err)
+	}
+
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		k := &Key{}
+
+		if err := cur.Decode(k); err != nil {
+			logger.Error(err)
+			return r, model.MongoToAPIError(err)
+		}
+
+		r.Items = append(r.Items, k)
+	}
+
+	if err := cur.Err(); err != nil {
+		return r, model.MongoToAPIError(err)
+	}
+
+	return r, nil
+}
+
+// GetByUser returns a result of all keys of the user
+func GetByUser(ctx context.Context, usr string, opts *Options) (*model.Result, error) {
+	userID, err := model.ToObjectID(usr)
+	if err != nil {
+		return &model.Result{}, err
+	}
+
+	return getManyByFilter(ctx, bson.M{"user": userID}, opts)
+}
+
+// Authenticate returns the claims granted by the key. Keys of locked users
+// and expired keys are rejected.
+func Authenticate(ctx context.Context, s string) (*jwt.Claims, error) {
+	k, err := getOneByFilter(ctx, bson.M{"hash": hashKey(s)})
+	if err != nil {
+		if err == model.ErrNoResult {
+			return nil, jwt.ErrInvalidAPIKey
+		}
+		return nil, err
+	}
+
+	if k.IsExpired() {
+		return nil, jwt.ErrInvalidAPIKey
+	}
+
+	usr, err := user.GetByID(ctx, k.User.Hex())
+	if err != nil {
+		if err == model.ErrNoResult {
+			return nil, jwt.ErrInvalidAPIKey
+		}
+		return nil, err
+	}
+
+	if usr.Locked {
+		return nil, jwt.ErrInvalidAPIKey
+	}
+
+	// Scopes no longer covered by the roles of the user are not granted
+	clm := k.Claims()
+
+	clm.Scope, err = role.Constrain(ctx, usr.Roles, clm.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.LastUsed == nil || time.Since(k.LastUsed.Time) > lastUsedInterval {
+		if err := setLastUsed(ctx, k.ID); err != nil {
+			logger.Errorf("Error while updating last use of API key %s: %s", k.ID.Hex(), err)
+		}
+	}
+
+	return clm, nil
+}
+
+func setLastUsed(ctx context.Context, id objectID) error {
+	c := database.GetDB().Collection(Collection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	update := bson.M{"$set": bson.M{"lastUsed": timestamp{Time: time.Now()}}}
+
+	if _, err := c.UpdateOne(ctx, bson.M{"_id": id}, update); err != nil {
+		return model.MongoToAPIError(err)
+	}
+
+	return nil
+}
+
+// Create creates a new entity
+func Create(ctx context.Context, k *Key) error {
+	c := database.GetDB().Collection(Collection)
+
+	if k.ID.IsZero() {
+		k.ID = primitive.NewObjectID()
+	}
+
+	now := timestamp{Time: time.Now()}
+	k.Created, k.Modified = now, now
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if _, err := c.InsertOne(ctx, k); err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	return nil
+}
+
+// Replace replaces the data of an existing entity
+func Replace(ctx context.Context, k *Key) error {
+	k.Modified = timestamp{Time: time.Now()}
+
+	c := database.GetDB().Collection(Collection)
+
+	opts := options.FindOneAndReplace()
+	opts.SetUpsert(false)
+	opts.SetReturnDocument(options.After)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if err := c.FindOneAndReplace(ctx, bson.M{"_id": k.ID, "user": k.User}, k, opts).Decode(k); err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	return nil
+}
+
+// Remove removes an entity of the user
+func Remove(ctx context.Context, id, usr string) error {
+	objID, err := model.ToObjectID(id)
+	if err != nil {
+		return err
+	}
+
+	userID, err := model.ToObjectID(usr)
+	if err != nil {
+		return err
+	}
+
+	return removeByFilter(ctx, bson.M{"_id": objID, "user": userID}, false)
+}
+
+// RemoveByUser removes all keys of the user
+func RemoveByUser(ctx context.Context, usr string) error {
+	userID, err := model.ToObjectID(usr)
+	if err != nil {
+		return err
+	}
+
+	return removeByFilter(ctx, bson.M{"user": userID}, true)
+}
+
+func removeByFilter(ctx context.Context, filter interface{}, many bool) error {
+	c := database.GetDB().Collection(Collection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var res *mongo.DeleteResult
+	var err error
+
+	if many {
+		res, err = c.DeleteMany(ctx, filter)
+	} else {
+		res, err = c.DeleteOne(ctx, filter)
+	}
+	if err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	if !many && res.DeletedCount == 0 {
+		return model.ErrNoResult
+	}
+
+	return nil
+}
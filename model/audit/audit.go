@@ -0,0 +1,210 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type objectID = model.ObjectID
+
+// Entry describes a recorded request
+type Entry struct {
+	ID        objectID  `json:"_id" bson:"_id"`
+	Subject   string    `json:"subject,omitempty" bson:"subject,omitempty"`
+	Scope     []string  `json:"scope,omitempty" bson:"scope,omitempty"`
+	Address   string    `json:"address" bson:"address"`
+	Method    string    `json:"method" bson:"method"`
+	Route     string    `json:"route" bson:"route"`
+	Resource  string    `json:"resource,omitempty" bson:"resource,omitempty"`
+	Status    int       `json:"status" bson:"status"`
+	RequestID string    `json:"requestId" bson:"requestId"`
+	Time      time.Time `json:"time" bson:"time"`
+}
+
+// Collection indicates the MongoDB audit collection
+const Collection = "auditLog"
+
+func init() {
+	database.RegisterIndexes(
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "time", Value: 1}, {Key: "_id", Value: 1}}},
+	)
+}
+
+// Filter holds the criteria of an audit log query, where zero values match any entry
+type Filter struct {
+	Subject   string
+	Method    string
+	Route     string
+	Resource  string
+	Status    int
+	RequestID string
+	From      time.Time
+	To        time.Time
+}
+
+func (f *Filter) bson() bson.M {
+	filter := bson.M{}
+
+	if f.Subject != "" {
+		filter["subject"] = f.Subject
+	}
+	if f.Method != "" {
+		filter["method"] = f.Method
+	}
+	if f.Route != "" {
+		filter["route"] = f.Route
+	}
+	if f.Resource != "" {
+		filter["resource"] = f.Resource
+	}
+	if f.Status != 0 {
+		filter["status"] = f.Status
+	}
+	if f.RequestID != "" {
+		filter["requestId"] = f.RequestID
+	}
+
+	if !f.From.IsZero() || !f.To.IsZero() {
+		t := bson.M{}
+		if !f.From.IsZero() {
+			t["$gte"] = f.From
+		}
+		if !f.To.IsZero() {
+			t["$lt"] = f.To
+		}
+		filter["time"] = t
+	}
+
+	return filter
+}
+
+// Options represents the options for a database operation
+type Options struct {
+	Sort   bson.D
+	Limit  int64
+	Offset int64
+}
+
+// Get returns a result of the entries matching the filter
+func Get(ctx context.Context, f *Filter, opts *Options) (*model.Result, error) {
+	c := database.GetDB().Collection(Collection)
+
+	findOpts := options.Find()
+	findOpts.SetLimit(opts.Limit)
+	findOpts.SetSkip(opts.Offset)
+	findOpts.SetSort(opts.Sort)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	filter := f.bson()
+
+	var err error
+
+	r := &model.Result{}
+
+	r.Count, err = c.CountDocuments(ctx, filter)
+	if err != nil {
+		logger.Error(err)
+		return r, model.MongoToAPIError(err)
+	}
+
+	if r.Count == 0 {
+		return r, nil
+	}
+
+	cur, err := c.Find(ctx, filter, findOpts)
+	if err != nil {
+		if err != mongo.ErrNoDocuments {
+			logger.Error(err)
+		}
+		return r, model.MongoToAPIError(err)
+	}
+
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		e := &Entry{}
+
+		if err := cur.Decode(e); err != nil {
+			logger.Error(err)
+			return r, model.MongoToAPIError(err)
+		}
+
+		r.Items = append(r.Items, e)
+	}
+
+	if err := cur.Err(); err != nil {
+		return r, model.MongoToAPIError(err)
+	}
+
+	return r, nil
+}
+
+// Export passes all entries matching the filter in chronological order to fn
+// and stops at the first error
+func Export(ctx context.Context, f *Filter, fn func(*Entry) error) error {
+	c := database.GetDB().Collection(Collection)
+
+	opts := options.Find()
+	opts.SetSort(bson.D{{Key: "time", Value: 1}, {Key: "_id", Value: 1}})
+
+	cur, err := c.Find(ctx, f.bson(), opts)
+	if err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		e := &Entry{}
+
+		if err := cur.Decode(e); err != nil {
+			logger.Error(err)
+			return model.MongoToAPIError(err)
+		}
+
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+
+	if err := cur.Err(); err != nil {
+		return model.MongoToAPIError(err)
+	}
+
+	return nil
+}
+
+// Create records a new entry
+func Create(ctx context.Context, e *Entry) error {
+	c := database.GetDB().Collection(Collection)
+
+	if e.ID.IsZero() {
+		e.ID = primitive.NewObjectID()
+	}
+
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if _, err := c.InsertOne(ctx, e); err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,371 @@
+package clientcert
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/model/role"
+	"github.com/tarkov-database/rest-api/model/user"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	// ErrInvalidLabel indicates that the label of a mapping is not valid
+	ErrInvalidLabel = errors.New("invalid label")
+
+	// ErrInvalidIdentity indicates that the certificate identity of a mapping is not valid
+	ErrInvalidIdentity = errors.New("invalid certificate identity")
+)
+
+// Identity types of a certificate
+const (
+	// TypeSubject matches the distinguished name of the certificate subject
+	TypeSubject = "subject"
+
+	// TypeDNS matches a DNS name of the subject alternative names
+	TypeDNS = "dns"
+
+	// TypeURI matches a URI of the subject alternative names
+	TypeURI = "uri"
+
+	// TypeEmail matches an e-mail address of the subject alternative names
+	TypeEmail = "email"
+)
+
+type objectID = model.ObjectID
+
+type timestamp = model.Timestamp
+
+// Mapping describes the entity mapping an identity of verified client
+// certificates to a user and the scopes granted to the certificate
+type Mapping struct {
+	ID       objectID  `json:"_id" bson:"_id"`
+	User     objectID  `json:"user" bson:"user"`
+	Label    string    `json:"label" bson:"label"`
+	Identity string    `json:"identity" bson:"identity"`
+	Scope    []string  `json:"scope" bson:"scope"`
+	Created  timestamp `json:"created" bson:"created"`
+	Modified timestamp `json:"_modified" bson:"_modified"`
+}
+
+// Validate validates the fields of a mapping
+func (m *Mapping) Validate() error {
+	if l := len(m.Label); l < 1 || l > 64 {
+		return ErrInvalidLabel
+	}
+
+	typ, value, ok := strings.Cut(m.Identity, ":")
+	if !ok || len(value) == 0 || len(value) > 512 {
+		return ErrInvalidIdentity
+	}
+
+	switch typ {
+	case TypeSubject, TypeDNS, TypeURI, TypeEmail:
+	default:
+		return ErrInvalidIdentity
+	}
+
+	if len(m.Scope) == 0 {
+		return jwt.ErrInvalidScope
+	}
+
+	return m.Claims().Validate()
+}
+
+// Claims returns the claims granted by the mapping
+func (m *Mapping) Claims() *jwt.Claims {
+	c := &jwt.Claims{Scope: m.Scope}
+	c.Subject = m.User.Hex()
+
+	return c
+}
+
+// Identities returns the identities of a certificate in the form "<type>:<value>"
+func Identities(cert *x509.Certificate) []string {
+	ids := []string{TypeSubject + ":" + cert.Subject.String()}
+
+	for _, name := range cert.DNSNames {
+		ids = append(ids, TypeDNS+":"+name)
+	}
+	for _, uri := range cert.URIs {
+		ids = append(ids, TypeURI+":"+uri.String())
+	}
+	for _, addr := range cert.EmailAddresses {
+		ids = append(ids, TypeEmail+":"+addr)
+	}
+
+	return ids
+}
+
+// Request represents the body of a mapping creation or update request
+type Request struct {
+	Label    string   `json:"label"`
+	Identity string   `json:"identity"`
+	Scope    []string `json:"scope"`
+}
+
+// Apply sets the fields of the request to the mapping
+func (r *Request) Apply(m *Mapping) {
+	m.Label = r.Label
+	m.Identity = r.Identity
+	m.Scope = r.Scope
+}
+
+// Collection indicates the MongoDB client certificate mapping collection
+const Collection = "clientCertificates"
+
+func init() {
+	database.RegisterIndexes(
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "identity", Value: 1}}},
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "user", Value: 1}}},
+	)
+}
+
+func getOneByFilter(ctx context.Context, filter interface{}) (*Mapping, error) {
+	c := database.GetDB().Collection(Collection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	m := &Mapping{}
+
+	if err := c.FindOne(ctx, filter).Decode(m); err != nil {
+		if err != mongo.ErrNoDocuments {
+			logger.Error(err)
+		}
+		return m, model.MongoToAPIError(err)
+	}
+
+	return m, nil
+}
+
+// GetByID returns the entity of the given ID and user
+func GetByID(ctx context.Context, id, usr string) (*Mapping, error) {
+	objID, err := model.ToObjectID(id)
+	if err != nil {
+		return &Mapping{}, err
+	}
+
+	userID, err := model.ToObjectID(usr)
+	if err != nil {
+		return &Mapping{}, err
+	}
+
+	return getOneByFilter(ctx, bson.M{"_id": objID, "user": userID})
+}
+
+// Options represents the options for a database operation
+type Options struct {
+	Sort   bson.D
+	Limit  int64
+	Offset int64
+}
+
+func getManyByFilter(ctx context.Context, filter interface{}, opts *Options) (*model.Result, error) {
+	c := database.GetDB().Collection(Collection)
+
+	findOpts := options.Find()
+	findOpts.SetLimit(opts.Limit)
+	findOpts.SetSkip(opts.Offset)
+	findOpts.SetSort(opts.Sort)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var err error
+
+	r := &model.Result{}
+
+	r.Count, err = c.CountDocuments(ctx, filter)
+	if err != nil {
+		logger.Error(err)
+		return r, model.MongoToAPIError(err)
+	}
+
+	if r.Count == 0 {
+		return r, nil
+	}
+
+	cur, err := c.Find(ctx, filter, findOpts)
+	if err != nil {
+		if err != mongo.ErrNoDocuments {
+			logger.Error(err)
+		}
+		return r, model.MongoToAPIError(err)
+	}
+
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		m := &Mapping{}
+
+		if err := cur.Decode(m); err != nil {
+			logger.Error(err)
+			return r, model.MongoToAPIError(err)
+		}
+
+		r.Items = append(r.Items, m)
+	}
+
+	if err := cur.Err(); err != nil {
+		return r, model.MongoToAPIError(err)
+	}
+
+	return r, nil
+}
+
+// GetByUser returns a result of all mappings of the user
+func GetByUser(ctx context.Context, usr string, opts *Options) (*model.Result, error) {
+	userID, err := model.ToObjectID(usr)
+	if err != nil {
+		return &model.Result{}, err
+	}
+
+	return getManyByFilter(ctx, bson.M{"user": userID}, opts)
+}
+
+// Authenticate returns the claims granted to a verified client certificate.
+// Certificates matching no or more than one mapping and certificates of
+// locked users are rejected.
+func Authenticate(ctx context.Context, cert *x509.Certificate) (*jwt.Claims, error) {
+	res, err := getManyByFilter(ctx, bson.M{"identity": bson.M{"$in": Identities(cert)}}, &Options{Limit: 2})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Count != 1 {
+		if res.Count > 1 {
+			logger.Warningf("Client certificate %s matches %d mappings", cert.Subject, res.Count)
+		}
+		return nil, jwt.ErrInvalidClientCert
+	}
+
+	m := res.Items[0].(*Mapping)
+
+	usr, err := user.GetByID(ctx, m.User.Hex())
+	if err != nil {
+		if err == model.ErrNoResult {
+			return nil, jwt.ErrInvalidClientCert
+		}
+		return nil, err
+	}
+
+	if usr.Locked {
+		return nil, jwt.ErrInvalidClientCert
+	}
+
+	// Scopes no longer covered by the roles of the user are not granted
+	clm := m.Claims()
+
+	clm.Scope, err = role.Constrain(ctx, usr.Roles, clm.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return clm, nil
+}
+
+// Create creates a new entity
+func Create(ctx context.Context, m *Mapping) error {
+	c := database.GetDB().Collection(Collection)
+
+	if m.ID.IsZero() {
+		m.ID = primitive.NewObjectID()
+	}
+
+	now := timestamp{Time: time.Now()}
+	m.Created, m.Modified = now, now
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if _, err := c.InsertOne(ctx, m); err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	return nil
+}
+
+// Replace replaces the data of an existing entity
+func Replace(ctx context.Context, m *Mapping) error {
+	m.Modified = timestamp{Time: time.Now()}
+
+	c := database.GetDB().Collection(Collection)
+
+	opts := options.FindOneAndReplace()
+	opts.SetUpsert(false)
+	opts.SetReturnDocument(options.After)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if err := c.FindOneAndReplace(ctx, bson.M{"_id": m.ID, "user": m.User}, m, opts).Decode(m); err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	return nil
+}
+
+// Remove removes an entity of the user
+func Remove(ctx context.Context, id, usr string) error {
+	objID, err := model.ToObjectID(id)
+	if err != nil {
+		return err
+	}
+
+	userID, err := model.ToObjectID(usr)
+	if err != nil {
+		return err
+	}
+
+	return removeByFilter(ctx, bson.M{"_id": objID, "user": userID}, false)
+}
+
+// RemoveByUser removes all mappings of the user
+func RemoveByUser(ctx context.Context, usr string) error {
+	userID, err := model.ToObjectID(usr)
+	if err != nil {
+		return err
+	}
+
+	return removeByFilter(ctx, bson.M{"user": userID}, true)
+}
+
+func removeByFilter(ctx context.Context, filter interface{}, many bool) error {
+	c := database.GetDB().Collection(Collection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var res *mongo.DeleteResult
+	var err error
+
+	if many {
+		res, err = c.DeleteMany(ctx, filter)
+	} else {
+		res, err = c.DeleteOne(ctx, filter)
+	}
+	if err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	if !many && res.DeletedCount == 0 {
+		return model.ErrNoResult
+	}
+
+	return nil
+}
@@ -19,16 +19,32 @@ var (
 	// ErrInvalidObjectID indicates that an object ID was invalid
 	ErrInvalidObjectID = errors.New("invalid resource id")
 
+	// ErrDuplicate indicates that a document violates a unique index
+	ErrDuplicate = errors.New("document already exists")
+
 	// ErrInternalError indicates that there was an function or backend error
 	ErrInternalError = errors.New("server or network error")
 )
 
 // MongoToAPIError converts an MongoDB error to an internal error
 func MongoToAPIError(err error) error {
-	switch err {
-	case mongo.ErrNoDocuments:
+	switch {
+	case err == mongo.ErrNoDocuments:
 		return ErrNoResult
+	case mongo.IsDuplicateKeyError(err):
+		return ErrDuplicate
+	case isGeoKeyError(err):
+		return ErrInvalidInput
 	default:
 		return ErrInternalError
 	}
 }
+
+// codeGeoKeys is the code of the error of a document whose GeoJSON can't be
+// indexed by a 2dsphere index
+const codeGeoKeys = 16755
+
+func isGeoKeyError(err error) bool {
+	var se mongo.ServerError
+	return errors.As(err, &se) && se.HasErrorCode(codeGeoKeys)
+}
@@ -8,11 +8,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/tarkov-database/rest-api/core/cache"
 	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/event"
+	"github.com/tarkov-database/rest-api/core/logger"
 	"github.com/tarkov-database/rest-api/model"
 	"github.com/tarkov-database/rest-api/model/item"
 
-	"github.com/google/logger"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -160,10 +162,25 @@ func (i ItemRef) Validate() error {
 // Collection indicates the MongoDB module collection
 const Collection = "modules"
 
-func getOneByFilter(filter interface{}) (*Module, error) {
+const eventKind = "module"
+
+func init() {
+	event.RegisterSource(event.Source{
+		Collection: Collection,
+		Resource:   event.ResourceHideout,
+		Kind:       eventKind,
+	})
+
+	database.RegisterIndexes(
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "stages.materials.id", Value: 1}}},
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "name", Value: "text"}}},
+	)
+}
+
+func getOneByFilter(ctx context.Context, filter interface{}) (*Module, error) {
 	c := database.GetDB().Collection(Collection)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	mod := &Module{}
@@ -179,13 +196,13 @@ func getOneByFilter(filter interface{}) (*Module, error) {
 }
 
 // GetByID returns the entity of the given ID
-func GetByID(id string) (*Module, error) {
+func GetByID(ctx context.Context, id string) (*Module, error) {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return &Module{}, err
 	}
 
-	return getOneByFilter(bson.M{"_id": objID})
+	return getOneByFilter(ctx, bson.M{"_id": objID})
 }
 
 // Options represents the options for a database operation
@@ -195,7 +212,7 @@ type Options struct {
 	Offset int64
 }
 
-func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
+func getManyByFilter(ctx context.Context, filter interface{}, opts *Options) (*model.Result, error) {
 	c := database.GetDB().Collection(Collection)
 
 	findOpts := options.Find()
@@ -203,7 +220,7 @@ func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
 	findOpts.SetSkip(opts.Offset)
 	findOpts.SetSort(opts.Sort)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	var err error
@@ -249,12 +266,12 @@ func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
 }
 
 // GetAll returns a result based on filters
-func GetAll(opts *Options) (*model.Result, error) {
-	return getManyByFilter(bson.D{}, opts)
+func GetAll(ctx context.Context, opts *Options) (*model.Result, error) {
+	return getManyByFilter(ctx, bson.D{}, opts)
 }
 
 // GetByIDs returns a result by given IDs
-func GetByIDs(ids []string, opts *Options) (*model.Result, error) {
+func GetByIDs(ctx context.Context, ids []string, opts *Options) (*model.Result, error) {
 	objIDs := make([]objectID, len(ids))
 	for i, id := range ids {
 		objID, err := model.ToObjectID(id)
@@ -265,28 +282,28 @@ func GetByIDs(ids []string, opts *Options) (*model.Result, error) {
 		objIDs[i] = objID
 	}
 
-	return getManyByFilter(bson.M{"_id": bson.M{"$in": objIDs}}, opts)
+	return getManyByFilter(ctx, bson.M{"_id": bson.M{"$in": objIDs}}, opts)
 }
 
 // GetByMaterial returns a result based on stage materials
-func GetByMaterial(id string, opts *Options) (*model.Result, error) {
+func GetByMaterial(ctx context.Context, id string, opts *Options) (*model.Result, error) {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return &model.Result{}, err
 	}
 
-	return getManyByFilter(bson.D{{Key: "stages.materials.id", Value: objID}}, opts)
+	return getManyByFilter(ctx, bson.D{{Key: "stages.materials.id", Value: objID}}, opts)
 }
 
 // GetByText returns a result based on given keyword
-func GetByText(q string, opts *Options) (*model.Result, error) {
+func GetByText(ctx context.Context, q string, opts *Options) (*model.Result, error) {
 	c := database.GetDB().Collection(Collection)
 
 	findOpts := options.Find()
 	findOpts.SetLimit(opts.Limit)
 	findOpts.SetSort(opts.Sort)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	r := &model.Result{}
@@ -344,7 +361,7 @@ func GetByText(q string, opts *Options) (*model.Result, error) {
 }
 
 // Create creates a new entity
-func Create(mod *Module) error {
+func Create(ctx context.Context, mod *Module) error {
 	c := database.GetDB().Collection(Collection)
 
 	if mod.ID.IsZero() {
@@ -353,7 +370,7 @@ func Create(mod *Module) error {
 
 	mod.Modified = timestamp{Time: time.Now()}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	if _, err := c.InsertOne(ctx, mod); err != nil {
@@ -361,11 +378,14 @@ func Create(mod *Module) error {
 		return model.MongoToAPIError(err)
 	}
 
+	event.Publish(event.OperationCreate, event.ResourceHideout, eventKind, mod.ID)
+	cache.Invalidate(ctx, Collection, cache.Tag(Collection, mod.ID.Hex()))
+
 	return nil
 }
 
 // Replace replaces the data of an existing entity
-func Replace(id string, mod *Module) error {
+func Replace(ctx context.Context, id string, mod *Module) error {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return err
@@ -383,7 +403,7 @@ func Replace(id string, mod *Module) error {
 	opts.SetUpsert(false)
 	opts.SetReturnDocument(options.After)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	if err = c.FindOneAndReplace(ctx, bson.M{"_id": objID}, mod, opts).Decode(mod); err != nil {
@@ -391,11 +411,14 @@ func Replace(id string, mod *Module) error {
 		return model.MongoToAPIError(err)
 	}
 
+	event.Publish(event.OperationUpdate, event.ResourceHideout, eventKind, mod.ID)
+	cache.Invalidate(ctx, Collection, cache.Tag(Collection, mod.ID.Hex()))
+
 	return nil
 }
 
 // Remove removes an entity
-func Remove(id string) error {
+func Remove(ctx context.Context, id string) error {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return err
@@ -403,13 +426,19 @@ func Remove(id string) error {
 
 	c := database.GetDB().Collection(Collection)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	if _, err = c.DeleteOne(ctx, bson.M{"_id": objID}); err != nil {
+	res, err := c.DeleteOne(ctx, bson.M{"_id": objID})
+	if err != nil {
 		logger.Error(err)
 		return model.MongoToAPIError(err)
 	}
 
+	if res.DeletedCount > 0 {
+		event.Publish(event.OperationDelete, event.ResourceHideout, eventKind, objID)
+		cache.Invalidate(ctx, Collection, cache.Tag(Collection, objID.Hex()))
+	}
+
 	return nil
 }
@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/tarkov-database/rest-api/core/cache"
 	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/event"
+	"github.com/tarkov-database/rest-api/core/logger"
 	"github.com/tarkov-database/rest-api/model"
 	"github.com/tarkov-database/rest-api/model/item"
 
-	"github.com/google/logger"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -119,10 +121,26 @@ func (q Quest) Validate() error {
 // Collection indicates the MongoDB production collection
 const Collection = "production"
 
-func getOneByFilter(filter interface{}) (*Production, error) {
+const eventKind = "production"
+
+func init() {
+	event.RegisterSource(event.Source{
+		Collection: Collection,
+		Resource:   event.ResourceHideout,
+		Kind:       eventKind,
+	})
+
+	database.RegisterIndexes(
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "module", Value: 1}}},
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "materials.id", Value: 1}}},
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "outcome.id", Value: 1}}},
+	)
+}
+
+func getOneByFilter(ctx context.Context, filter interface{}) (*Production, error) {
 	c := database.GetDB().Collection(Collection)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	prod := &Production{}
@@ -138,13 +156,13 @@ func getOneByFilter(filter interface{}) (*Production, error) {
 }
 
 // GetByID returns the entity of the given ID
-func GetByID(id string) (*Production, error) {
+func GetByID(ctx context.Context, id string) (*Production, error) {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return &Production{}, err
 	}
 
-	return getOneByFilter(bson.M{"_id": objID})
+	return getOneByFilter(ctx, bson.M{"_id": objID})
 }
 
 // Options represents the options for a database operation
@@ -154,7 +172,7 @@ type Options struct {
 	Offset int64
 }
 
-func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
+func getManyByFilter(ctx context.Context, filter interface{}, opts *Options) (*model.Result, error) {
 	c := database.GetDB().Collection(Collection)
 
 	findOpts := options.Find()
@@ -162,7 +180,7 @@ func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
 	findOpts.SetSkip(opts.Offset)
 	findOpts.SetSort(opts.Sort)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	var err error
@@ -208,12 +226,12 @@ func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
 }
 
 // GetAll returns a result based on filters
-func GetAll(opts *Options) (*model.Result, error) {
-	return getManyByFilter(bson.D{}, opts)
+func GetAll(ctx context.Context, opts *Options) (*model.Result, error) {
+	return getManyByFilter(ctx, bson.D{}, opts)
 }
 
 // GetByIDs returns a result by given IDs
-func GetByIDs(ids []string, opts *Options) (*model.Result, error) {
+func GetByIDs(ctx context.Context, ids []string, opts *Options) (*model.Result, error) {
 	objIDs := make([]objectID, len(ids))
 	for i, id := range ids {
 		objID, err := model.ToObjectID(id)
@@ -224,41 +242,41 @@ func GetByIDs(ids []string, opts *Options) (*model.Result, error) {
 		objIDs[i] = objID
 	}
 
-	return getManyByFilter(bson.M{"_id": bson.M{"$in": objIDs}}, opts)
+	return getManyByFilter(ctx, bson.M{"_id": bson.M{"$in": objIDs}}, opts)
 }
 
 // GetByModule returns a result based on module
-func GetByModule(id string, opts *Options) (*model.Result, error) {
+func GetByModule(ctx context.Context, id string, opts *Options) (*model.Result, error) {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return &model.Result{}, err
 	}
 
-	return getManyByFilter(bson.M{"module": objID}, opts)
+	return getManyByFilter(ctx, bson.M{"module": objID}, opts)
 }
 
 // GetByMaterial returns a result based on material
-func GetByMaterial(id string, opts *Options) (*model.Result, error) {
+func GetByMaterial(ctx context.Context, id string, opts *Options) (*model.Result, error) {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return &model.Result{}, err
 	}
 
-	return getManyByFilter(bson.D{{Key: "materials.id", Value: objID}}, opts)
+	return getManyByFilter(ctx, bson.D{{Key: "materials.id", Value: objID}}, opts)
 }
 
 // GetByOutcome returns a result based on outcome
-func GetByOutcome(id string, opts *Options) (*model.Result, error) {
+func GetByOutcome(ctx context.Context, id string, opts *Options) (*model.Result, error) {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return &model.Result{}, err
 	}
 
-	return getManyByFilter(bson.D{{Key: "outcome.id", Value: objID}}, opts)
+	return getManyByFilter(ctx, bson.D{{Key: "outcome.id", Value: objID}}, opts)
 }
 
 // Create creates a new entity
-func Create(prod *Production) error {
+func Create(ctx context.Context, prod *Production) error {
 	c := database.GetDB().Collection(Collection)
 
 	if prod.ID.IsZero() {
@@ -267,7 +285,7 @@ func Create(prod *Production) error {
 
 	prod.Modified = timestamp{Time: time.Now()}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	if _, err := c.InsertOne(ctx, prod); err != nil {
@@ -275,11 +293,14 @@ func Create(prod *Production) error {
 		return model.MongoToAPIError(err)
 	}
 
+	event.Publish(event.OperationCreate, event.ResourceHideout, eventKind, prod.ID)
+	cache.Invalidate(ctx, Collection, cache.Tag(Collection, prod.ID.Hex()))
+
 	return nil
 }
 
 // Replace replaces the data of an existing entity
-func Replace(id string, prod *Production) error {
+func Replace(ctx context.Context, id string, prod *Production) error {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return err
@@ -297,7 +318,7 @@ func Replace(id string, prod *Production) error {
 	opts.SetUpsert(false)
 	opts.SetReturnDocument(options.After)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	if err = c.FindOneAndReplace(ctx, bson.M{"_id": objID}, prod, opts).Decode(prod); err != nil {
@@ -305,11 +326,14 @@ func Replace(id string, prod *Production) error {
 		return model.MongoToAPIError(err)
 	}
 
+	event.Publish(event.OperationUpdate, event.ResourceHideout, eventKind, prod.ID)
+	cache.Invalidate(ctx, Collection, cache.Tag(Collection, prod.ID.Hex()))
+
 	return nil
 }
 
 // Remove removes an entity
-func Remove(id string) error {
+func Remove(ctx context.Context, id string) error {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return err
@@ -317,13 +341,19 @@ func Remove(id string) error {
 
 	c := database.GetDB().Collection(Collection)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	if _, err = c.DeleteOne(ctx, bson.M{"_id": objID}); err != nil {
+	res, err := c.DeleteOne(ctx, bson.M{"_id": objID})
+	if err != nil {
 		logger.Error(err)
 		return model.MongoToAPIError(err)
 	}
 
+	if res.DeletedCount > 0 {
+		event.Publish(event.OperationDelete, event.ResourceHideout, eventKind, objID)
+		cache.Invalidate(ctx, Collection, cache.Tag(Collection, objID.Hex()))
+	}
+
 	return nil
 }
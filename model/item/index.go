@@ -6,9 +6,9 @@ import (
 	"time"
 
 	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/logger"
 	"github.com/tarkov-database/rest-api/model"
 
-	"github.com/google/logger"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -28,7 +28,7 @@ type KindStats struct {
 }
 
 // WithKinds fills Index with kind data
-func (i *Index) WithKinds(c *mongo.Collection) error {
+func (i *Index) WithKinds(ctx context.Context, c *mongo.Collection) error {
 	var err error
 
 	s1 := bson.M{"_modified": -1}
@@ -70,7 +70,7 @@ func (i *Index) WithKinds(c *mongo.Collection) error {
 		{{Key: "$project", Value: s3}},
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	cur, err := c.Aggregate(ctx, pipeline)
@@ -95,12 +95,12 @@ func (i *Index) WithKinds(c *mongo.Collection) error {
 }
 
 // WithoutKinds fills Index without kind data
-func (i *Index) WithoutKinds(c *mongo.Collection) error {
+func (i *Index) WithoutKinds(ctx context.Context, c *mongo.Collection) error {
 	findOpts := options.Find()
 	findOpts.SetSort(bson.M{"_modified": -1})
 	findOpts.SetLimit(1)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	var err error
@@ -145,7 +145,7 @@ func (i *Index) WithoutKinds(c *mongo.Collection) error {
 }
 
 // GetIndex returns the data of the item root endpoint
-func GetIndex(skipKinds bool) (*Index, error) {
+func GetIndex(ctx context.Context, skipKinds bool) (*Index, error) {
 	db := database.GetDB()
 	c := db.Collection(Collection)
 
@@ -154,9 +154,9 @@ func GetIndex(skipKinds bool) (*Index, error) {
 	index := &Index{}
 
 	if skipKinds {
-		err = index.WithoutKinds(c)
+		err = index.WithoutKinds(ctx, c)
 	} else {
-		err = index.WithKinds(c)
+		err = index.WithKinds(ctx, c)
 	}
 
 	return index, err
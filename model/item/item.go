@@ -7,10 +7,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/tarkov-database/rest-api/core/cache"
 	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/event"
+	"github.com/tarkov-database/rest-api/core/logger"
 	"github.com/tarkov-database/rest-api/model"
 
-	"github.com/google/logger"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -20,10 +22,28 @@ import (
 // Collection indicates the MongoDB item collection
 const Collection = "items"
 
-func getOneByFilter(filter interface{}, k Kind) (Entity, error) {
+func init() {
+	event.RegisterSource(event.Source{
+		Collection: Collection,
+		Resource:   event.ResourceItem,
+		KindField:  "_kind",
+	})
+
+	database.RegisterIndexes(
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "_kind", Value: 1}, {Key: "_modified", Value: -1}}},
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "_modified", Value: -1}}},
+		database.Index{Collection: Collection, Keys: bson.D{
+			{Key: "name", Value: "text"},
+			{Key: "shortName", Value: "text"},
+			{Key: "description", Value: "text"},
+		}},
+	)
+}
+
+func getOneByFilter(ctx context.Context, filter interface{}, k Kind) (Entity, error) {
 	c := database.GetDB().Collection(Collection)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	e, err := k.GetEntity()
@@ -42,13 +62,40 @@ func getOneByFilter(filter interface{}, k Kind) (Entity, error) {
 }
 
 // GetByID returns the entity of the given ID
-func GetByID(id string, k Kind) (Entity, error) {
+func GetByID(ctx context.Context, id string, k Kind) (Entity, error) {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return nil, err
 	}
 
-	return getOneByFilter(bson.M{"_id": objID, "_kind": k}, k)
+	return getOneByFilter(ctx, bson.M{"_id": objID, "_kind": k}, k)
+}
+
+// GetKindByID returns the kind of the entity of the given ID
+func GetKindByID(ctx context.Context, id string) (Kind, error) {
+	objID, err := model.ToObjectID(id)
+	if err != nil {
+		return "", err
+	}
+
+	c := database.GetDB().Collection(Collection)
+
+	opts := options.FindOne()
+	opts.SetProjection(bson.M{"_kind": 1})
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	e := &Item{}
+
+	if err := c.FindOne(ctx, bson.M{"_id": objID}, opts).Decode(e); err != nil {
+		if err != mongo.ErrNoDocuments {
+			logger.Error(err)
+		}
+		return "", model.MongoToAPIError(err)
+	}
+
+	return e.GetKind(), nil
 }
 
 // Options represents the options for a database operation
@@ -58,7 +105,7 @@ type Options struct {
 	Offset int64
 }
 
-func getManyByFilter(filter interface{}, k Kind, opts *Options) (*model.Result, error) {
+func getManyByFilter(ctx context.Context, filter interface{}, k Kind, opts *Options) (*model.Result, error) {
 	c := database.GetDB().Collection(Collection)
 
 	findOpts := options.Find()
@@ -66,7 +113,7 @@ func getManyByFilter(filter interface{}, k Kind, opts *Options) (*model.Result,
 	findOpts.SetSkip(opts.Offset)
 	findOpts.SetSort(opts.Sort)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	var err error
@@ -116,17 +163,17 @@ func getManyByFilter(filter interface{}, k Kind, opts *Options) (*model.Result,
 }
 
 // GetAll returns a result based on filters
-func GetAll(filter model.DocumentFilter, k Kind, opts *Options) (*model.Result, error) {
+func GetAll(ctx context.Context, filter model.DocumentFilter, k Kind, opts *Options) (*model.Result, error) {
 	f := bson.D{{Key: "_kind", Value: k}}
 	if filter != nil {
 		f = append(f, filter.Filter()...)
 	}
 
-	return getManyByFilter(f, k, opts)
+	return getManyByFilter(ctx, f, k, opts)
 }
 
 // GetByIDs returns a result by given IDs
-func GetByIDs(ids []string, k Kind, opts *Options) (*model.Result, error) {
+func GetByIDs(ctx context.Context, ids []string, k Kind, opts *Options) (*model.Result, error) {
 	objIDs := make([]objectID, len(ids))
 	for i, id := range ids {
 		objID, err := model.ToObjectID(id)
@@ -137,18 +184,18 @@ func GetByIDs(ids []string, k Kind, opts *Options) (*model.Result, error) {
 		objIDs[i] = objID
 	}
 
-	return getManyByFilter(bson.M{"_id": bson.M{"$in": objIDs}, "_kind": k}, k, opts)
+	return getManyByFilter(ctx, bson.M{"_id": bson.M{"$in": objIDs}, "_kind": k}, k, opts)
 }
 
 // GetByText returns a result based on given keyword
-func GetByText(q string, opts *Options, kind Kind) (*model.Result, error) {
+func GetByText(ctx context.Context, q string, opts *Options, kind Kind) (*model.Result, error) {
 	c := database.GetDB().Collection(Collection)
 
 	findOpts := options.Find()
 	findOpts.SetLimit(opts.Limit)
 	findOpts.SetSort(opts.Sort)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	r := &model.Result{}
@@ -215,7 +262,7 @@ func GetByText(q string, opts *Options, kind Kind) (*model.Result, error) {
 }
 
 // Create creates a new entity
-func Create(e Entity) error {
+func Create(ctx context.Context, e Entity) error {
 	c := database.GetDB().Collection(Collection)
 
 	if e.GetID().IsZero() {
@@ -224,7 +271,7 @@ func Create(e Entity) error {
 
 	e.SetModified(timestamp{Time: time.Now()})
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	if _, err := c.InsertOne(ctx, e); err != nil {
@@ -232,11 +279,14 @@ func Create(e Entity) error {
 		return model.MongoToAPIError(err)
 	}
 
+	event.Publish(event.OperationCreate, event.ResourceItem, e.GetKind().String(), e.GetID())
+	invalidate(ctx, e.GetKind(), e.GetID())
+
 	return nil
 }
 
 // Replace replaces the data of an existing entity
-func Replace(id string, e Entity) error {
+func Replace(ctx context.Context, id string, e Entity) error {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return err
@@ -254,7 +304,7 @@ func Replace(id string, e Entity) error {
 	opts.SetUpsert(false)
 	opts.SetReturnDocument(options.After)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	filter := bson.M{"_kind": e.GetKind(), "_id": objID}
@@ -263,11 +313,14 @@ func Replace(id string, e Entity) error {
 		return model.MongoToAPIError(err)
 	}
 
+	event.Publish(event.OperationUpdate, event.ResourceItem, e.GetKind().String(), e.GetID())
+	invalidate(ctx, e.GetKind(), e.GetID())
+
 	return nil
 }
 
 // Remove removes an entity
-func Remove(id string) error {
+func Remove(ctx context.Context, id string) error {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return err
@@ -275,13 +328,30 @@ func Remove(id string) error {
 
 	c := database.GetDB().Collection(Collection)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	opts := options.FindOneAndDelete()
+	opts.SetProjection(bson.M{"_kind": 1})
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	if _, err := c.DeleteOne(ctx, bson.M{"_id": objID}); err != nil {
+	deleted := &Item{}
+
+	if err := c.FindOneAndDelete(ctx, bson.M{"_id": objID}, opts).Decode(deleted); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil
+		}
 		logger.Error(err)
 		return model.MongoToAPIError(err)
 	}
 
+	event.Publish(event.OperationDelete, event.ResourceItem, deleted.GetKind().String(), objID)
+	invalidate(ctx, deleted.GetKind(), objID)
+
 	return nil
 }
+
+// invalidate removes the cached responses depending on the entity, which
+// are the index, the list of its kind and the entity itself
+func invalidate(ctx context.Context, k Kind, id objectID) {
+	cache.Invalidate(ctx, Collection, cache.Tag(Collection, k.String()), cache.Tag(Collection, id.Hex()))
+}
@@ -28,7 +28,6 @@ type Ammunition struct {
 	Fragmentation       AmmoFrag               `json:"fragmentation" bson:"fragmentation"`
 	Effects             AmmoEffects            `json:"effects" bson:"effects"`
 	Projectiles         int64                  `json:"projectiles" bson:"projectiles"`
-	Pellets             int64                  `json:"pellets,omitempty" bson:"pellets,omitempty"` // Deprecated: no longer used
 	MisfireChance       float64                `json:"misfireChance" bson:"misfireChance"`
 	FailureToFeedChance float64                `json:"failureToFeedChance" bson:"failureToFeedChance"`
 	WeaponModifier      WeaponModifier         `json:"weaponModifier" bson:"weaponModifier"`
@@ -22,10 +22,8 @@ type Item struct {
 	Name        string    `json:"name" bson:"name"`
 	ShortName   string    `json:"shortName" bson:"shortName"`
 	Description string    `json:"description" bson:"description"`
-	Price       int64     `json:"price" bson:"price"` // Deprecated: no longer supported
 	Weight      float64   `json:"weight" bson:"weight"`
 	MaxStack    int64     `json:"maxStack" bson:"maxStack"`
-	Rarity      string    `json:"rarity" bson:"rarity"` // Deprecated: no longer supported
 	Grid        GridProps `json:"grid" bson:"grid"`
 	Modified    timestamp `json:"_modified" bson:"_modified"`
 	Kind        Kind      `json:"_kind" bson:"_kind"`
@@ -72,18 +70,12 @@ func (i *Item) Validate() error {
 	if len(i.Description) < 8 {
 		return errors.New("description is too short or not set")
 	}
-	if i.Price < 0 {
-		return errors.New("no negative price allowed")
-	}
 	if i.Weight < 0 {
 		return errors.New("weight is too low or not set")
 	}
 	if i.MaxStack < 1 {
 		return errors.New("maximum stack is too low or not set")
 	}
-	if i.Rarity == "" {
-		return errors.New("rarity is not set")
-	}
 	if !i.Kind.IsValid() {
 		return model.ErrInvalidKind
 	}
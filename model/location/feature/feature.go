@@ -8,10 +8,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/tarkov-database/rest-api/core/cache"
 	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/event"
+	"github.com/tarkov-database/rest-api/core/logger"
 	"github.com/tarkov-database/rest-api/model"
 
-	"github.com/google/logger"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -46,10 +48,30 @@ func (f Feature) Validate() error {
 // Collection indicates the MongoDB feature collection
 const Collection = "features"
 
-func getOneByFilter(filter interface{}) (*Feature, error) {
+const eventKind = "feature"
+
+func init() {
+	event.RegisterSource(event.Source{
+		Collection: Collection,
+		Resource:   event.ResourceLocation,
+		Kind:       eventKind,
+	})
+
+	database.RegisterIndexes(
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "_location", Value: 1}, {Key: "group", Value: 1}}},
+		database.Index{
+			Collection: Collection,
+			Keys:       bson.D{{Key: "geometry", Value: "2dsphere"}},
+			Requires:   "all geometries must be valid GeoJSON with coordinates in longitude and latitude",
+		},
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "name", Value: "text"}, {Key: "description", Value: "text"}}},
+	)
+}
+
+func getOneByFilter(ctx context.Context, filter interface{}) (*Feature, error) {
 	c := database.GetDB().Collection(Collection)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	ft := &Feature{}
@@ -65,7 +87,7 @@ func getOneByFilter(filter interface{}) (*Feature, error) {
 }
 
 // GetByID returns the entity of the given ID
-func GetByID(id, loc string) (*Feature, error) {
+func GetByID(ctx context.Context, id, loc string) (*Feature, error) {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return &Feature{}, err
@@ -76,7 +98,7 @@ func GetByID(id, loc string) (*Feature, error) {
 		return &Feature{}, err
 	}
 
-	return getOneByFilter(bson.M{"_id": objID, "_location": lID})
+	return getOneByFilter(ctx, bson.M{"_id": objID, "_location": lID})
 }
 
 // Options represents the options for a database operation
@@ -86,7 +108,7 @@ type Options struct {
 	Offset int64
 }
 
-func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
+func getManyByFilter(ctx context.Context, filter interface{}, opts *Options) (*model.Result, error) {
 	c := database.GetDB().Collection(Collection)
 
 	findOpts := options.Find()
@@ -94,7 +116,7 @@ func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
 	findOpts.SetSkip(opts.Offset)
 	findOpts.SetSort(opts.Sort)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	var err error
@@ -140,17 +162,17 @@ func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
 }
 
 // GetAll returns a result based on filters
-func GetAll(loc string, opts *Options) (*model.Result, error) {
+func GetAll(ctx context.Context, loc string, opts *Options) (*model.Result, error) {
 	lID, err := model.ToObjectID(loc)
 	if err != nil {
 		return &model.Result{}, err
 	}
 
-	return getManyByFilter(bson.M{"_location": lID}, opts)
+	return getManyByFilter(ctx, bson.M{"_location": lID}, opts)
 }
 
 // GetByGroup returns a result based on feature group
-func GetByGroup(id, loc string, opts *Options) (*model.Result, error) {
+func GetByGroup(ctx context.Context, id, loc string, opts *Options) (*model.Result, error) {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return &model.Result{}, err
@@ -161,11 +183,11 @@ func GetByGroup(id, loc string, opts *Options) (*model.Result, error) {
 		return &model.Result{}, err
 	}
 
-	return getManyByFilter(bson.M{"group": objID, "_location": lID}, opts)
+	return getManyByFilter(ctx, bson.M{"group": objID, "_location": lID}, opts)
 }
 
 // GetByText returns a result based on given keyword
-func GetByText(q, loc string, opts *Options) (*model.Result, error) {
+func GetByText(ctx context.Context, q, loc string, opts *Options) (*model.Result, error) {
 	c := database.GetDB().Collection(Collection)
 
 	lID, err := model.ToObjectID(loc)
@@ -177,7 +199,7 @@ func GetByText(q, loc string, opts *Options) (*model.Result, error) {
 	findOpts.SetLimit(opts.Limit)
 	findOpts.SetSort(opts.Sort)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	r := &model.Result{}
@@ -237,7 +259,7 @@ func GetByText(q, loc string, opts *Options) (*model.Result, error) {
 }
 
 // Create creates a new entity
-func Create(ft *Feature) error {
+func Create(ctx context.Context, ft *Feature) error {
 	c := database.GetDB().Collection(Collection)
 
 	if ft.ID.IsZero() {
@@ -246,7 +268,7 @@ func Create(ft *Feature) error {
 
 	ft.Modified = timestamp{Time: time.Now()}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	if _, err := c.InsertOne(ctx, ft); err != nil {
@@ -254,20 +276,34 @@ func Create(ft *Feature) error {
 		return model.MongoToAPIError(err)
 	}
 
+	event.Publish(event.OperationCreate, event.ResourceLocation, eventKind, ft.ID)
+	cache.Invalidate(ctx, cache.Tag(Collection, ft.Location.Hex()), cache.Tag(Collection, ft.ID.Hex()))
+
 	return nil
 }
 
-// Replace replaces the data of an existing entity
-func Replace(id string, ft *Feature) error {
+// Replace replaces the data of an existing entity of the location
+func Replace(ctx context.Context, id, loc string, ft *Feature) error {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return err
 	}
 
+	lID, err := model.ToObjectID(loc)
+	if err != nil {
+		return err
+	}
+
 	if ft.ID.IsZero() {
 		ft.ID = objID
 	}
 
+	if ft.Location.IsZero() {
+		ft.Location = lID
+	} else if ft.Location != lID {
+		return model.ErrInvalidInput
+	}
+
 	ft.Modified = timestamp{Time: time.Now()}
 
 	c := database.GetDB().Collection(Collection)
@@ -276,33 +312,49 @@ func Replace(id string, ft *Feature) error {
 	opts.SetUpsert(false)
 	opts.SetReturnDocument(options.After)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	if err = c.FindOneAndReplace(ctx, bson.M{"_id": objID}, ft, opts).Decode(ft); err != nil {
+	if err = c.FindOneAndReplace(ctx, bson.M{"_id": objID, "_location": lID}, ft, opts).Decode(ft); err != nil {
 		logger.Error(err)
 		return model.MongoToAPIError(err)
 	}
 
+	event.Publish(event.OperationUpdate, event.ResourceLocation, eventKind, ft.ID)
+	cache.Invalidate(ctx, cache.Tag(Collection, lID.Hex()), cache.Tag(Collection, objID.Hex()))
+
 	return nil
 }
 
-// Remove removes an entity
-func Remove(id string) error {
+// Remove removes an entity of the location
+func Remove(ctx context.Context, id, loc string) error {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return err
 	}
 
+	lID, err := model.ToObjectID(loc)
+	if err != nil {
+		return err
+	}
+
 	c := database.GetDB().Collection(Collection)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	if _, err = c.DeleteOne(ctx, bson.M{"_id": objID}); err != nil {
+	res, err := c.DeleteOne(ctx, bson.M{"_id": objID, "_location": lID})
+	if err != nil {
 		logger.Error(err)
 		return model.MongoToAPIError(err)
 	}
 
+	if res.DeletedCount == 0 {
+		return model.ErrNoResult
+	}
+
+	event.Publish(event.OperationDelete, event.ResourceLocation, eventKind, objID)
+	cache.Invalidate(ctx, cache.Tag(Collection, lID.Hex()), cache.Tag(Collection, objID.Hex()))
+
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
 )
 
@@ -15,7 +16,8 @@ var (
 	// ErrUnknownGeometryType indicates that a GeoJSON geometry type is invalid
 	ErrUnknownGeometryType = errors.New("unknown geometry type")
 
-	// ErrBadGeometryCoords indicates that GeoJSON geometry coordinates are ivnalid
+	// ErrBadGeometryCoords indicates that GeoJSON geometry coordinates are
+	// invalid or not longitudes and latitudes
 	ErrBadGeometryCoords = errors.New("bad geometry coordinates")
 
 	// ErrBadGeometryCollection indicates that a GeoJSON geometry collection are invalid
@@ -108,26 +110,106 @@ func (g *GeometryType) UnmarshalBSONValue(t bsontype.Type, b []byte) error {
 // Coordinates ...
 type Coordinates []interface{}
 
-func isLongLat(a []interface{}) bool {
-	if len(a) != 2 {
+// array returns the elements of a coordinates array
+func array(v interface{}) ([]interface{}, bool) {
+	switch a := v.(type) {
+	case Coordinates:
+		return a, true
+	case []interface{}:
+		return a, true
+	case primitive.A:
+		return a, true
+	}
+
+	return nil, false
+}
+
+func number(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	}
+
+	return 0, false
+}
+
+// position returns the longitude and latitude of a position, which have to
+// be in range as required by the 2dsphere index
+func position(v interface{}) (lon, lat float64, ok bool) {
+	a, ok := array(v)
+	if !ok || len(a) != 2 {
+		return 0, 0, false
+	}
+
+	lon, okLon := number(a[0])
+	lat, okLat := number(a[1])
+	if !okLon || !okLat {
+		return 0, 0, false
+	}
+
+	return lon, lat, lon >= -180 && lon <= 180 && lat >= -90 && lat <= 90
+}
+
+func isPosition(v interface{}) bool {
+	_, _, ok := position(v)
+	return ok
+}
+
+// isPositions reports whether the value is an array of at least min positions
+func isPositions(v interface{}, min int) bool {
+	a, ok := array(v)
+	if !ok || len(a) < min {
 		return false
 	}
 
+	for _, p := range a {
+		if !isPosition(p) {
+			return false
+		}
+	}
+
 	return true
 }
 
-func isCoords(a []interface{}) bool {
-	for _, v := range a {
-		var ok bool
+// isLine reports whether the value is the array of positions of a line string
+func isLine(v interface{}) bool {
+	return isPositions(v, 2)
+}
+
+// isRing reports whether the value is a closed linear ring of at least four
+// positions
+func isRing(v interface{}) bool {
+	if !isPositions(v, 4) {
+		return false
+	}
 
-		switch v := v.(type) {
-		case []interface{}:
-			ok = isCoords(v)
-		case float64:
-			ok = isLongLat(a)
-		}
+	a, _ := array(v)
+	firstLon, firstLat, _ := position(a[0])
+	lastLon, lastLat, _ := position(a[len(a)-1])
 
-		if !ok {
+	return firstLon == lastLon && firstLat == lastLat
+}
+
+// isPolygon reports whether the value is the array of linear rings of a
+// polygon
+func isPolygon(v interface{}) bool {
+	return isEach(v, isRing)
+}
+
+// isEach reports whether the value is a non-empty array of which each
+// element is valid
+func isEach(v interface{}, valid func(interface{}) bool) bool {
+	a, ok := array(v)
+	if !ok || len(a) == 0 {
+		return false
+	}
+
+	for _, e := range a {
+		if !valid(e) {
 			return false
 		}
 	}
@@ -142,27 +224,34 @@ type Geometry struct {
 	Geometries  []Geometry   `json:"geometries,omitempty" bson:"geometries,omitempty"`
 }
 
-// Validate validates a GeoJSON geometry object
+// Validate validates a GeoJSON geometry object by the rules of the 2dsphere
+// index, so that a valid geometry can be stored
 func (g Geometry) Validate() error {
 	var ok bool
 
 	switch g.Type {
 	case Point:
-		ok = isCoords(g.Coordinates)
+		ok = isPosition(g.Coordinates)
 	case MultiPoint:
-		ok = isCoords(g.Coordinates)
+		ok = isPositions(g.Coordinates, 1)
 	case LineString:
-		ok = isCoords(g.Coordinates)
+		ok = isLine(g.Coordinates)
 	case MultiLineString:
-		ok = isCoords(g.Coordinates)
+		ok = isEach(g.Coordinates, isLine)
 	case Polygon:
-		ok = isCoords(g.Coordinates)
+		ok = isPolygon(g.Coordinates)
 	case MultiPolygon:
-		ok = isCoords(g.Coordinates)
+		ok = isEach(g.Coordinates, isPolygon)
 	case GeometryCollection:
 		if len(g.Geometries) == 0 {
 			return ErrBadGeometryCollection
 		}
+		for _, m := range g.Geometries {
+			if err := m.Validate(); err != nil {
+				return err
+			}
+		}
+		ok = true
 	default:
 		return ErrUnknownGeometryType
 	}
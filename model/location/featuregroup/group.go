@@ -8,10 +8,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/tarkov-database/rest-api/core/cache"
 	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/event"
+	"github.com/tarkov-database/rest-api/core/logger"
 	"github.com/tarkov-database/rest-api/model"
 
-	"github.com/google/logger"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -50,10 +52,25 @@ func (g Group) Validate() error {
 // Collection indicates the MongoDB feature group collection
 const Collection = "featureGroups"
 
-func getOneByFilter(filter interface{}) (*Group, error) {
+const eventKind = "featuregroup"
+
+func init() {
+	event.RegisterSource(event.Source{
+		Collection: Collection,
+		Resource:   event.ResourceLocation,
+		Kind:       eventKind,
+	})
+
+	database.RegisterIndexes(
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "_location", Value: 1}, {Key: "tags", Value: 1}}},
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "name", Value: "text"}, {Key: "description", Value: "text"}}},
+	)
+}
+
+func getOneByFilter(ctx context.Context, filter interface{}) (*Group, error) {
 	c := database.GetDB().Collection(Collection)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	ft := &Group{}
@@ -69,7 +86,7 @@ func getOneByFilter(filter interface{}) (*Group, error) {
 }
 
 // GetByID returns the entity of the given ID
-func GetByID(id, loc string) (*Group, error) {
+func GetByID(ctx context.Context, id, loc string) (*Group, error) {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return &Group{}, err
@@ -80,7 +97,7 @@ func GetByID(id, loc string) (*Group, error) {
 		return &Group{}, err
 	}
 
-	return getOneByFilter(bson.M{"_id": objID, "_location": lID})
+	return getOneByFilter(ctx, bson.M{"_id": objID, "_location": lID})
 }
 
 // Options represents the options for a database operation
@@ -90,7 +107,7 @@ type Options struct {
 	Offset int64
 }
 
-func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
+func getManyByFilter(ctx context.Context, filter interface{}, opts *Options) (*model.Result, error) {
 	c := database.GetDB().Collection(Collection)
 
 	findOpts := options.Find()
@@ -98,7 +115,7 @@ func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
 	findOpts.SetSkip(opts.Offset)
 	findOpts.SetSort(opts.Sort)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	var err error
@@ -144,27 +161,27 @@ func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
 }
 
 // GetAll returns a result based on filters
-func GetAll(loc string, opts *Options) (*model.Result, error) {
+func GetAll(ctx context.Context, loc string, opts *Options) (*model.Result, error) {
 	lID, err := model.ToObjectID(loc)
 	if err != nil {
 		return &model.Result{}, err
 	}
 
-	return getManyByFilter(bson.M{"_location": lID}, opts)
+	return getManyByFilter(ctx, bson.M{"_location": lID}, opts)
 }
 
 // GetByTags returns a result based on tag
-func GetByTags(tags []string, loc string, opts *Options) (*model.Result, error) {
+func GetByTags(ctx context.Context, tags []string, loc string, opts *Options) (*model.Result, error) {
 	lID, err := model.ToObjectID(loc)
 	if err != nil {
 		return &model.Result{}, err
 	}
 
-	return getManyByFilter(bson.M{"tags": bson.M{"$all": tags}, "_location": lID}, opts)
+	return getManyByFilter(ctx, bson.M{"tags": bson.M{"$all": tags}, "_location": lID}, opts)
 }
 
 // GetByText returns a result based on given keyword
-func GetByText(q, loc string, opts *Options) (*model.Result, error) {
+func GetByText(ctx context.Context, q, loc string, opts *Options) (*model.Result, error) {
 	c := database.GetDB().Collection(Collection)
 
 	lID, err := model.ToObjectID(loc)
@@ -176,7 +193,7 @@ func GetByText(q, loc string, opts *Options) (*model.Result, error) {
 	findOpts.SetLimit(opts.Limit)
 	findOpts.SetSort(opts.Sort)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	r := &model.Result{}
@@ -239,7 +256,7 @@ func GetByText(q, loc string, opts *Options) (*model.Result, error) {
 }
 
 // Create creates a new entity
-func Create(ft *Group) error {
+func Create(ctx context.Context, ft *Group) error {
 	c := database.GetDB().Collection(Collection)
 
 	if ft.ID.IsZero() {
@@ -248,7 +265,7 @@ func Create(ft *Group) error {
 
 	ft.Modified = timestamp{Time: time.Now()}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	if _, err := c.InsertOne(ctx, ft); err != nil {
@@ -256,20 +273,34 @@ func Create(ft *Group) error {
 		return model.MongoToAPIError(err)
 	}
 
+	event.Publish(event.OperationCreate, event.ResourceLocation, eventKind, ft.ID)
+	cache.Invalidate(ctx, cache.Tag(Collection, ft.Location.Hex()), cache.Tag(Collection, ft.ID.Hex()))
+
 	return nil
 }
 
-// Replace replaces the data of an existing entity
-func Replace(id string, fg *Group) error {
+// Replace replaces the data of an existing entity of the location
+func Replace(ctx context.Context, id, loc string, fg *Group) error {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return err
 	}
 
+	lID, err := model.ToObjectID(loc)
+	if err != nil {
+		return err
+	}
+
 	if fg.ID.IsZero() {
 		fg.ID = objID
 	}
 
+	if fg.Location.IsZero() {
+		fg.Location = lID
+	} else if fg.Location != lID {
+		return model.ErrInvalidInput
+	}
+
 	fg.Modified = timestamp{Time: time.Now()}
 
 	c := database.GetDB().Collection(Collection)
@@ -278,33 +309,49 @@ func Replace(id string, fg *Group) error {
 	opts.SetUpsert(false)
 	opts.SetReturnDocument(options.After)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	if err = c.FindOneAndReplace(ctx, bson.M{"_id": objID}, fg, opts).Decode(fg); err != nil {
+	if err = c.FindOneAndReplace(ctx, bson.M{"_id": objID, "_location": lID}, fg, opts).Decode(fg); err != nil {
 		logger.Error(err)
 		return model.MongoToAPIError(err)
 	}
 
+	event.Publish(event.OperationUpdate, event.ResourceLocation, eventKind, fg.ID)
+	cache.Invalidate(ctx, cache.Tag(Collection, lID.Hex()), cache.Tag(Collection, objID.Hex()))
+
 	return nil
 }
 
-// Remove removes an entity
-func Remove(id string) error {
+// Remove removes an entity of the location
+func Remove(ctx context.Context, id, loc string) error {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return err
 	}
 
+	lID, err := model.ToObjectID(loc)
+	if err != nil {
+		return err
+	}
+
 	c := database.GetDB().Collection(Collection)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	if _, err = c.DeleteOne(ctx, bson.M{"_id": objID}); err != nil {
+	res, err := c.DeleteOne(ctx, bson.M{"_id": objID, "_location": lID})
+	if err != nil {
 		logger.Error(err)
 		return model.MongoToAPIError(err)
 	}
 
+	if res.DeletedCount == 0 {
+		return model.ErrNoResult
+	}
+
+	event.Publish(event.OperationDelete, event.ResourceLocation, eventKind, objID)
+	cache.Invalidate(ctx, cache.Tag(Collection, lID.Hex()), cache.Tag(Collection, objID.Hex()))
+
 	return nil
 }
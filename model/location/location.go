@@ -8,10 +8,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/tarkov-database/rest-api/core/cache"
 	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/event"
+	"github.com/tarkov-database/rest-api/core/logger"
 	"github.com/tarkov-database/rest-api/model"
 
-	"github.com/google/logger"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -77,10 +79,24 @@ type Boss struct {
 // Collection indicates the MongoDB location collection
 const Collection = "locations"
 
-func getOneByFilter(filter interface{}) (*Location, error) {
+const eventKind = "location"
+
+func init() {
+	event.RegisterSource(event.Source{
+		Collection: Collection,
+		Resource:   event.ResourceLocation,
+		Kind:       eventKind,
+	})
+
+	database.RegisterIndexes(
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "name", Value: "text"}, {Key: "description", Value: "text"}}},
+	)
+}
+
+func getOneByFilter(ctx context.Context, filter interface{}) (*Location, error) {
 	c := database.GetDB().Collection(Collection)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	loc := &Location{}
@@ -96,13 +112,13 @@ func getOneByFilter(filter interface{}) (*Location, error) {
 }
 
 // GetByID returns the entity of the given ID
-func GetByID(id string) (*Location, error) {
+func GetByID(ctx context.Context, id string) (*Location, error) {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return &Location{}, err
 	}
 
-	return getOneByFilter(bson.M{"_id": objID})
+	return getOneByFilter(ctx, bson.M{"_id": objID})
 }
 
 // Options represents the options for a database operation
@@ -112,7 +128,7 @@ type Options struct {
 	Offset int64
 }
 
-func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
+func getManyByFilter(ctx context.Context, filter interface{}, opts *Options) (*model.Result, error) {
 	c := database.GetDB().Collection(Collection)
 
 	findOpts := options.Find()
@@ -120,7 +136,7 @@ func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
 	findOpts.SetSkip(opts.Offset)
 	findOpts.SetSort(opts.Sort)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	var err error
@@ -166,24 +182,24 @@ func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
 }
 
 // GetAll returns a result based on filters
-func GetAll(opts *Options) (*model.Result, error) {
-	return getManyByFilter(bson.D{}, opts)
+func GetAll(ctx context.Context, opts *Options) (*model.Result, error) {
+	return getManyByFilter(ctx, bson.D{}, opts)
 }
 
 // GetByAvailability returns a result based on availability
-func GetByAvailability(a bool, opts *Options) (*model.Result, error) {
-	return getManyByFilter(bson.M{"available": a}, opts)
+func GetByAvailability(ctx context.Context, a bool, opts *Options) (*model.Result, error) {
+	return getManyByFilter(ctx, bson.M{"available": a}, opts)
 }
 
 // GetByText returns a result based on given keyword
-func GetByText(q string, opts *Options) (*model.Result, error) {
+func GetByText(ctx context.Context, q string, opts *Options) (*model.Result, error) {
 	c := database.GetDB().Collection(Collection)
 
 	findOpts := options.Find()
 	findOpts.SetLimit(opts.Limit)
 	findOpts.SetSort(opts.Sort)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	r := &model.Result{}
@@ -242,7 +258,7 @@ func GetByText(q string, opts *Options) (*model.Result, error) {
 }
 
 // Create creates a new entity
-func Create(loc *Location) error {
+func Create(ctx context.Context, loc *Location) error {
 	c := database.GetDB().Collection(Collection)
 
 	if loc.ID.IsZero() {
@@ -251,7 +267,7 @@ func Create(loc *Location) error {
 
 	loc.Modified = timestamp{Time: time.Now()}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	if _, err := c.InsertOne(ctx, loc); err != nil {
@@ -259,11 +275,14 @@ func Create(loc *Location) error {
 		return model.MongoToAPIError(err)
 	}
 
+	event.Publish(event.OperationCreate, event.ResourceLocation, eventKind, loc.ID)
+	cache.Invalidate(ctx, Collection, cache.Tag(Collection, loc.ID.Hex()))
+
 	return nil
 }
 
 // Replace replaces the data of an existing entity
-func Replace(id string, loc *Location) error {
+func Replace(ctx context.Context, id string, loc *Location) error {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return err
@@ -281,7 +300,7 @@ func Replace(id string, loc *Location) error {
 	opts.SetUpsert(false)
 	opts.SetReturnDocument(options.After)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	if err = c.FindOneAndReplace(ctx, bson.M{"_id": objID}, loc, opts).Decode(loc); err != nil {
@@ -289,11 +308,14 @@ func Replace(id string, loc *Location) error {
 		return model.MongoToAPIError(err)
 	}
 
+	event.Publish(event.OperationUpdate, event.ResourceLocation, eventKind, loc.ID)
+	cache.Invalidate(ctx, Collection, cache.Tag(Collection, loc.ID.Hex()))
+
 	return nil
 }
 
 // Remove removes an entity
-func Remove(id string) error {
+func Remove(ctx context.Context, id string) error {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return err
@@ -301,13 +323,19 @@ func Remove(id string) error {
 
 	c := database.GetDB().Collection(Collection)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	if _, err = c.DeleteOne(ctx, bson.M{"_id": objID}); err != nil {
+	res, err := c.DeleteOne(ctx, bson.M{"_id": objID})
+	if err != nil {
 		logger.Error(err)
 		return model.MongoToAPIError(err)
 	}
 
+	if res.DeletedCount > 0 {
+		event.Publish(event.OperationDelete, event.ResourceLocation, eventKind, objID)
+		cache.Invalidate(ctx, Collection, cache.Tag(Collection, objID.Hex()))
+	}
+
 	return nil
 }
@@ -46,6 +46,7 @@ type Response struct {
 	Status     string `json:"status"`
 	Message    string `json:"message"`
 	StatusCode int    `json:"code"`
+	RequestID  string `json:"requestId,omitempty"`
 }
 
 // NewResponse creates a new status response based on parameters
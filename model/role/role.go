@@ -0,0 +1,333 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/model/user"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	// ErrInvalidName indicates that the name of a role is not valid
+	ErrInvalidName = errors.New("invalid role name")
+
+	// ErrBuiltin indicates that a built-in role can't be changed
+	ErrBuiltin = errors.New("built-in roles can't be changed")
+
+	// ErrUnknownRole indicates that a role does not exist
+	ErrUnknownRole = errors.New("unknown role")
+)
+
+type timestamp = model.Timestamp
+
+const (
+	// Viewer is the built-in role with read access to the game data
+	Viewer = "viewer"
+
+	// Editor is the built-in role with read and write access to the game data
+	Editor = "editor"
+
+	// Admin is the built-in role with all permissions
+	Admin = "admin"
+)
+
+// builtin holds the roles which are defined by the service
+var builtin = map[string]*Role{
+	Viewer: {
+		ID:          Viewer,
+		Description: "Read access to the game data",
+		Scope: []string{
+			jwt.ScopeItemRead,
+			jwt.ScopeHideoutRead,
+			jwt.ScopeLocationRead,
+			jwt.ScopeStatisticRead,
+		},
+		Builtin: true,
+	},
+	Editor: {
+		ID:          Editor,
+		Description: "Read and write access to the game data",
+		Scope: []string{
+			jwt.ScopeItemRead,
+			jwt.ScopeItemWrite,
+			jwt.ScopeHideoutRead,
+			jwt.ScopeHideoutWrite,
+			jwt.ScopeLocationRead,
+			jwt.ScopeLocationWrite,
+			jwt.ScopeStatisticRead,
+			jwt.ScopeStatisticWrite,
+		},
+		Builtin: true,
+	},
+	Admin: {
+		ID:          Admin,
+		Description: "All permissions",
+		Scope: []string{
+			jwt.ScopeAllRead,
+			jwt.ScopeAllWrite,
+		},
+		Builtin: true,
+	},
+}
+
+var namePattern = regexp.MustCompile(`^[a-z][a-z0-9-]{1,31}$`)
+
+// Role describes the entity of a role, which is a named set of scopes.
+// Roles other than the built-in ones are stored in the database.
+type Role struct {
+	ID          string    `json:"_id" bson:"_id"`
+	Description string    `json:"description" bson:"description"`
+	Scope       []string  `json:"scope" bson:"scope"`
+	Builtin     bool      `json:"builtin" bson:"-"`
+	Modified    timestamp `json:"_modified" bson:"_modified"`
+}
+
+// Validate validates the fields of a role
+func (r *Role) Validate() error {
+	if !namePattern.MatchString(r.ID) {
+		return ErrInvalidName
+	}
+
+	if len(r.Scope) == 0 {
+		return jwt.ErrInvalidScope
+	}
+
+	return r.Claims().Validate()
+}
+
+// Claims returns claims with the scopes of the role
+func (r *Role) Claims() *jwt.Claims {
+	return &jwt.Claims{Scope: r.Scope}
+}
+
+// IsBuiltin checks if the name belongs to a built-in role
+func IsBuiltin(name string) bool {
+	_, ok := builtin[name]
+	return ok
+}
+
+// Collection indicates the MongoDB role collection
+const Collection = "roles"
+
+// GetByID returns the entity of the given name
+func GetByID(ctx context.Context, id string) (*Role, error) {
+	if r, ok := builtin[id]; ok {
+		return r, nil
+	}
+
+	c := database.GetDB().Collection(Collection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	r := &Role{}
+
+	if err := c.FindOne(ctx, bson.M{"_id": id}).Decode(r); err != nil {
+		if err != mongo.ErrNoDocuments {
+			logger.Error(err)
+		}
+		return r, model.MongoToAPIError(err)
+	}
+
+	return r, nil
+}
+
+func getManyByFilter(ctx context.Context, filter interface{}) ([]*Role, error) {
+	c := database.GetDB().Collection(Collection)
+
+	opts := options.Find()
+	opts.SetSort(bson.M{"_id": 1})
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	cur, err := c.Find(ctx, filter, opts)
+	if err != nil {
+		logger.Error(err)
+		return nil, model.MongoToAPIError(err)
+	}
+
+	defer cur.Close(ctx)
+
+	var roles []*Role
+
+	for cur.Next(ctx) {
+		r := &Role{}
+
+		if err := cur.Decode(r); err != nil {
+			logger.Error(err)
+			return nil, model.MongoToAPIError(err)
+		}
+
+		roles = append(roles, r)
+	}
+
+	if err := cur.Err(); err != nil {
+		logger.Error(err)
+		return nil, model.MongoToAPIError(err)
+	}
+
+	return roles, nil
+}
+
+// GetAll returns the built-in roles followed by all stored roles
+func GetAll(ctx context.Context) (*model.Result, error) {
+	roles, err := getManyByFilter(ctx, bson.M{})
+	if err != nil {
+		return &model.Result{}, err
+	}
+
+	names := make([]string, 0, len(builtin))
+	for name := range builtin {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	r := &model.Result{Count: int64(len(names) + len(roles))}
+
+	for _, name := range names {
+		r.Items = append(r.Items, builtin[name])
+	}
+
+	for _, role := range roles {
+		r.Items = append(r.Items, role)
+	}
+
+	return r, nil
+}
+
+// Scope returns the union of the scopes of the given roles
+func Scope(ctx context.Context, names []string) ([]string, error) {
+	var scope, custom []string
+
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		if r, ok := builtin[name]; ok {
+			scope = append(scope, r.Scope...)
+		} else {
+			custom = append(custom, name)
+		}
+	}
+
+	if len(custom) == 0 {
+		return scope, nil
+	}
+
+	roles, err := getManyByFilter(ctx, bson.M{"_id": bson.M{"$in": custom}})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(roles) != len(custom) {
+		return nil, ErrUnknownRole
+	}
+
+	for _, r := range roles {
+		scope = append(scope, r.Scope...)
+	}
+
+	return scope, nil
+}
+
+// Constrain returns the scopes which are covered by the given roles
+func Constrain(ctx context.Context, names, scope []string) ([]string, error) {
+	granted, err := Scope(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	clm := &jwt.Claims{Scope: granted}
+
+	allowed := make([]string, 0, len(scope))
+	for _, s := range scope {
+		if clm.Covers(s) {
+			allowed = append(allowed, s)
+		}
+	}
+
+	return allowed, nil
+}
+
+// Create creates a new entity
+func Create(ctx context.Context, r *Role) error {
+	if IsBuiltin(r.ID) {
+		return ErrBuiltin
+	}
+
+	c := database.GetDB().Collection(Collection)
+
+	r.Modified = timestamp{Time: time.Now()}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if _, err := c.InsertOne(ctx, r); err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	return nil
+}
+
+// Replace replaces the data of an existing entity
+func Replace(ctx context.Context, id string, r *Role) error {
+	if IsBuiltin(id) {
+		return ErrBuiltin
+	}
+
+	r.ID = id
+	r.Modified = timestamp{Time: time.Now()}
+
+	c := database.GetDB().Collection(Collection)
+
+	opts := options.FindOneAndReplace()
+	opts.SetUpsert(false)
+	opts.SetReturnDocument(options.After)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if err := c.FindOneAndReplace(ctx, bson.M{"_id": id}, r, opts).Decode(r); err != nil {
+		if err != mongo.ErrNoDocuments {
+			logger.Error(err)
+		}
+		return model.MongoToAPIError(err)
+	}
+
+	return nil
+}
+
+// Remove removes an entity and unassigns it from all users
+func Remove(ctx context.Context, id string) error {
+	if IsBuiltin(id) {
+		return ErrBuiltin
+	}
+
+	c := database.GetDB().Collection(Collection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if _, err := c.DeleteOne(ctx, bson.M{"_id": id}); err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	return user.RemoveRole(ctx, id)
+}
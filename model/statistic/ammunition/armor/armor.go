@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/google/logger"
+	"github.com/tarkov-database/rest-api/core/cache"
 	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/event"
+	"github.com/tarkov-database/rest-api/core/logger"
 	"github.com/tarkov-database/rest-api/model"
 	"github.com/tarkov-database/rest-api/model/item"
 	"go.mongodb.org/mongo-driver/bson"
@@ -74,10 +76,25 @@ type Statistics struct {
 // Collection indicates the MongoDB feature collection
 const Collection = "statistics.ammunition.armor"
 
-func getOneByFilter(filter interface{}) (*AmmoArmorStatistics, error) {
+const eventKind = "ammunition.armor"
+
+func init() {
+	event.RegisterSource(event.Source{
+		Collection: Collection,
+		Resource:   event.ResourceStatistic,
+		Kind:       eventKind,
+	})
+
+	database.RegisterIndexes(
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "ammo", Value: 1}, {Key: "armor.id", Value: 1}, {Key: "distance", Value: 1}}},
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "armor.id", Value: 1}, {Key: "distance", Value: 1}}},
+	)
+}
+
+func getOneByFilter(ctx context.Context, filter interface{}) (*AmmoArmorStatistics, error) {
 	c := database.GetDB().Collection(Collection)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	stats := &AmmoArmorStatistics{}
@@ -93,13 +110,13 @@ func getOneByFilter(filter interface{}) (*AmmoArmorStatistics, error) {
 }
 
 // GetByID returns the entity of the given ID
-func GetByID(id string) (*AmmoArmorStatistics, error) {
+func GetByID(ctx context.Context, id string) (*AmmoArmorStatistics, error) {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return &AmmoArmorStatistics{}, err
 	}
 
-	return getOneByFilter(bson.M{"_id": objID})
+	return getOneByFilter(ctx, bson.M{"_id": objID})
 }
 
 // Options represents the options for a database operation
@@ -109,7 +126,7 @@ type Options struct {
 	Offset int64
 }
 
-func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
+func getManyByFilter(ctx context.Context, filter interface{}, opts *Options) (*model.Result, error) {
 	c := database.GetDB().Collection(Collection)
 
 	findOpts := options.Find()
@@ -117,7 +134,7 @@ func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
 	findOpts.SetSkip(opts.Offset)
 	findOpts.SetSort(opts.Sort)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	var err error
@@ -169,12 +186,12 @@ type RangeOptions struct {
 }
 
 // GetAll returns a result based on filters
-func GetAll(opts *Options) (*model.Result, error) {
-	return getManyByFilter(bson.D{}, opts)
+func GetAll(ctx context.Context, opts *Options) (*model.Result, error) {
+	return getManyByFilter(ctx, bson.D{}, opts)
 }
 
 // GetByRefs returns a result by given ammo and armor IDs
-func GetByRefs(ammo, armor []string, r *RangeOptions, opts *Options) (*model.Result, error) {
+func GetByRefs(ctx context.Context, ammo, armor []string, r *RangeOptions, opts *Options) (*model.Result, error) {
 	opts.Sort = append(opts.Sort, bson.D{
 		bson.E{Key: "ammo", Value: 1},
 		bson.E{Key: "armor.id", Value: 1},
@@ -220,11 +237,11 @@ func GetByRefs(ammo, armor []string, r *RangeOptions, opts *Options) (*model.Res
 		filter = append(filter, bson.E{Key: "distance", Value: bson.D{{Key: "$lte", Value: r.LTE}}})
 	}
 
-	return getManyByFilter(filter, opts)
+	return getManyByFilter(ctx, filter, opts)
 }
 
 // Create creates a new entity
-func Create(stats *AmmoArmorStatistics) error {
+func Create(ctx context.Context, stats *AmmoArmorStatistics) error {
 	c := database.GetDB().Collection(Collection)
 
 	if stats.ID.IsZero() {
@@ -233,7 +250,7 @@ func Create(stats *AmmoArmorStatistics) error {
 
 	stats.Modified = timestamp{Time: time.Now()}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	if _, err := c.InsertOne(ctx, stats); err != nil {
@@ -241,11 +258,14 @@ func Create(stats *AmmoArmorStatistics) error {
 		return model.MongoToAPIError(err)
 	}
 
+	event.Publish(event.OperationCreate, event.ResourceStatistic, eventKind, stats.ID)
+	cache.Invalidate(ctx, Collection, cache.Tag(Collection, stats.ID.Hex()))
+
 	return nil
 }
 
 // Replace replaces the data of an existing entity
-func Replace(id string, stats *AmmoArmorStatistics) error {
+func Replace(ctx context.Context, id string, stats *AmmoArmorStatistics) error {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return err
@@ -263,7 +283,7 @@ func Replace(id string, stats *AmmoArmorStatistics) error {
 	opts.SetUpsert(false)
 	opts.SetReturnDocument(options.After)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	if err = c.FindOneAndReplace(ctx, bson.M{"_id": objID}, stats, opts).Decode(stats); err != nil {
@@ -271,11 +291,14 @@ func Replace(id string, stats *AmmoArmorStatistics) error {
 		return model.MongoToAPIError(err)
 	}
 
+	event.Publish(event.OperationUpdate, event.ResourceStatistic, eventKind, stats.ID)
+	cache.Invalidate(ctx, Collection, cache.Tag(Collection, stats.ID.Hex()))
+
 	return nil
 }
 
 // Remove removes an entity
-func Remove(id string) error {
+func Remove(ctx context.Context, id string) error {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return err
@@ -283,13 +306,19 @@ func Remove(id string) error {
 
 	c := database.GetDB().Collection(Collection)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	if _, err = c.DeleteOne(ctx, bson.M{"_id": objID}); err != nil {
+	res, err := c.DeleteOne(ctx, bson.M{"_id": objID})
+	if err != nil {
 		logger.Error(err)
 		return model.MongoToAPIError(err)
 	}
 
+	if res.DeletedCount > 0 {
+		event.Publish(event.OperationDelete, event.ResourceStatistic, eventKind, objID)
+		cache.Invalidate(ctx, Collection, cache.Tag(Collection, objID.Hex()))
+	}
+
 	return nil
 }
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"time"
 
-	"github.com/google/logger"
+	"github.com/tarkov-database/rest-api/core/cache"
 	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/event"
+	"github.com/tarkov-database/rest-api/core/logger"
 	"github.com/tarkov-database/rest-api/model"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -43,10 +45,24 @@ func (d AmmoDistanceStatistics) Validate() error {
 // Collection indicates the MongoDB feature collection
 const Collection = "statistics.ammunition.distances"
 
-func getOneByFilter(filter interface{}) (*AmmoDistanceStatistics, error) {
+const eventKind = "ammunition.distance"
+
+func init() {
+	event.RegisterSource(event.Source{
+		Collection: Collection,
+		Resource:   event.ResourceStatistic,
+		Kind:       eventKind,
+	})
+
+	database.RegisterIndexes(
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "ammo", Value: 1}, {Key: "distance", Value: 1}}},
+	)
+}
+
+func getOneByFilter(ctx context.Context, filter interface{}) (*AmmoDistanceStatistics, error) {
 	c := database.GetDB().Collection(Collection)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	stats := &AmmoDistanceStatistics{}
@@ -62,13 +78,13 @@ func getOneByFilter(filter interface{}) (*AmmoDistanceStatistics, error) {
 }
 
 // GetByID returns the entity of the given ID
-func GetByID(id string) (*AmmoDistanceStatistics, error) {
+func GetByID(ctx context.Context, id string) (*AmmoDistanceStatistics, error) {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return &AmmoDistanceStatistics{}, err
 	}
 
-	return getOneByFilter(bson.M{"_id": objID})
+	return getOneByFilter(ctx, bson.M{"_id": objID})
 }
 
 // Options represents the options for a database operation
@@ -78,7 +94,7 @@ type Options struct {
 	Offset int64
 }
 
-func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
+func getManyByFilter(ctx context.Context, filter interface{}, opts *Options) (*model.Result, error) {
 	c := database.GetDB().Collection(Collection)
 
 	findOpts := options.Find()
@@ -86,7 +102,7 @@ func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
 	findOpts.SetSkip(opts.Offset)
 	findOpts.SetSort(opts.Sort)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	var err error
@@ -132,12 +148,12 @@ func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
 }
 
 // GetAll returns a result based on filters
-func GetAll(opts *Options) (*model.Result, error) {
-	return getManyByFilter(bson.D{}, opts)
+func GetAll(ctx context.Context, opts *Options) (*model.Result, error) {
+	return getManyByFilter(ctx, bson.D{}, opts)
 }
 
 // GetByRefsAndRange returns a result by given IDs and range
-func GetByRefsAndRange(ids []string, gte, lte *uint64, opts *Options) (*model.Result, error) {
+func GetByRefsAndRange(ctx context.Context, ids []string, gte, lte *uint64, opts *Options) (*model.Result, error) {
 	opts.Sort = append(opts.Sort, bson.D{
 		bson.E{Key: "ammo", Value: 1},
 		bson.E{Key: "armor.id", Value: 1},
@@ -170,11 +186,11 @@ func GetByRefsAndRange(ids []string, gte, lte *uint64, opts *Options) (*model.Re
 		filter = append(filter, bson.E{Key: "distance", Value: bson.D{{Key: "$lte", Value: lte}}})
 	}
 
-	return getManyByFilter(filter, opts)
+	return getManyByFilter(ctx, filter, opts)
 }
 
 // Create creates a new entity
-func Create(stats *AmmoDistanceStatistics) error {
+func Create(ctx context.Context, stats *AmmoDistanceStatistics) error {
 	c := database.GetDB().Collection(Collection)
 
 	if stats.ID.IsZero() {
@@ -183,7 +199,7 @@ func Create(stats *AmmoDistanceStatistics) error {
 
 	stats.Modified = timestamp{Time: time.Now()}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	if _, err := c.InsertOne(ctx, stats); err != nil {
@@ -191,11 +207,14 @@ func Create(stats *AmmoDistanceStatistics) error {
 		return model.MongoToAPIError(err)
 	}
 
+	event.Publish(event.OperationCreate, event.ResourceStatistic, eventKind, stats.ID)
+	cache.Invalidate(ctx, Collection, cache.Tag(Collection, stats.ID.Hex()))
+
 	return nil
 }
 
 // Replace replaces the data of an existing entity
-func Replace(id string, stats *AmmoDistanceStatistics) error {
+func Replace(ctx context.Context, id string, stats *AmmoDistanceStatistics) error {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return err
@@ -213,7 +232,7 @@ func Replace(id string, stats *AmmoDistanceStatistics) error {
 	opts.SetUpsert(false)
 	opts.SetReturnDocument(options.After)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	if err = c.FindOneAndReplace(ctx, bson.M{"_id": objID}, stats, opts).Decode(stats); err != nil {
@@ -221,11 +240,14 @@ func Replace(id string, stats *AmmoDistanceStatistics) error {
 		return model.MongoToAPIError(err)
 	}
 
+	event.Publish(event.OperationUpdate, event.ResourceStatistic, eventKind, stats.ID)
+	cache.Invalidate(ctx, Collection, cache.Tag(Collection, stats.ID.Hex()))
+
 	return nil
 }
 
 // Remove removes an entity
-func Remove(id string) error {
+func Remove(ctx context.Context, id string) error {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return err
@@ -233,13 +255,19 @@ func Remove(id string) error {
 
 	c := database.GetDB().Collection(Collection)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	if _, err = c.DeleteOne(ctx, bson.M{"_id": objID}); err != nil {
+	res, err := c.DeleteOne(ctx, bson.M{"_id": objID})
+	if err != nil {
 		logger.Error(err)
 		return model.MongoToAPIError(err)
 	}
 
+	if res.DeletedCount > 0 {
+		event.Publish(event.OperationDelete, event.ResourceStatistic, eventKind, objID)
+		cache.Invalidate(ctx, Collection, cache.Tag(Collection, objID.Hex()))
+	}
+
 	return nil
 }
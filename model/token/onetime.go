@@ -0,0 +1,126 @@
+package token
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var (
+	// ErrInvalidOneTimeToken indicates that a one-time token is unknown, used or expired
+	ErrInvalidOneTimeToken = errors.New("invalid or expired link")
+)
+
+// Purpose represents the action a one-time token authorizes
+type Purpose string
+
+const (
+	// PurposeLogin authorizes a login without password
+	PurposeLogin Purpose = "login"
+
+	// PurposeReset authorizes setting a new password
+	PurposeReset Purpose = "reset"
+
+	// PurposeVerify authorizes the verification of an e-mail address
+	PurposeVerify Purpose = "verify"
+)
+
+// OneTimeToken describes a stored token of a one-time link. Only the hash of
+// the token is stored.
+type OneTimeToken struct {
+	ID      objectID  `json:"_id" bson:"_id"`
+	Hash    string    `json:"-" bson:"hash"`
+	Purpose Purpose   `json:"purpose" bson:"purpose"`
+	User    objectID  `json:"user" bson:"user"`
+	Email   string    `json:"email" bson:"email"`
+	Expires time.Time `json:"expires" bson:"expires"`
+	Created time.Time `json:"created" bson:"created"`
+}
+
+// OneTimeCollection indicates the MongoDB one-time token collection
+const OneTimeCollection = "oneTimeTokens"
+
+func init() {
+	database.RegisterIndexes(
+		database.Index{Collection: OneTimeCollection, Keys: bson.D{{Key: "hash", Value: 1}}, Unique: true},
+		database.Index{Collection: OneTimeCollection, Keys: bson.D{{Key: "user", Value: 1}, {Key: "purpose", Value: 1}}},
+		database.Index{Collection: OneTimeCollection, Keys: bson.D{{Key: "expires", Value: 1}}},
+	)
+}
+
+// NewOneTimeToken creates and stores a one-time token for the user and returns
+// the token string. Earlier tokens of the user with the same purpose are removed.
+func NewOneTimeToken(ctx context.Context, p Purpose, usr objectID, email string, lt time.Duration) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	s := base64.RawURLEncoding.EncodeToString(b)
+
+	now := time.Now()
+
+	t := &OneTimeToken{
+		ID:      primitive.NewObjectID(),
+		Hash:    hashToken(s),
+		Purpose: p,
+		User:    usr,
+		Email:   email,
+		Expires: now.Add(lt),
+		Created: now,
+	}
+
+	col := database.GetDB().Collection(OneTimeCollection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	filter := bson.M{"$or": bson.A{
+		bson.M{"user": usr, "purpose": p},
+		bson.M{"expires": bson.M{"$lt": now}},
+	}}
+
+	if _, err := col.DeleteMany(ctx, filter); err != nil {
+		logger.Error(err)
+		return "", model.MongoToAPIError(err)
+	}
+
+	if _, err := col.InsertOne(ctx, t); err != nil {
+		logger.Error(err)
+		return "", model.MongoToAPIError(err)
+	}
+
+	return s, nil
+}
+
+// UseOneTimeToken removes the one-time token of the purpose and returns it
+func UseOneTimeToken(ctx context.Context, s string, p Purpose) (*OneTimeToken, error) {
+	col := database.GetDB().Collection(OneTimeCollection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	filter := bson.M{"hash": hashToken(s), "purpose": p, "expires": bson.M{"$gt": time.Now()}}
+
+	t := &OneTimeToken{}
+
+	if err := col.FindOneAndDelete(ctx, filter).Decode(t); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrInvalidOneTimeToken
+		}
+		logger.Error(err)
+		return nil, model.MongoToAPIError(err)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,196 @@
+package token
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	// ErrInvalidRefreshToken indicates that a refresh token is unknown or expired
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+
+	// ErrReusedRefreshToken indicates that a refresh token was used more than once
+	ErrReusedRefreshToken = errors.New("refresh token has already been used")
+)
+
+// RefreshToken describes a stored refresh token. Only the hash of the token
+// is stored. Tokens of the same family descend from the same token creation.
+type RefreshToken struct {
+	ID      objectID  `json:"_id" bson:"_id"`
+	Hash    string    `json:"-" bson:"hash"`
+	Family  objectID  `json:"family" bson:"family"`
+	Subject string    `json:"sub" bson:"sub"`
+	Issuer  string    `json:"iss,omitempty" bson:"iss,omitempty"`
+	Scope   []string  `json:"scope" bson:"scope"`
+	Used    bool      `json:"used" bson:"used"`
+	Expires time.Time `json:"expires" bson:"expires"`
+	Created time.Time `json:"created" bson:"created"`
+}
+
+// Claims returns the claims of an access token issued by the refresh token
+func (t *RefreshToken) Claims() *jwt.Claims {
+	c := &jwt.Claims{Scope: t.Scope}
+
+	c.Subject = t.Subject
+	c.Issuer = t.Issuer
+
+	return c
+}
+
+// RefreshCollection indicates the MongoDB refresh token collection
+const RefreshCollection = "refreshTokens"
+
+func init() {
+	database.RegisterIndexes(
+		database.Index{Collection: RefreshCollection, Keys: bson.D{{Key: "hash", Value: 1}}, Unique: true},
+		database.Index{Collection: RefreshCollection, Keys: bson.D{{Key: "family", Value: 1}}},
+		database.Index{Collection: RefreshCollection, Keys: bson.D{{Key: "sub", Value: 1}}},
+		database.Index{Collection: RefreshCollection, Keys: bson.D{{Key: "expires", Value: 1}}},
+	)
+}
+
+func hashToken(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// NewRefreshToken creates and stores a refresh token for the claims and returns
+// the token string. If family is zero, a new token family is started.
+func NewRefreshToken(ctx context.Context, c *jwt.Claims, family objectID) (string, *RefreshToken, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, err
+	}
+
+	s := base64.RawURLEncoding.EncodeToString(b)
+
+	now := time.Now()
+
+	t := &RefreshToken{
+		ID:      primitive.NewObjectID(),
+		Hash:    hashToken(s),
+		Family:  family,
+		Subject: c.Subject,
+		Issuer:  c.Issuer,
+		Scope:   c.Scope,
+		Expires: now.Add(jwt.RefreshExpirationTime()),
+		Created: now,
+	}
+
+	if t.Family.IsZero() {
+		t.Family = t.ID
+	}
+
+	col := database.GetDB().Collection(RefreshCollection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if _, err := col.InsertOne(ctx, t); err != nil {
+		logger.Error(err)
+		return "", nil, model.MongoToAPIError(err)
+	}
+
+	if _, err := col.DeleteMany(ctx, bson.M{"expires": bson.M{"$lt": now}}); err != nil {
+		logger.Error(err)
+	}
+
+	return s, t, nil
+}
+
+// UseRefreshToken marks the refresh token as used and returns it.
+// Presenting an already used token revokes its whole family.
+func UseRefreshToken(ctx context.Context, s string) (*RefreshToken, error) {
+	col := database.GetDB().Collection(RefreshCollection)
+
+	hash := hashToken(s)
+
+	filter := bson.M{"hash": hash, "used": false, "expires": bson.M{"$gt": time.Now()}}
+	update := bson.M{"$set": bson.M{"used": true}}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	t := &RefreshToken{}
+
+	err := col.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate()).Decode(t)
+	if err == nil {
+		return t, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		logger.Error(err)
+		return nil, model.MongoToAPIError(err)
+	}
+
+	if err := col.FindOne(ctx, bson.M{"hash": hash, "used": true}).Decode(t); err != nil {
+		if err != mongo.ErrNoDocuments {
+			logger.Error(err)
+			return nil, model.MongoToAPIError(err)
+		}
+		return nil, ErrInvalidRefreshToken
+	}
+
+	if err := RemoveRefreshTokenFamily(ctx, t.Family); err != nil {
+		return nil, err
+	}
+
+	return nil, ErrReusedRefreshToken
+}
+
+// GetRefreshToken returns the stored refresh token of the token string
+func GetRefreshToken(ctx context.Context, s string) (*RefreshToken, error) {
+	col := database.GetDB().Collection(RefreshCollection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	t := &RefreshToken{}
+
+	if err := col.FindOne(ctx, bson.M{"hash": hashToken(s)}).Decode(t); err != nil {
+		if err != mongo.ErrNoDocuments {
+			logger.Error(err)
+		}
+		return t, model.MongoToAPIError(err)
+	}
+
+	return t, nil
+}
+
+// RemoveRefreshTokenFamily removes all refresh tokens of a family
+func RemoveRefreshTokenFamily(ctx context.Context, family objectID) error {
+	return removeRefreshTokens(ctx, bson.M{"family": family})
+}
+
+// RemoveRefreshTokens removes all refresh tokens of the subject
+func RemoveRefreshTokens(ctx context.Context, subject string) error {
+	return removeRefreshTokens(ctx, bson.M{"sub": subject})
+}
+
+func removeRefreshTokens(ctx context.Context, filter interface{}) error {
+	col := database.GetDB().Collection(RefreshCollection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if _, err := col.DeleteMany(ctx, filter); err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package token
+
+import (
+	"context"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type objectID = model.ObjectID
+
+// Revocation describes an entry of the revocation list. It either revokes a
+// single token by its ID or all tokens of a subject issued before a point in time.
+type Revocation struct {
+	ID           objectID   `json:"_id" bson:"_id"`
+	TokenID      string     `json:"jti,omitempty" bson:"jti,omitempty"`
+	Subject      string     `json:"sub" bson:"sub"`
+	IssuedBefore *time.Time `json:"issuedBefore,omitempty" bson:"issuedBefore,omitempty"`
+	Expires      *time.Time `json:"expires,omitempty" bson:"expires,omitempty"`
+	Created      time.Time  `json:"created" bson:"created"`
+}
+
+// RevocationCollection indicates the MongoDB revocation list collection
+const RevocationCollection = "tokenRevocations"
+
+func init() {
+	database.RegisterIndexes(
+		database.Index{Collection: RevocationCollection, Keys: bson.D{{Key: "jti", Value: 1}}},
+		database.Index{Collection: RevocationCollection, Keys: bson.D{{Key: "sub", Value: 1}}},
+		database.Index{Collection: RevocationCollection, Keys: bson.D{{Key: "expires", Value: 1}}},
+	)
+}
+
+// Revoke adds the token of the claims to the revocation list
+func Revoke(ctx context.Context, c *jwt.Claims) error {
+	if c.ID == "" {
+		return model.ErrInvalidInput
+	}
+
+	now := time.Now()
+
+	r := &Revocation{
+		ID:      primitive.NewObjectID(),
+		TokenID: c.ID,
+		Subject: c.Subject,
+		Created: now,
+	}
+
+	if c.ExpiresAt != nil {
+		r.Expires = &c.ExpiresAt.Time
+	}
+
+	col := database.GetDB().Collection(RevocationCollection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if _, err := col.InsertOne(ctx, r); err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	// Entries of expired tokens are no longer needed
+	filter := bson.M{"expires": bson.M{"$lt": now}}
+	if _, err := col.DeleteMany(ctx, filter); err != nil {
+		logger.Error(err)
+	}
+
+	return nil
+}
+
+// RevokeAll revokes all tokens of the subject issued until now, including
+// its refresh tokens. The issue time of a token has second precision, so all
+// tokens issued in the current second are revoked as well.
+func RevokeAll(ctx context.Context, subject string) error {
+	now := time.Now()
+
+	col := database.GetDB().Collection(RevocationCollection)
+
+	filter := bson.M{"sub": subject, "jti": bson.M{"$exists": false}}
+	update := bson.M{
+		"$set":         bson.M{"issuedBefore": now.Truncate(time.Second).Add(time.Second), "created": now},
+		"$setOnInsert": bson.M{"_id": primitive.NewObjectID()},
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if _, err := col.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true)); err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	return RemoveRefreshTokens(ctx, subject)
+}
+
+// IsRevoked checks if the token of the claims is on the revocation list
+func IsRevoked(ctx context.Context, c *jwt.Claims) (bool, error) {
+	or := bson.A{}
+
+	if c.ID != "" {
+		or = append(or, bson.M{"jti": c.ID})
+	}
+
+	if c.Subject != "" {
+		var issued time.Time
+		if c.IssuedAt != nil {
+			issued = c.IssuedAt.Time
+		}
+
+		or = append(or, bson.M{
+			"sub":          c.Subject,
+			"jti":          bson.M{"$exists": false},
+			"issuedBefore": bson.M{"$gt": issued},
+		})
+	}
+
+	if len(or) == 0 {
+		return false, nil
+	}
+
+	col := database.GetDB().Collection(RevocationCollection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	n, err := col.CountDocuments(ctx, bson.M{"$or": or}, options.Count().SetLimit(1))
+	if err != nil {
+		logger.Error(err)
+		return false, model.MongoToAPIError(err)
+	}
+
+	return n > 0, nil
+}
@@ -11,6 +11,7 @@ type Request struct {
 	Subject   string   `json:"sub"`
 	Scope     []string `json:"scope"`
 	ExpiresIn string   `json:"expiresIn"`
+	Refresh   bool     `json:"refresh,omitempty"`
 }
 
 // Duration parses ExpiresIn and returns it as time.Duration
@@ -39,6 +40,49 @@ func (r *Request) ToClaims() *jwt.Claims {
 
 // Response represents the body of a token creation response
 type Response struct {
-	Token   string `json:"token"`
-	Expires int64  `json:"expires"`
+	Token          string `json:"token"`
+	Expires        int64  `json:"expires"`
+	RefreshToken   string `json:"refreshToken,omitempty"`
+	RefreshExpires int64  `json:"refreshExpires,omitempty"`
+}
+
+// RefreshRequest represents the body of a token refresh request
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// RevokeRequest represents the body of a token revocation request
+type RevokeRequest struct {
+	Token        string `json:"token,omitempty"`
+	RefreshToken string `json:"refreshToken,omitempty"`
+}
+
+// LoginRequest represents the body of a login request, either with e-mail
+// address and password or with the token of a login link
+type LoginRequest struct {
+	Email    string `json:"email,omitempty"`
+	Password string `json:"password,omitempty"`
+	Token    string `json:"token,omitempty"`
+}
+
+// LinkRequest represents the body of a request for a one-time link
+type LinkRequest struct {
+	Email string `json:"email"`
+}
+
+// PasswordRequest represents the body of a password reset
+type PasswordRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+// VerifyRequest represents the body of an e-mail verification
+type VerifyRequest struct {
+	Token string `json:"token"`
+}
+
+// OIDCRequest represents the body of a login with the ID token of an
+// identity provider
+type OIDCRequest struct {
+	IDToken string `json:"idToken"`
 }
@@ -0,0 +1,222 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	// ErrInvalidPassword indicates that a password does not meet the requirements
+	ErrInvalidPassword = errors.New("password must have between 10 and 128 characters")
+
+	// ErrInvalidCredentials indicates that an e-mail address and password don't match
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Parameters of new Argon2id hashes
+const (
+	argonTime    = 3
+	argonMemory  = 64 * 1024
+	argonThreads = 2
+	argonKeyLen  = 32
+	argonSaltLen = 16
+)
+
+// dummy holds the hash compared against if no user exists, so that the
+// response time doesn't reveal whether an e-mail address is registered
+var dummy struct {
+	once sync.Once
+	hash string
+}
+
+// dummyHash returns the dummy hash, which is computed on first use since
+// Argon2id is expensive. Its salt is fixed, since it protects no password.
+func dummyHash() string {
+	dummy.once.Do(func() {
+		dummy.hash = hashWithSalt("dummy password", make([]byte, argonSaltLen))
+	})
+
+	return dummy.hash
+}
+
+// ValidatePassword checks if a password meets the requirements
+func ValidatePassword(pw string) error {
+	if l := len([]rune(pw)); l < 10 || l > 128 {
+		return ErrInvalidPassword
+	}
+
+	return nil
+}
+
+// HashPassword returns the Argon2id hash of the password in PHC string format
+func HashPassword(pw string) (string, error) {
+	salt := make([]byte, argonSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	return hashWithSalt(pw, salt), nil
+}
+
+func hashWithSalt(pw string, salt []byte) string {
+	key := argon2.IDKey([]byte(pw), salt, argonTime, argonMemory, argonThreads, argonKeyLen)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, argonMemory, argonTime, argonThreads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+}
+
+// comparePassword checks a password against an Argon2id or bcrypt hash
+func comparePassword(hash, pw string) bool {
+	if strings.HasPrefix(hash, "$2") {
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(pw)) == nil
+	}
+
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return false
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false
+	}
+
+	var memory, iterations uint32
+	var threads uint8
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &iterations, &threads); err != nil {
+		return false
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return false
+	}
+
+	other := argon2.IDKey([]byte(pw), salt, iterations, memory, threads, uint32(len(key)))
+
+	return subtle.ConstantTimeCompare(key, other) == 1
+}
+
+// needsRehash checks if a hash was not created with the current parameters
+func needsRehash(hash string) bool {
+	return !strings.HasPrefix(hash, fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$", argon2.Version, argonMemory, argonTime, argonThreads))
+}
+
+// Authenticate returns the user of the e-mail address if the password matches.
+// Hashes of outdated algorithms or parameters are replaced.
+func Authenticate(ctx context.Context, email, pw string) (*User, error) {
+	u, err := GetOneByEmail(ctx, email)
+	if err != nil {
+		if err == model.ErrNoResult {
+			comparePassword(dummyHash(), pw)
+			return nil, ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	if u.Password == "" {
+		comparePassword(dummyHash(), pw)
+		return nil, ErrInvalidCredentials
+	}
+
+	if !comparePassword(u.Password, pw) {
+		return nil, ErrInvalidCredentials
+	}
+
+	if needsRehash(u.Password) {
+		if err := SetPassword(ctx, u.ID.Hex(), pw); err != nil {
+			logger.Errorf("Error while rehashing password of user %s: %s", u.ID.Hex(), err)
+		}
+	}
+
+	return u, nil
+}
+
+// SetPassword sets a new password for the user
+func SetPassword(ctx context.Context, id, pw string) error {
+	if err := ValidatePassword(pw); err != nil {
+		return err
+	}
+
+	hash, err := HashPassword(pw)
+	if err != nil {
+		return err
+	}
+
+	return setFields(ctx, id, bson.M{"password": hash})
+}
+
+// SetVerified marks the e-mail address of the user as verified
+func SetVerified(ctx context.Context, id, email string) error {
+	objID, err := model.ToObjectID(id)
+	if err != nil {
+		return err
+	}
+
+	c := database.GetDB().Collection(Collection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	// The address may have changed since the verification was requested
+	filter := bson.M{"_id": objID, "email": email}
+	update := bson.M{"$set": bson.M{"verified": true, "_modified": timestamp{Time: time.Now()}}}
+
+	res, err := c.UpdateOne(ctx, filter, update)
+	if err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	if res.MatchedCount == 0 {
+		return model.ErrNoResult
+	}
+
+	return nil
+}
+
+func setFields(ctx context.Context, id string, fields bson.M) error {
+	objID, err := model.ToObjectID(id)
+	if err != nil {
+		return err
+	}
+
+	c := database.GetDB().Collection(Collection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	fields["_modified"] = timestamp{Time: time.Now()}
+
+	res, err := c.UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$set": fields})
+	if err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	if res.MatchedCount == 0 {
+		return model.ErrNoResult
+	}
+
+	return nil
+}
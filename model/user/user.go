@@ -7,9 +7,9 @@ import (
 	"time"
 
 	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/logger"
 	"github.com/tarkov-database/rest-api/model"
 
-	"github.com/google/logger"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -19,6 +19,9 @@ import (
 var (
 	// ErrInvalidEmail indicates that a email address is not valid
 	ErrInvalidEmail = errors.New("invalid e-mail address")
+
+	// ErrInvalidQuota indicates that a quota is negative
+	ErrInvalidQuota = errors.New("invalid quota")
 )
 
 type objectID = model.ObjectID
@@ -29,7 +32,11 @@ type timestamp = model.Timestamp
 type User struct {
 	ID       objectID  `json:"_id" bson:"_id"`
 	Email    string    `json:"email" bson:"email"`
+	Verified bool      `json:"verified" bson:"verified"`
+	Password string    `json:"-" bson:"password,omitempty"`
 	Locked   bool      `json:"locked" bson:"locked"`
+	Roles    []string  `json:"roles,omitempty" bson:"roles,omitempty"`
+	Quota    int64     `json:"quota,omitempty" bson:"quota,omitempty"`
 	Modified timestamp `json:"_modified" bson:"_modified"`
 }
 
@@ -39,16 +46,27 @@ func (u *User) Validate() error {
 		return ErrInvalidEmail
 	}
 
+	if u.Quota < 0 {
+		return ErrInvalidQuota
+	}
+
 	return nil
 }
 
 // Collection indicates the MongoDB user collection
 const Collection = "users"
 
-func getOneByFilter(filter interface{}) (*User, error) {
+func init() {
+	database.RegisterIndexes(
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "email", Value: 1}}, Unique: true},
+		database.Index{Collection: Collection, Keys: bson.D{{Key: "roles", Value: 1}}},
+	)
+}
+
+func getOneByFilter(ctx context.Context, filter interface{}) (*User, error) {
 	c := database.GetDB().Collection(Collection)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	u := &User{}
@@ -64,13 +82,13 @@ func getOneByFilter(filter interface{}) (*User, error) {
 }
 
 // GetByID returns the entity of the given ID
-func GetByID(id string) (*User, error) {
+func GetByID(ctx context.Context, id string) (*User, error) {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return &User{}, err
 	}
 
-	return getOneByFilter(bson.M{"_id": objID})
+	return getOneByFilter(ctx, bson.M{"_id": objID})
 }
 
 // Options represents the options for a database operation
@@ -80,7 +98,35 @@ type Options struct {
 	Offset int64
 }
 
-func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
+// GetQuota returns the daily request quota of a user, where zero means the default quota
+func GetQuota(ctx context.Context, id string) (int64, error) {
+	objID, err := model.ToObjectID(id)
+	if err != nil {
+		return 0, nil
+	}
+
+	c := database.GetDB().Collection(Collection)
+
+	opts := options.FindOne()
+	opts.SetProjection(bson.M{"quota": 1})
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	u := &User{}
+
+	if err := c.FindOne(ctx, bson.M{"_id": objID}, opts).Decode(u); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return 0, nil
+		}
+		logger.Error(err)
+		return 0, model.MongoToAPIError(err)
+	}
+
+	return u.Quota, nil
+}
+
+func getManyByFilter(ctx context.Context, filter interface{}, opts *Options) (*model.Result, error) {
 	c := database.GetDB().Collection(Collection)
 
 	findOpts := options.Find()
@@ -88,7 +134,7 @@ func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
 	findOpts.SetSkip(opts.Offset)
 	findOpts.SetSort(opts.Sort)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	var err error
@@ -134,22 +180,32 @@ func getManyByFilter(filter interface{}, opts *Options) (*model.Result, error) {
 }
 
 // GetAll returns a result based on filters
-func GetAll(opts *Options) (*model.Result, error) {
-	return getManyByFilter(bson.D{}, opts)
+func GetAll(ctx context.Context, opts *Options) (*model.Result, error) {
+	return getManyByFilter(ctx, bson.D{}, opts)
 }
 
 // GetByEmail returns a result based on email address
-func GetByEmail(addr string, opts *Options) (*model.Result, error) {
-	return getManyByFilter(bson.M{"email": addr}, opts)
+func GetByEmail(ctx context.Context, addr string, opts *Options) (*model.Result, error) {
+	return getManyByFilter(ctx, bson.M{"email": addr}, opts)
+}
+
+// GetOneByEmail returns the user of the e-mail address
+func GetOneByEmail(ctx context.Context, addr string) (*User, error) {
+	return getOneByFilter(ctx, bson.M{"email": addr})
 }
 
 // GetByLockedState returns a result based on lock state
-func GetByLockedState(locked bool, opts *Options) (*model.Result, error) {
-	return getManyByFilter(bson.M{"locked": locked}, opts)
+func GetByLockedState(ctx context.Context, locked bool, opts *Options) (*model.Result, error) {
+	return getManyByFilter(ctx, bson.M{"locked": locked}, opts)
+}
+
+// GetByRole returns a result based on an assigned role
+func GetByRole(ctx context.Context, role string, opts *Options) (*model.Result, error) {
+	return getManyByFilter(ctx, bson.M{"roles": role}, opts)
 }
 
 // Create creates a new entity
-func Create(user *User) error {
+func Create(ctx context.Context, user *User) error {
 	c := database.GetDB().Collection(Collection)
 
 	if user.ID.IsZero() {
@@ -158,7 +214,7 @@ func Create(user *User) error {
 
 	user.Modified = timestamp{Time: time.Now()}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	if _, err := c.InsertOne(ctx, user); err != nil {
@@ -170,7 +226,7 @@ func Create(user *User) error {
 }
 
 // Replace replaces the data of an existing entity
-func Replace(id string, user *User) error {
+func Replace(ctx context.Context, id string, user *User) error {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return err
@@ -188,7 +244,7 @@ func Replace(id string, user *User) error {
 	opts.SetUpsert(false)
 	opts.SetReturnDocument(options.After)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	if err = c.FindOneAndReplace(ctx, bson.M{"_id": objID}, user, opts).Decode(user); err != nil {
@@ -200,7 +256,7 @@ func Replace(id string, user *User) error {
 }
 
 // Remove removes an entity
-func Remove(id string) error {
+func Remove(ctx context.Context, id string) error {
 	objID, err := model.ToObjectID(id)
 	if err != nil {
 		return err
@@ -208,7 +264,7 @@ func Remove(id string) error {
 
 	c := database.GetDB().Collection(Collection)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	if _, err = c.DeleteOne(ctx, bson.M{"_id": objID}); err != nil {
@@ -218,3 +274,23 @@ func Remove(id string) error {
 
 	return nil
 }
+
+// RemoveRole unassigns a role from all users
+func RemoveRole(ctx context.Context, role string) error {
+	c := database.GetDB().Collection(Collection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	update := bson.M{
+		"$pull": bson.M{"roles": role},
+		"$set":  bson.M{"_modified": timestamp{Time: time.Now()}},
+	}
+
+	if _, err := c.UpdateMany(ctx, bson.M{"roles": role}, update); err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	return nil
+}
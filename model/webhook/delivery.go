@@ -0,0 +1,159 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/event"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// DeliveryStatus represents the state of a delivery
+type DeliveryStatus string
+
+const (
+	// DeliveryPending is used while a delivery is attempted
+	DeliveryPending DeliveryStatus = "pending"
+
+	// DeliverySucceeded is used when the receiver accepted the delivery
+	DeliverySucceeded DeliveryStatus = "succeeded"
+
+	// DeliveryFailed is used when all attempts of a delivery failed
+	DeliveryFailed DeliveryStatus = "failed"
+)
+
+// Delivery describes the log entry of a webhook delivery
+type Delivery struct {
+	ID         objectID       `json:"_id" bson:"_id"`
+	Webhook    objectID       `json:"webhook" bson:"webhook"`
+	Event      *event.Event   `json:"event" bson:"event"`
+	Status     DeliveryStatus `json:"status" bson:"status"`
+	Attempts   int            `json:"attempts" bson:"attempts"`
+	StatusCode int            `json:"statusCode,omitempty" bson:"statusCode,omitempty"`
+	Error      string         `json:"error,omitempty" bson:"error,omitempty"`
+	Created    timestamp      `json:"created" bson:"created"`
+	Modified   timestamp      `json:"_modified" bson:"_modified"`
+}
+
+// DeliveryCollection indicates the MongoDB webhook delivery collection
+const DeliveryCollection = "webhookDeliveries"
+
+func init() {
+	database.RegisterIndexes(
+		database.Index{Collection: DeliveryCollection, Keys: bson.D{{Key: "webhook", Value: 1}}},
+	)
+}
+
+// GetDeliveries returns a result of deliveries of the given webhook
+func GetDeliveries(ctx context.Context, id string, opts *Options) (*model.Result, error) {
+	objID, err := model.ToObjectID(id)
+	if err != nil {
+		return &model.Result{}, err
+	}
+
+	c := database.GetDB().Collection(DeliveryCollection)
+
+	findOpts := options.Find()
+	findOpts.SetLimit(opts.Limit)
+	findOpts.SetSkip(opts.Offset)
+	findOpts.SetSort(opts.Sort)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	filter := bson.M{"webhook": objID}
+
+	r := &model.Result{}
+
+	r.Count, err = c.CountDocuments(ctx, filter)
+	if err != nil {
+		logger.Error(err)
+		return r, model.MongoToAPIError(err)
+	}
+
+	if r.Count == 0 {
+		return r, nil
+	}
+
+	cur, err := c.Find(ctx, filter, findOpts)
+	if err != nil {
+		if err != mongo.ErrNoDocuments {
+			logger.Error(err)
+		}
+		return r, model.MongoToAPIError(err)
+	}
+
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		d := &Delivery{}
+
+		if err := cur.Decode(d); err != nil {
+			logger.Error(err)
+			return r, model.MongoToAPIError(err)
+		}
+
+		r.Items = append(r.Items, d)
+	}
+
+	if err := cur.Err(); err != nil {
+		return r, model.MongoToAPIError(err)
+	}
+
+	return r, nil
+}
+
+// CreateDelivery creates a new delivery log entry
+func CreateDelivery(ctx context.Context, d *Delivery) error {
+	c := database.GetDB().Collection(DeliveryCollection)
+
+	if d.ID.IsZero() {
+		d.ID = primitive.NewObjectID()
+	}
+
+	now := time.Now()
+	d.Created = timestamp{Time: now}
+	d.Modified = timestamp{Time: now}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if _, err := c.InsertOne(ctx, d); err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	return nil
+}
+
+// UpdateDelivery updates the state of an existing delivery log entry
+func UpdateDelivery(ctx context.Context, d *Delivery) error {
+	c := database.GetDB().Collection(DeliveryCollection)
+
+	d.Modified = timestamp{Time: time.Now()}
+
+	update := bson.M{"$set": bson.M{
+		"status":     d.Status,
+		"attempts":   d.Attempts,
+		"statusCode": d.StatusCode,
+		"error":      d.Error,
+		"_modified":  d.Modified,
+	}}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if _, err := c.UpdateByID(ctx, d.ID, update); err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	return nil
+}
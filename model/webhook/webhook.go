@@ -0,0 +1,286 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/event"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	// ErrInvalidURL indicates that a webhook URL is not valid
+	ErrInvalidURL = errors.New("invalid url")
+)
+
+type objectID = model.ObjectID
+
+type timestamp = model.Timestamp
+
+// Webhook describes the entity of a webhook subscription
+type Webhook struct {
+	ID        objectID         `json:"_id" bson:"_id"`
+	URL       string           `json:"url" bson:"url"`
+	Secret    string           `json:"secret,omitempty" bson:"secret"`
+	Resources []event.Resource `json:"resources" bson:"resources"`
+	Kinds     []string         `json:"kinds" bson:"kinds"`
+	Disabled  bool             `json:"disabled" bson:"disabled"`
+	Modified  timestamp        `json:"_modified" bson:"_modified"`
+}
+
+// Validate validates the fields of a webhook
+func (w *Webhook) Validate() error {
+	u, err := url.Parse(w.URL)
+	if err != nil || !u.IsAbs() || u.Host == "" {
+		return ErrInvalidURL
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return ErrInvalidURL
+	}
+
+	for _, r := range w.Resources {
+		if !r.IsValid() {
+			return fmt.Errorf("resource \"%s\" is not valid", r)
+		}
+	}
+
+	for _, k := range w.Kinds {
+		if k == "" {
+			return errors.New("kind is empty")
+		}
+	}
+
+	return nil
+}
+
+// GenerateSecret sets a random secret
+func (w *Webhook) GenerateSecret() error {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return err
+	}
+
+	w.Secret = hex.EncodeToString(b)
+
+	return nil
+}
+
+// Collection indicates the MongoDB webhook collection
+const Collection = "webhooks"
+
+func getOneByFilter(ctx context.Context, filter interface{}) (*Webhook, error) {
+	c := database.GetDB().Collection(Collection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	w := &Webhook{}
+
+	if err := c.FindOne(ctx, filter).Decode(w); err != nil {
+		if err != mongo.ErrNoDocuments {
+			logger.Error(err)
+		}
+		return w, model.MongoToAPIError(err)
+	}
+
+	return w, nil
+}
+
+// GetByID returns the entity of the given ID
+func GetByID(ctx context.Context, id string) (*Webhook, error) {
+	objID, err := model.ToObjectID(id)
+	if err != nil {
+		return &Webhook{}, err
+	}
+
+	return getOneByFilter(ctx, bson.M{"_id": objID})
+}
+
+// Options represents the options for a database operation
+type Options struct {
+	Sort   bson.D
+	Limit  int64
+	Offset int64
+}
+
+func getManyByFilter(ctx context.Context, filter interface{}, opts *Options) (*model.Result, error) {
+	c := database.GetDB().Collection(Collection)
+
+	findOpts := options.Find()
+	findOpts.SetLimit(opts.Limit)
+	findOpts.SetSkip(opts.Offset)
+	findOpts.SetSort(opts.Sort)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var err error
+
+	r := &model.Result{}
+
+	r.Count, err = c.CountDocuments(ctx, filter)
+	if err != nil {
+		logger.Error(err)
+		return r, model.MongoToAPIError(err)
+	}
+
+	if r.Count == 0 {
+		return r, nil
+	}
+
+	cur, err := c.Find(ctx, filter, findOpts)
+	if err != nil {
+		if err != mongo.ErrNoDocuments {
+			logger.Error(err)
+		}
+		return r, model.MongoToAPIError(err)
+	}
+
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		w := &Webhook{}
+
+		if err := cur.Decode(w); err != nil {
+			logger.Error(err)
+			return r, model.MongoToAPIError(err)
+		}
+
+		r.Items = append(r.Items, w)
+	}
+
+	if err := cur.Err(); err != nil {
+		return r, model.MongoToAPIError(err)
+	}
+
+	return r, nil
+}
+
+// GetAll returns a result based on filters
+func GetAll(ctx context.Context, opts *Options) (*model.Result, error) {
+	return getManyByFilter(ctx, bson.D{}, opts)
+}
+
+func matchAny(field string, v interface{}) bson.M {
+	return bson.M{"$or": bson.A{
+		bson.M{field: v},
+		bson.M{field: bson.M{"$size": 0}},
+		bson.M{field: nil},
+	}}
+}
+
+// GetByEvent returns all enabled webhooks subscribed to the given event.
+// Events without a kind are not excluded by the kind filter.
+func GetByEvent(ctx context.Context, e *event.Event) ([]*Webhook, error) {
+	conds := bson.A{matchAny("resources", e.Resource)}
+	if e.Kind != "" {
+		conds = append(conds, matchAny("kinds", e.Kind))
+	}
+
+	filter := bson.D{
+		{Key: "disabled", Value: false},
+		{Key: "$and", Value: conds},
+	}
+
+	r, err := getManyByFilter(ctx, filter, &Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	hooks := make([]*Webhook, len(r.Items))
+	for i, v := range r.Items {
+		hooks[i] = v.(*Webhook)
+	}
+
+	return hooks, nil
+}
+
+// Create creates a new entity
+func Create(ctx context.Context, w *Webhook) error {
+	c := database.GetDB().Collection(Collection)
+
+	if w.ID.IsZero() {
+		w.ID = primitive.NewObjectID()
+	}
+
+	w.Modified = timestamp{Time: time.Now()}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if _, err := c.InsertOne(ctx, w); err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	return nil
+}
+
+// Replace replaces the data of an existing entity
+func Replace(ctx context.Context, id string, w *Webhook) error {
+	objID, err := model.ToObjectID(id)
+	if err != nil {
+		return err
+	}
+
+	if w.ID.IsZero() {
+		w.ID = objID
+	}
+
+	w.Modified = timestamp{Time: time.Now()}
+
+	c := database.GetDB().Collection(Collection)
+
+	opts := options.FindOneAndReplace()
+	opts.SetUpsert(false)
+	opts.SetReturnDocument(options.After)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if err = c.FindOneAndReplace(ctx, bson.M{"_id": objID}, w, opts).Decode(w); err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	return nil
+}
+
+// Remove removes an entity and its delivery logs
+func Remove(ctx context.Context, id string) error {
+	objID, err := model.ToObjectID(id)
+	if err != nil {
+		return err
+	}
+
+	c := database.GetDB().Collection(Collection)
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if _, err = c.DeleteOne(ctx, bson.M{"_id": objID}); err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	c = database.GetDB().Collection(DeliveryCollection)
+
+	if _, err = c.DeleteMany(ctx, bson.M{"webhook": objID}); err != nil {
+		logger.Error(err)
+		return model.MongoToAPIError(err)
+	}
+
+	return nil
+}
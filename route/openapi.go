@@ -0,0 +1,299 @@
+package route
+
+import (
+	"net/http"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/tarkov-database/rest-api/core/openapi"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/model/api"
+	"github.com/tarkov-database/rest-api/model/item"
+	"github.com/tarkov-database/rest-api/model/location/feature"
+	"github.com/tarkov-database/rest-api/view"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	specTitle = "Tarkov Database REST API"
+
+	securityScheme       = "bearerAuth"
+	securitySchemeAPIKey = "apiKeyAuth"
+
+	contentTypeJSON        = "application/json"
+	contentTypeEventStream = "text/event-stream"
+)
+
+var (
+	spec     *openapi.Document
+	specOnce sync.Once
+)
+
+func specGET(w http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
+	specOnce.Do(func() {
+		spec = Spec()
+	})
+
+	view.RenderJSON(spec, http.StatusOK, w)
+}
+
+// Spec generates the OpenAPI document of all routes
+func Spec() *openapi.Document {
+	doc := openapi.NewDocument(specTitle, api.Version)
+
+	doc.Components.SecuritySchemes[securityScheme] = &openapi.SecurityScheme{
+		Type:         "http",
+		Scheme:       "bearer",
+		BearerFormat: "JWT",
+	}
+	doc.Components.SecuritySchemes[securitySchemeAPIKey] = &openapi.SecurityScheme{
+		Type: "apiKey",
+		In:   "header",
+		Name: jwt.HeaderAPIKey,
+	}
+
+	g := newGenerator(doc.Components.Schemas)
+
+	tags := make(map[string]bool)
+
+	for _, rt := range table() {
+		path, params := specPath(g, rt.path)
+
+		doc.AddOperation(path, rt.method, specOperation(g, &rt, params))
+
+		if t := rt.doc.tag; t != "" && !tags[t] {
+			tags[t] = true
+			doc.Tags = append(doc.Tags, &openapi.Tag{Name: t})
+		}
+	}
+
+	return doc
+}
+
+func newGenerator(schemas map[string]*openapi.Schema) *openapi.Generator {
+	g := openapi.NewGenerator(schemas)
+
+	g.Override(model.ObjectID{}, &openapi.Schema{Type: "string", Pattern: "^[0-9a-f]{24}$"})
+	g.Override(model.Timestamp{}, &openapi.Schema{Type: "integer", Format: "int64", Description: "Unix timestamp"})
+	g.Override(feature.GeometryType(0), &openapi.Schema{Type: "string", Enum: []interface{}{
+		feature.Point.String(),
+		feature.MultiPoint.String(),
+		feature.LineString.String(),
+		feature.MultiLineString.String(),
+		feature.Polygon.String(),
+		feature.MultiPolygon.String(),
+		feature.GeometryCollection.String(),
+	}})
+
+	kinds := make([]interface{}, len(item.KindList))
+	for i, k := range item.KindList {
+		kinds[i] = k.String()
+	}
+	g.Override(item.Kind(""), &openapi.Schema{Type: "string", Enum: kinds})
+
+	return g
+}
+
+// kindSchema returns a schema of the entities of all item kinds
+func kindSchema(g *openapi.Generator) *openapi.Schema {
+	s := &openapi.Schema{
+		Discriminator: &openapi.Discriminator{
+			PropertyName: "_kind",
+			Mapping:      make(map[string]string),
+		},
+	}
+
+	for _, k := range item.KindList {
+		e, err := k.GetEntity()
+		if err != nil {
+			continue
+		}
+
+		ref := openapi.Ref(g.Name(e))
+
+		s.OneOf = append(s.OneOf, ref)
+		s.Discriminator.Mapping[k.String()] = ref.Ref
+	}
+
+	return s
+}
+
+func specSchema(g *openapi.Generator, v interface{}) *openapi.Schema {
+	if _, ok := v.(kindEntity); ok {
+		return kindSchema(g)
+	}
+
+	if _, ok := v.(map[string]interface{}); ok {
+		return &openapi.Schema{Type: "object"}
+	}
+
+	return g.Schema(v)
+}
+
+func specPath(g *openapi.Generator, p string) (string, []*openapi.Parameter) {
+	var params []*openapi.Parameter
+
+	segs := strings.Split(p, "/")
+	for i, s := range segs {
+		if !strings.HasPrefix(s, ":") {
+			continue
+		}
+
+		name := s[1:]
+		segs[i] = "{" + name + "}"
+
+		schema := g.Schema(model.ObjectID{})
+		if name == "kind" {
+			schema = g.Schema(item.Kind(""))
+		}
+
+		params = append(params, &openapi.Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   schema,
+		})
+	}
+
+	return strings.Join(segs, "/"), params
+}
+
+func specOperation(g *openapi.Generator, rt *route, params []*openapi.Parameter) *openapi.Operation {
+	op := &openapi.Operation{
+		OperationID: handlerName(rt.handle),
+		Summary:     rt.doc.summary,
+		Parameters:  params,
+		Responses:   make(map[string]*openapi.Response),
+	}
+
+	if rt.doc.tag != "" {
+		op.Tags = []string{rt.doc.tag}
+	}
+
+	for _, q := range rt.doc.query {
+		op.Parameters = append(op.Parameters, &openapi.Parameter{
+			Name:        q.name,
+			In:          "query",
+			Description: q.description,
+			Schema:      &openapi.Schema{Type: q.typ},
+		})
+	}
+
+	if rt.doc.request != nil {
+		op.RequestBody = &openapi.RequestBody{
+			Required: true,
+			Content: map[string]*openapi.MediaType{
+				contentTypeJSON: {Schema: specSchema(g, rt.doc.request)},
+			},
+		}
+	}
+
+	status := rt.doc.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	res := &openapi.Response{Description: http.StatusText(status)}
+	if rt.doc.response != nil {
+		schema := specSchema(g, rt.doc.response)
+		if rt.doc.list {
+			schema = &openapi.Schema{
+				Type: "object",
+				Properties: map[string]*openapi.Schema{
+					"total": {Type: "integer", Format: "int64"},
+					"items": {Type: "array", Items: schema},
+				},
+			}
+		}
+
+		ct := contentTypeJSON
+		if rt.doc.stream {
+			ct = contentTypeEventStream
+		} else if rt.doc.contentType != "" {
+			ct = rt.doc.contentType
+		}
+
+		res.Content = map[string]*openapi.MediaType{ct: {Schema: schema}}
+	}
+	op.Responses[strconv.Itoa(status)] = res
+
+	errSchema := g.Schema(model.Response{})
+	addError := func(code int) {
+		op.Responses[strconv.Itoa(code)] = &openapi.Response{
+			Description: http.StatusText(code),
+			Content: map[string]*openapi.MediaType{
+				contentTypeJSON: {Schema: errSchema},
+			},
+		}
+	}
+
+	if rt.access != accessPublic {
+		op.Security = securityRequirements(rt.access == accessToken)
+		op.RequiredScopes = requiredScopes(rt.scope)
+		addError(http.StatusUnauthorized)
+		addError(http.StatusForbidden)
+	}
+
+	if len(params) > 0 {
+		addError(http.StatusNotFound)
+	}
+
+	if len(rt.doc.query) > 0 || rt.doc.request != nil {
+		addError(http.StatusBadRequest)
+	}
+
+	if rt.doc.request != nil {
+		addError(http.StatusUnsupportedMediaType)
+		addError(http.StatusUnprocessableEntity)
+	}
+
+	addError(http.StatusInternalServerError)
+
+	return op
+}
+
+// securityRequirements returns the alternative schemes that grant access to
+// a route. Their scopes are empty, as only OAuth2 schemes can list scopes.
+func securityRequirements(apiKey bool) []openapi.SecurityRequirement {
+	reqs := []openapi.SecurityRequirement{{securityScheme: []string{}}}
+	if apiKey {
+		reqs = append(reqs, openapi.SecurityRequirement{securitySchemeAPIKey: []string{}})
+	}
+
+	return reqs
+}
+
+// requiredScopes returns the alternative scopes that grant access to a route
+func requiredScopes(scope string) []string {
+	if scope == "" {
+		return nil
+	}
+
+	scopes := []string{scope}
+
+	// A qualified scope is granted by its unqualified form as well
+	if parts := strings.SplitN(scope, ":", 3); len(parts) == 3 {
+		scope = parts[0] + ":" + parts[1]
+		scopes = append(scopes, scope)
+	}
+
+	switch {
+	case strings.HasPrefix(scope, "read:"):
+		scopes = append(scopes, jwt.ScopeAllRead)
+	case strings.HasPrefix(scope, "write:"):
+		scopes = append(scopes, jwt.ScopeAllWrite)
+	}
+
+	return scopes
+}
+
+func handlerName(h httprouter.Handle) string {
+	name := runtime.FuncForPC(reflect.ValueOf(h).Pointer()).Name()
+
+	return name[strings.LastIndex(name, ".")+1:]
+}
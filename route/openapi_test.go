@@ -0,0 +1,84 @@
+package route
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tarkov-database/rest-api/core/openapi"
+	"github.com/tarkov-database/rest-api/middleware/jwt"
+)
+
+func TestSpec(t *testing.T) {
+	doc := Spec()
+
+	if _, err := json.Marshal(doc); err != nil {
+		t.Fatalf("Encoding specification failed: %s", err)
+	}
+
+	for _, rt := range table() {
+		path, _ := specPath(openapi.NewGenerator(make(map[string]*openapi.Schema)), rt.path)
+
+		p, ok := doc.Paths[path]
+		if !ok {
+			t.Errorf("Generating specification failed: path %s missing", path)
+			continue
+		}
+
+		var op *openapi.Operation
+		switch rt.method {
+		case http.MethodGet:
+			op = p.Get
+		case http.MethodPost:
+			op = p.Post
+		case http.MethodPut:
+			op = p.Put
+		case http.MethodDelete:
+			op = p.Delete
+		}
+
+		if op == nil {
+			t.Errorf("Generating specification failed: operation %s %s missing", rt.method, path)
+			continue
+		}
+
+		if rt.access == accessPublic && op.Security != nil {
+			t.Errorf("Generating specification failed: %s %s should be public", rt.method, path)
+		}
+	}
+
+	op := doc.Paths[prefix+"/item/{kind}"].Get
+	if s, ok := op.Security[0][securityScheme]; !ok || s == nil || len(s) != 0 {
+		t.Errorf("Generating specification failed: unexpected scopes %v of the bearer scheme", s)
+	}
+
+	expected := []string{jwt.ScopeParam(jwt.ScopeItemRead, "kind"), jwt.ScopeItemRead, jwt.ScopeAllRead}
+	if s := op.RequiredScopes; len(s) != len(expected) || s[0] != expected[0] || s[1] != expected[1] || s[2] != expected[2] {
+		t.Errorf("Generating specification failed: unexpected required scopes %v, expected %v", s, expected)
+	}
+
+	if _, ok := doc.Components.Schemas["item.Ammunition"]; !ok {
+		t.Error("Generating specification failed: item kind schema missing")
+	}
+}
+
+func TestSpecGET(t *testing.T) {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, prefix+"/openapi.json", nil)
+
+	routes().ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Getting specification failed: unexpected status code %v", w.Code)
+	}
+
+	doc := &openapi.Document{}
+	if err := json.NewDecoder(w.Body).Decode(doc); err != nil {
+		t.Fatalf("Decoding specification failed: %s", err)
+	}
+
+	if doc.OpenAPI != openapi.Version {
+		t.Errorf("Getting specification failed: unexpected version %q", doc.OpenAPI)
+	}
+}
@@ -4,7 +4,34 @@ import (
 	"net/http"
 
 	cntrl "github.com/tarkov-database/rest-api/controller"
+	"github.com/tarkov-database/rest-api/core/cache"
+	"github.com/tarkov-database/rest-api/core/database"
+	"github.com/tarkov-database/rest-api/core/event"
+	"github.com/tarkov-database/rest-api/core/graph"
+	"github.com/tarkov-database/rest-api/core/metrics"
+	"github.com/tarkov-database/rest-api/core/tracing"
+	"github.com/tarkov-database/rest-api/middleware/accesslog"
+	"github.com/tarkov-database/rest-api/middleware/audit"
 	"github.com/tarkov-database/rest-api/middleware/jwt"
+	"github.com/tarkov-database/rest-api/middleware/ratelimit"
+	"github.com/tarkov-database/rest-api/middleware/requestid"
+	"github.com/tarkov-database/rest-api/model"
+	"github.com/tarkov-database/rest-api/model/api"
+	"github.com/tarkov-database/rest-api/model/apikey"
+	auditlog "github.com/tarkov-database/rest-api/model/audit"
+	"github.com/tarkov-database/rest-api/model/clientcert"
+	"github.com/tarkov-database/rest-api/model/hideout/module"
+	"github.com/tarkov-database/rest-api/model/hideout/production"
+	"github.com/tarkov-database/rest-api/model/item"
+	"github.com/tarkov-database/rest-api/model/location"
+	"github.com/tarkov-database/rest-api/model/location/feature"
+	"github.com/tarkov-database/rest-api/model/location/featuregroup"
+	"github.com/tarkov-database/rest-api/model/role"
+	"github.com/tarkov-database/rest-api/model/statistic/ammunition/armor"
+	"github.com/tarkov-database/rest-api/model/statistic/ammunition/distance"
+	"github.com/tarkov-database/rest-api/model/token"
+	"github.com/tarkov-database/rest-api/model/user"
+	"github.com/tarkov-database/rest-api/model/webhook"
 
 	"github.com/julienschmidt/httprouter"
 )
@@ -16,83 +43,427 @@ func Load() *httprouter.Router {
 	return routes()
 }
 
+// access defines how a route is authorized
+type access int
+
+const (
+	// accessToken requires a token with the scope of the route
+	accessToken access = iota
+
+	// accessPublic requires no authorization
+	accessPublic
+
+	// accessHandler leaves the verification of the token to the handler
+	accessHandler
+)
+
+// route describes a single endpoint of the API
+type route struct {
+	method string
+	path   string
+	scope  string
+	access access
+	handle httprouter.Handle
+	doc    doc
+
+	// readOnly marks a route which doesn't change any data despite its method
+	readOnly bool
+
+	// probe marks a route polled by orchestrators, which is neither rate
+	// limited nor written to the access log
+	probe bool
+
+	// cached marks a route whose responses are cached and returns the tags
+	// of the data they depend on
+	cached cache.TagFunc
+}
+
+// audited reports whether the requests of a route are recorded in the audit
+// log, which are all changing requests and all token issuances
+func (rt *route) audited() bool {
+	if _, ok := rt.doc.response.(token.Response); ok {
+		return true
+	}
+
+	return rt.method != "GET" && !rt.readOnly
+}
+
+// doc holds the information needed to describe a route in the specification
+type doc struct {
+	summary  string
+	tag      string
+	query    []param
+	request  interface{}
+	response interface{}
+	list     bool
+	status   int
+	stream   bool
+
+	// contentType overrides the media type of the response
+	contentType string
+}
+
+// param describes a query parameter
+type param struct {
+	name        string
+	typ         string
+	description string
+}
+
+// kindEntity is used as prototype for the entities of all item kinds
+type kindEntity struct{}
+
+// graphqlResult is used as prototype for the result of a GraphQL query
+type graphqlResult struct {
+	Data   map[string]interface{}   `json:"data"`
+	Errors []map[string]interface{} `json:"errors,omitempty"`
+}
+
+var (
+	paramLimit  = param{"limit", "integer", "Maximum number of results"}
+	paramOffset = param{"offset", "integer", "Number of results to skip"}
+	paramSort   = param{"sort", "string", "Field to sort by, prefixed with \"-\" for descending order"}
+	paramIDs    = param{"id", "string", "Comma separated list of IDs"}
+	paramText   = param{"text", "string", "Text to search for"}
+)
+
+func listParams(p ...param) []param {
+	return append([]param{paramLimit, paramOffset, paramSort}, p...)
+}
+
+func table() []route {
+	return []route{
+		// Index
+		{method: "GET", path: prefix, access: accessPublic, handle: cntrl.IndexGET,
+			doc: doc{summary: "Get API index", tag: "index", response: api.Index{}}},
+
+		// Health
+		{method: "GET", path: prefix + "/health", handle: cntrl.HealthGET,
+			doc: doc{summary: "Get service health", tag: "health", response: api.Health{}}},
+		{method: "GET", path: "/livez", access: accessPublic, handle: cntrl.LivezGET, probe: true,
+			doc: doc{summary: "Get service liveness", tag: "health", response: model.Response{}}},
+		{method: "GET", path: "/readyz", access: accessPublic, handle: cntrl.ReadyzGET, probe: true,
+			doc: doc{summary: "Get service readiness", tag: "health", response: model.Response{}}},
+
+		// Change feed
+		{method: "GET", path: prefix + "/changes", handle: cntrl.ChangesGET,
+			doc: doc{summary: "Subscribe to data changes", tag: "changes", response: event.Event{}, stream: true,
+				query: []param{
+					{"resource", "string", "Comma separated list of resources"},
+					{"kind", "string", "Comma separated list of kinds"},
+				}}},
+
+		// GraphQL
+		{method: "GET", path: prefix + "/graphql", handle: cntrl.GraphQLGET,
+			doc: doc{summary: "Execute GraphQL query", tag: "graphql", response: graphqlResult{},
+				query: []param{
+					{"query", "string", "GraphQL query document"},
+					{"operationName", "string", "Name of the operation to execute"},
+					{"variables", "string", "JSON encoded variables"},
+				}}},
+		{method: "POST", path: prefix + "/graphql", handle: cntrl.GraphQLPOST, readOnly: true,
+			doc: doc{summary: "Execute GraphQL query", tag: "graphql", request: graph.Request{}, response: graphqlResult{}}},
+
+		// Item
+		{method: "GET", path: prefix + "/item", scope: jwt.ScopeItemRead, handle: cntrl.ItemIndexGET, cached: cache.ParamTag(item.Collection),
+			doc: doc{summary: "Get item index", tag: "item", response: item.Index{},
+				query: []param{{"skipKinds", "boolean", "Omit the statistics of each kind"}}}},
+		{method: "GET", path: prefix + "/item/:kind", scope: jwt.ScopeParam(jwt.ScopeItemRead, "kind"), handle: cntrl.ItemsGET, cached: cache.ParamTag(item.Collection, "kind"),
+			doc: doc{summary: "Get items of a kind", tag: "item", response: kindEntity{}, list: true,
+				query: listParams(paramIDs, paramText,
+					param{"type", "string", "Type of the item (ammunition, armor, clothing, firearm, food, grenade, medical and some modification kinds)"},
+					param{"class", "string", "Class of the firearm"},
+					param{"caliber", "string", "Caliber (ammunition, firearm and magazine)"},
+					param{"manufacturer", "string", "Manufacturer of the firearm"},
+					param{"armor.class", "integer", "Armor class (armor and tactical rig)"},
+					param{"armor.material.name", "string", "Armor material (armor and tactical rig)"},
+					param{"isPlateCarrier", "boolean", "Whether the tactical rig is a plate carrier"},
+					param{"isArmored", "boolean", "Whether the tactical rig is armored"},
+				)}},
+		{method: "GET", path: prefix + "/item/:kind/:id", scope: jwt.ScopeParam(jwt.ScopeItemRead, "kind"), handle: cntrl.ItemGET, cached: cache.ParamTag(item.Collection, "id"),
+			doc: doc{summary: "Get item", tag: "item", response: kindEntity{}}},
+		{method: "POST", path: prefix + "/item/:kind", scope: jwt.ScopeParam(jwt.ScopeItemWrite, "kind"), handle: cntrl.ItemPOST,
+			doc: doc{summary: "Create item", tag: "item", request: kindEntity{}, response: kindEntity{}, status: http.StatusCreated}},
+		{method: "PUT", path: prefix + "/item/:kind/:id", scope: jwt.ScopeParam(jwt.ScopeItemWrite, "kind"), handle: cntrl.ItemPUT,
+			doc: doc{summary: "Replace item", tag: "item", request: kindEntity{}, response: kindEntity{}}},
+		{method: "DELETE", path: prefix + "/item/:id", scope: jwt.QualifyScope(jwt.ScopeItemWrite, jwt.ScopeWildcard), handle: cntrl.ItemDELETE,
+			doc: doc{summary: "Remove item", tag: "item", status: http.StatusNoContent}},
+
+		// Hideout module
+		{method: "GET", path: prefix + "/hideout/module", scope: jwt.ScopeHideoutRead, handle: cntrl.ModulesGET, cached: cache.ParamTag(module.Collection),
+			doc: doc{summary: "Get hideout modules", tag: "hideout", response: module.Module{}, list: true,
+				query: listParams(paramIDs, paramText,
+					param{"material", "string", "ID of an item required by a stage"},
+				)}},
+		{method: "GET", path: prefix + "/hideout/module/:id", scope: jwt.ScopeHideoutRead, handle: cntrl.ModuleGET, cached: cache.ParamTag(module.Collection, "id"),
+			doc: doc{summary: "Get hideout module", tag: "hideout", response: module.Module{}}},
+		{method: "POST", path: prefix + "/hideout/module", scope: jwt.ScopeHideoutWrite, handle: cntrl.ModulePOST,
+			doc: doc{summary: "Create hideout module", tag: "hideout", request: module.Module{}, response: module.Module{}, status: http.StatusCreated}},
+		{method: "PUT", path: prefix + "/hideout/module/:id", scope: jwt.ScopeHideoutWrite, handle: cntrl.ModulePUT,
+			doc: doc{summary: "Replace hideout module", tag: "hideout", request: module.Module{}, response: module.Module{}}},
+		{method: "DELETE", path: prefix + "/hideout/module/:id", scope: jwt.ScopeHideoutWrite, handle: cntrl.ModuleDELETE,
+			doc: doc{summary: "Remove hideout module", tag: "hideout", status: http.StatusNoContent}},
+
+		// Hideout production
+		{method: "GET", path: prefix + "/hideout/production", scope: jwt.ScopeHideoutRead, handle: cntrl.ProductionsGET, cached: cache.ParamTag(production.Collection),
+			doc: doc{summary: "Get hideout productions", tag: "hideout", response: production.Production{}, list: true,
+				query: listParams(paramIDs,
+					param{"module", "string", "ID of the producing module"},
+					param{"material", "string", "ID of a required item"},
+					param{"outcome", "string", "ID of a produced item"},
+				)}},
+		{method: "GET", path: prefix + "/hideout/production/:id", scope: jwt.ScopeHideoutRead, handle: cntrl.ProductionGET, cached: cache.ParamTag(production.Collection, "id"),
+			doc: doc{summary: "Get hideout production", tag: "hideout", response: production.Production{}}},
+		{method: "POST", path: prefix + "/hideout/production", scope: jwt.ScopeHideoutWrite, handle: cntrl.ProductionPOST,
+			doc: doc{summary: "Create hideout production", tag: "hideout", request: production.Production{}, response: production.Production{}, status: http.StatusCreated}},
+		{method: "PUT", path: prefix + "/hideout/production/:id", scope: jwt.ScopeHideoutWrite, handle: cntrl.ProductionPUT,
+			doc: doc{summary: "Replace hideout production", tag: "hideout", request: production.Production{}, response: production.Production{}}},
+		{method: "DELETE", path: prefix + "/hideout/production/:id", scope: jwt.ScopeHideoutWrite, handle: cntrl.ProductionDELETE,
+			doc: doc{summary: "Remove hideout production", tag: "hideout", status: http.StatusNoContent}},
+
+		// Location
+		{method: "GET", path: prefix + "/location", scope: jwt.ScopeLocationRead, handle: cntrl.LocationsGET, cached: cache.ParamTag(location.Collection),
+			doc: doc{summary: "Get locations", tag: "location", response: location.Location{}, list: true,
+				query: listParams(paramText,
+					param{"available", "boolean", "Whether the location is available"},
+				)}},
+		{method: "GET", path: prefix + "/location/:id", scope: jwt.ScopeParam(jwt.ScopeLocationRead, "id"), handle: cntrl.LocationGET, cached: cache.ParamTag(location.Collection, "id"),
+			doc: doc{summary: "Get location", tag: "location", response: location.Location{}}},
+		{method: "POST", path: prefix + "/location", scope: jwt.ScopeLocationWrite, handle: cntrl.LocationPOST,
+			doc: doc{summary: "Create location", tag: "location", request: location.Location{}, response: location.Location{}, status: http.StatusCreated}},
+		{method: "PUT", path: prefix + "/location/:id", scope: jwt.ScopeParam(jwt.ScopeLocationWrite, "id"), handle: cntrl.LocationPUT,
+			doc: doc{summary: "Replace location", tag: "location", request: location.Location{}, response: location.Location{}}},
+		{method: "DELETE", path: prefix + "/location/:id", scope: jwt.ScopeParam(jwt.ScopeLocationWrite, "id"), handle: cntrl.LocationDELETE,
+			doc: doc{summary: "Remove location", tag: "location", status: http.StatusNoContent}},
+
+		// Location feature
+		{method: "GET", path: prefix + "/location/:id/feature", scope: jwt.ScopeParam(jwt.ScopeLocationRead, "id"), handle: cntrl.FeaturesGET, cached: cache.ParamTag(feature.Collection, "id"),
+			doc: doc{summary: "Get location features", tag: "location", response: feature.Feature{}, list: true,
+				query: listParams(paramText,
+					param{"group", "string", "ID of the feature group"},
+				)}},
+		{method: "GET", path: prefix + "/location/:id/feature/:fid", scope: jwt.ScopeParam(jwt.ScopeLocationRead, "id"), handle: cntrl.FeatureGET, cached: cache.ParamTag(feature.Collection, "fid"),
+			doc: doc{summary: "Get location feature", tag: "location", response: feature.Feature{}}},
+		{method: "POST", path: prefix + "/location/:id/feature", scope: jwt.ScopeParam(jwt.ScopeLocationWrite, "id"), handle: cntrl.FeaturePOST,
+			doc: doc{summary: "Create location feature", tag: "location", request: feature.Feature{}, response: feature.Feature{}, status: http.StatusCreated}},
+		{method: "PUT", path: prefix + "/location/:id/feature/:fid", scope: jwt.ScopeParam(jwt.ScopeLocationWrite, "id"), handle: cntrl.FeaturePUT,
+			doc: doc{summary: "Replace location feature", tag: "location", request: feature.Feature{}, response: feature.Feature{}}},
+		{method: "DELETE", path: prefix + "/location/:id/feature/:fid", scope: jwt.ScopeParam(jwt.ScopeLocationWrite, "id"), handle: cntrl.FeatureDELETE,
+			doc: doc{summary: "Remove location feature", tag: "location", status: http.StatusNoContent}},
+
+		// Location feature group
+		{method: "GET", path: prefix + "/location/:id/featuregroup", scope: jwt.ScopeParam(jwt.ScopeLocationRead, "id"), handle: cntrl.FeatureGroupsGET, cached: cache.ParamTag(featuregroup.Collection, "id"),
+			doc: doc{summary: "Get location feature groups", tag: "location", response: featuregroup.Group{}, list: true,
+				query: listParams(paramText,
+					param{"tag", "string", "Tag of the feature group"},
+				)}},
+		{method: "GET", path: prefix + "/location/:id/featuregroup/:gid", scope: jwt.ScopeParam(jwt.ScopeLocationRead, "id"), handle: cntrl.FeatureGroupGET, cached: cache.ParamTag(featuregroup.Collection, "gid"),
+			doc: doc{summary: "Get location feature group", tag: "location", response: featuregroup.Group{}}},
+		{method: "POST", path: prefix + "/location/:id/featuregroup", scope: jwt.ScopeParam(jwt.ScopeLocationWrite, "id"), handle: cntrl.FeatureGroupPOST,
+			doc: doc{summary: "Create location feature group", tag: "location", request: featuregroup.Group{}, response: featuregroup.Group{}, status: http.StatusCreated}},
+		{method: "PUT", path: prefix + "/location/:id/featuregroup/:gid", scope: jwt.ScopeParam(jwt.ScopeLocationWrite, "id"), handle: cntrl.FeatureGroupPUT,
+			doc: doc{summary: "Replace location feature group", tag: "location", request: featuregroup.Group{}, response: featuregroup.Group{}}},
+		{method: "DELETE", path: prefix + "/location/:id/featuregroup/:gid", scope: jwt.ScopeParam(jwt.ScopeLocationWrite, "id"), handle: cntrl.FeatureGroupDELETE,
+			doc: doc{summary: "Remove location feature group", tag: "location", status: http.StatusNoContent}},
+
+		// Ammunition distance statistics
+		{method: "GET", path: prefix + "/statistic/ammunition/distance", scope: jwt.ScopeStatisticRead, handle: cntrl.DistanceStatsGET, cached: cache.ParamTag(distance.Collection),
+			doc: doc{summary: "Get ammunition distance statistics", tag: "statistic", response: distance.AmmoDistanceStatistics{}, list: true,
+				query: listParams(
+					param{"range", "string", "Distance range in the form \"<min>,<max>\""},
+					param{"ammo", "string", "Comma separated list of ammunition IDs"},
+				)}},
+		{method: "GET", path: prefix + "/statistic/ammunition/distance/:id", scope: jwt.ScopeStatisticRead, handle: cntrl.DistanceStatGET, cached: cache.ParamTag(distance.Collection, "id"),
+			doc: doc{summary: "Get ammunition distance statistic", tag: "statistic", response: distance.AmmoDistanceStatistics{}}},
+		{method: "POST", path: prefix + "/statistic/ammunition/distance", scope: jwt.ScopeStatisticWrite, handle: cntrl.DistanceStatPOST,
+			doc: doc{summary: "Create ammunition distance statistic", tag: "statistic", request: distance.AmmoDistanceStatistics{}, response: distance.AmmoDistanceStatistics{}, status: http.StatusCreated}},
+		{method: "PUT", path: prefix + "/statistic/ammunition/distance/:id", scope: jwt.ScopeStatisticWrite, handle: cntrl.DistanceStatPUT,
+			doc: doc{summary: "Replace ammunition distance statistic", tag: "statistic", request: distance.AmmoDistanceStatistics{}, response: distance.AmmoDistanceStatistics{}}},
+		{method: "DELETE", path: prefix + "/statistic/ammunition/distance/:id", scope: jwt.ScopeStatisticWrite, handle: cntrl.DistanceStatDELETE,
+			doc: doc{summary: "Remove ammunition distance statistic", tag: "statistic", status: http.StatusNoContent}},
+
+		// Ammunition armor statistics
+		{method: "GET", path: prefix + "/statistic/ammunition/armor", scope: jwt.ScopeStatisticRead, handle: cntrl.ArmorStatsGET, cached: cache.ParamTag(armor.Collection),
+			doc: doc{summary: "Get ammunition armor statistics", tag: "statistic", response: armor.AmmoArmorStatistics{}, list: true,
+				query: listParams(
+					param{"range", "string", "Distance range in the form \"<min>,<max>\""},
+					param{"ammo", "string", "Comma separated list of ammunition IDs"},
+					param{"armor", "string", "Comma separated list of armor IDs"},
+				)}},
+		{method: "GET", path: prefix + "/statistic/ammunition/armor/:id", scope: jwt.ScopeStatisticRead, handle: cntrl.ArmorStatGET, cached: cache.ParamTag(armor.Collection, "id"),
+			doc: doc{summary: "Get ammunition armor statistic", tag: "statistic", response: armor.AmmoArmorStatistics{}}},
+		{method: "POST", path: prefix + "/statistic/ammunition/armor", scope: jwt.ScopeStatisticWrite, handle: cntrl.ArmorStatPOST,
+			doc: doc{summary: "Create ammunition armor statistic", tag: "statistic", request: armor.AmmoArmorStatistics{}, response: armor.AmmoArmorStatistics{}, status: http.StatusCreated}},
+		{method: "PUT", path: prefix + "/statistic/ammunition/armor/:id", scope: jwt.ScopeStatisticWrite, handle: cntrl.ArmorStatPUT,
+			doc: doc{summary: "Replace ammunition armor statistic", tag: "statistic", request: armor.AmmoArmorStatistics{}, response: armor.AmmoArmorStatistics{}}},
+		{method: "DELETE", path: prefix + "/statistic/ammunition/armor/:id", scope: jwt.ScopeStatisticWrite, handle: cntrl.ArmorStatDELETE,
+			doc: doc{summary: "Remove ammunition armor statistic", tag: "statistic", status: http.StatusNoContent}},
+
+		// User
+		{method: "GET", path: prefix + "/user", scope: jwt.ScopeUserRead, handle: cntrl.UsersGET,
+			doc: doc{summary: "Get users", tag: "user", response: user.User{}, list: true,
+				query: listParams(
+					param{"locked", "boolean", "Whether the user is locked"},
+					param{"email", "string", "E-mail address of the user"},
+					param{"role", "string", "Name of a role assigned to the user"},
+				)}},
+		{method: "GET", path: prefix + "/user/:id", scope: jwt.ScopeUserRead, handle: cntrl.UserGET,
+			doc: doc{summary: "Get user", tag: "user", response: user.User{}}},
+		{method: "POST", path: prefix + "/user", scope: jwt.ScopeUserWrite, handle: cntrl.UserPOST,
+			doc: doc{summary: "Create user", tag: "user", request: user.User{}, response: user.User{}, status: http.StatusCreated}},
+		{method: "PUT", path: prefix + "/user/:id", scope: jwt.ScopeUserWrite, handle: cntrl.UserPUT,
+			doc: doc{summary: "Replace user", tag: "user", request: user.User{}, response: user.User{}}},
+		{method: "DELETE", path: prefix + "/user/:id", scope: jwt.ScopeUserWrite, handle: cntrl.UserDELETE,
+			doc: doc{summary: "Remove user", tag: "user", status: http.StatusNoContent}},
+		{method: "DELETE", path: prefix + "/user/:id/token", scope: jwt.ScopeTokenWrite, handle: cntrl.UserTokenDELETE,
+			doc: doc{summary: "Revoke all tokens of user", tag: "user", status: http.StatusNoContent}},
+		{method: "GET", path: prefix + "/user/:id/key", scope: jwt.ScopeUserRead, handle: cntrl.APIKeysGET,
+			doc: doc{summary: "Get API keys of user", tag: "user", response: apikey.Key{}, list: true, query: listParams()}},
+		{method: "POST", path: prefix + "/user/:id/key", scope: jwt.ScopeTokenWrite, handle: cntrl.APIKeyPOST,
+			doc: doc{summary: "Create API key", tag: "user", request: apikey.Request{}, response: apikey.Created{}, status: http.StatusCreated}},
+		{method: "GET", path: prefix + "/user/:id/key/:key", scope: jwt.ScopeUserRead, handle: cntrl.APIKeyGET,
+			doc: doc{summary: "Get API key", tag: "user", response: apikey.Key{}}},
+		{method: "PUT", path: prefix + "/user/:id/key/:key", scope: jwt.ScopeTokenWrite, handle: cntrl.APIKeyPUT,
+			doc: doc{summary: "Update API key", tag: "user", request: apikey.Request{}, response: apikey.Key{}}},
+		{method: "DELETE", path: prefix + "/user/:id/key/:key", scope: jwt.ScopeTokenWrite, handle: cntrl.APIKeyDELETE,
+			doc: doc{summary: "Remove API key", tag: "user", status: http.StatusNoContent}},
+
+		{method: "GET", path: prefix + "/user/:id/cert", scope: jwt.ScopeUserRead, handle: cntrl.ClientCertsGET,
+			doc: doc{summary: "Get client certificate mappings of user", tag: "user", response: clientcert.Mapping{}, list: true, query: listParams()}},
+		{method: "POST", path: prefix + "/user/:id/cert", scope: jwt.ScopeTokenWrite, handle: cntrl.ClientCertPOST,
+			doc: doc{summary: "Create client certificate mapping", tag: "user", request: clientcert.Request{}, response: clientcert.Mapping{}, status: http.StatusCreated}},
+		{method: "GET", path: prefix + "/user/:id/cert/:cert", scope: jwt.ScopeUserRead, handle: cntrl.ClientCertGET,
+			doc: doc{summary: "Get client certificate mapping", tag: "user", response: clientcert.Mapping{}}},
+		{method: "PUT", path: prefix + "/user/:id/cert/:cert", scope: jwt.ScopeTokenWrite, handle: cntrl.ClientCertPUT,
+			doc: doc{summary: "Update client certificate mapping", tag: "user", request: clientcert.Request{}, response: clientcert.Mapping{}}},
+		{method: "DELETE", path: prefix + "/user/:id/cert/:cert", scope: jwt.ScopeTokenWrite, handle: cntrl.ClientCertDELETE,
+			doc: doc{summary: "Remove client certificate mapping", tag: "user", status: http.StatusNoContent}},
+
+		// Role
+		{method: "GET", path: prefix + "/role", scope: jwt.ScopeUserRead, handle: cntrl.RolesGET,
+			doc: doc{summary: "Get roles", tag: "role", response: role.Role{}, list: true}},
+		{method: "GET", path: prefix + "/role/:id", scope: jwt.ScopeUserRead, handle: cntrl.RoleGET,
+			doc: doc{summary: "Get role", tag: "role", response: role.Role{}}},
+		{method: "POST", path: prefix + "/role", scope: jwt.ScopeUserWrite, handle: cntrl.RolePOST,
+			doc: doc{summary: "Create role", tag: "role", request: role.Role{}, response: role.Role{}, status: http.StatusCreated}},
+		{method: "PUT", path: prefix + "/role/:id", scope: jwt.ScopeUserWrite, handle: cntrl.RolePUT,
+			doc: doc{summary: "Replace role", tag: "role", request: role.Role{}, response: role.Role{}}},
+		{method: "DELETE", path: prefix + "/role/:id", scope: jwt.ScopeUserWrite, handle: cntrl.RoleDELETE,
+			doc: doc{summary: "Remove role", tag: "role", status: http.StatusNoContent}},
+
+		// Webhook
+		{method: "GET", path: prefix + "/webhook", scope: jwt.ScopeWebhookRead, handle: cntrl.WebhooksGET,
+			doc: doc{summary: "Get webhooks", tag: "webhook", response: webhook.Webhook{}, list: true, query: listParams()}},
+		{method: "GET", path: prefix + "/webhook/:id", scope: jwt.ScopeWebhookRead, handle: cntrl.WebhookGET,
+			doc: doc{summary: "Get webhook", tag: "webhook", response: webhook.Webhook{}}},
+		{method: "GET", path: prefix + "/webhook/:id/delivery", scope: jwt.ScopeWebhookRead, handle: cntrl.WebhookDeliveriesGET,
+			doc: doc{summary: "Get webhook deliveries", tag: "webhook", response: webhook.Delivery{}, list: true, query: listParams()}},
+		{method: "POST", path: prefix + "/webhook", scope: jwt.ScopeWebhookWrite, handle: cntrl.WebhookPOST,
+			doc: doc{summary: "Create webhook", tag: "webhook", request: webhook.Webhook{}, response: webhook.Webhook{}, status: http.StatusCreated}},
+		{method: "PUT", path: prefix + "/webhook/:id", scope: jwt.ScopeWebhookWrite, handle: cntrl.WebhookPUT,
+			doc: doc{summary: "Replace webhook", tag: "webhook", request: webhook.Webhook{}, response: webhook.Webhook{}}},
+		{method: "DELETE", path: prefix + "/webhook/:id", scope: jwt.ScopeWebhookWrite, handle: cntrl.WebhookDELETE,
+			doc: doc{summary: "Remove webhook", tag: "webhook", status: http.StatusNoContent}},
+
+		// Audit log
+		{method: "GET", path: prefix + "/audit", scope: jwt.ScopeAuditRead, handle: cntrl.AuditGET,
+			doc: doc{summary: "Get audit log", tag: "audit", response: auditlog.Entry{}, list: true,
+				query: listParams(
+					param{"subject", "string", "Subject of the client"},
+					param{"method", "string", "HTTP method"},
+					param{"route", "string", "Route pattern"},
+					param{"resource", "string", "ID of the affected resource"},
+					param{"status", "integer", "Response status code"},
+					param{"requestId", "string", "ID of the request"},
+					param{"from", "string", "Start time as Unix timestamp or RFC 3339"},
+					param{"to", "string", "End time as Unix timestamp or RFC 3339"},
+				)}},
+		{method: "GET", path: prefix + "/audit/export", scope: jwt.ScopeAuditRead, handle: cntrl.AuditExportGET,
+			doc: doc{summary: "Export audit log", tag: "audit", response: auditlog.Entry{}, contentType: "application/x-ndjson",
+				query: []param{
+					{"format", "string", "Export format, either ndjson or csv"},
+					{"subject", "string", "Subject of the client"},
+					{"method", "string", "HTTP method"},
+					{"route", "string", "Route pattern"},
+					{"resource", "string", "ID of the affected resource"},
+					{"status", "integer", "Response status code"},
+					{"from", "string", "Start time as Unix timestamp or RFC 3339"},
+					{"to", "string", "End time as Unix timestamp or RFC 3339"},
+				}}},
+
+		// Token
+		{method: "GET", path: prefix + "/token", access: accessHandler, handle: cntrl.TokenGET,
+			doc: doc{summary: "Renew token", tag: "token", response: token.Response{}, status: http.StatusCreated}},
+		{method: "POST", path: prefix + "/token", scope: jwt.ScopeTokenWrite, access: accessHandler, handle: cntrl.TokenPOST,
+			doc: doc{summary: "Create token", tag: "token", request: token.Request{}, response: token.Response{}, status: http.StatusCreated}},
+		{method: "POST", path: prefix + "/token/refresh", access: accessPublic, handle: cntrl.TokenRefreshPOST,
+			doc: doc{summary: "Refresh token", tag: "token", request: token.RefreshRequest{}, response: token.Response{}, status: http.StatusCreated}},
+		{method: "POST", path: prefix + "/token/revoke", access: accessHandler, handle: cntrl.TokenRevokePOST,
+			doc: doc{summary: "Revoke token", tag: "token", request: token.RevokeRequest{}, status: http.StatusNoContent}},
+		{method: "GET", path: "/.well-known/jwks.json", access: accessPublic, handle: cntrl.JWKSGET,
+			doc: doc{summary: "Get token verification keys", tag: "token", response: jwt.JWKS{}}},
+
+		// Authentication
+		{method: "POST", path: prefix + "/auth/login", access: accessPublic, handle: cntrl.AuthLoginPOST,
+			doc: doc{summary: "Log in with password or login link", tag: "auth", request: token.LoginRequest{}, response: token.Response{}, status: http.StatusCreated}},
+		{method: "POST", path: prefix + "/auth/oidc", access: accessPublic, handle: cntrl.AuthOIDCPOST,
+			doc: doc{summary: "Log in with ID token of identity provider", tag: "auth", request: token.OIDCRequest{}, response: token.Response{}, status: http.StatusCreated}},
+		{method: "POST", path: prefix + "/auth/link", access: accessPublic, handle: cntrl.AuthLinkPOST,
+			doc: doc{summary: "Request login link", tag: "auth", request: token.LinkRequest{}, status: http.StatusAccepted}},
+		{method: "POST", path: prefix + "/auth/password/forgot", access: accessPublic, handle: cntrl.AuthPasswordForgotPOST,
+			doc: doc{summary: "Request password reset link", tag: "auth", request: token.LinkRequest{}, status: http.StatusAccepted}},
+		{method: "POST", path: prefix + "/auth/password/reset", access: accessPublic, handle: cntrl.AuthPasswordResetPOST,
+			doc: doc{summary: "Set new password", tag: "auth", request: token.PasswordRequest{}, status: http.StatusNoContent}},
+		{method: "POST", path: prefix + "/auth/verify/request", access: accessPublic, handle: cntrl.AuthVerifyRequestPOST,
+			doc: doc{summary: "Request e-mail verification link", tag: "auth", request: token.LinkRequest{}, status: http.StatusAccepted}},
+		{method: "POST", path: prefix + "/auth/verify", access: accessPublic, handle: cntrl.AuthVerifyPOST,
+			doc: doc{summary: "Verify e-mail address", tag: "auth", request: token.VerifyRequest{}, status: http.StatusNoContent}},
+
+		// Database
+		{method: "GET", path: prefix + "/database/index", scope: jwt.ScopeDatabaseRead, handle: cntrl.DatabaseIndexesGET,
+			doc: doc{summary: "Get declared and existing indexes with their state", tag: "database", response: database.IndexStatus{}, list: true}},
+
+		// Metrics
+		{method: "GET", path: "/metrics", access: accessPublic, handle: cntrl.MetricsGET,
+			doc: doc{summary: "Get metrics in Prometheus exposition format", tag: "health", response: "", contentType: "text/plain"}},
+
+		// Specification
+		{method: "GET", path: prefix + "/openapi.json", access: accessPublic, handle: specGET,
+			doc: doc{summary: "Get OpenAPI specification", tag: "index", response: map[string]interface{}{}}},
+	}
+}
+
 func routes() *httprouter.Router {
 	r := httprouter.New()
 
-	// Index
-	r.GET(prefix, cntrl.IndexGET)
-	r.Handler("GET", "/", http.RedirectHandler(prefix, http.StatusMovedPermanently))
+	for _, rt := range table() {
+		h := rt.handle
+		if rt.cached != nil {
+			h = cache.Handler(rt.path, rt.cached, h)
+		}
+		if !rt.probe {
+			h = ratelimit.Handler(h)
+		}
+		if rt.access == accessToken {
+			h = auth(rt.scope, audit.Actor(h))
+		}
+		if !rt.probe {
+			h = ratelimit.AddrHandler(h)
+		}
+		if rt.audited() {
+			h = audit.Handler(rt.path, h)
+		}
+		h = metrics.Handler(rt.path, h)
+		if !rt.probe {
+			h = accesslog.Handler(rt.path, h)
+		}
+		h = tracing.Handler(rt.path, h)
+		h = requestid.Handler(h)
+
+		r.Handle(rt.method, rt.path, h)
+	}
 
-	// Health
-	r.GET(prefix+"/health", auth("", cntrl.HealthGET))
-
-	// Item
-	r.GET(prefix+"/item", auth(jwt.ScopeItemRead, cntrl.ItemIndexGET))
-	r.GET(prefix+"/item/:kind", auth(jwt.ScopeItemRead, cntrl.ItemsGET))
-	r.GET(prefix+"/item/:kind/:id", auth(jwt.ScopeItemRead, cntrl.ItemGET))
-	r.POST(prefix+"/item/:kind", auth(jwt.ScopeItemWrite, cntrl.ItemPOST))
-	r.PUT(prefix+"/item/:kind/:id", auth(jwt.ScopeItemWrite, cntrl.ItemPUT))
-	r.DELETE(prefix+"/item/:id", auth(jwt.ScopeItemWrite, cntrl.ItemDELETE))
-
-	// Hideout module
-	r.GET(prefix+"/hideout/module", auth(jwt.ScopeHideoutRead, cntrl.ModulesGET))
-	r.GET(prefix+"/hideout/module/:id", auth(jwt.ScopeHideoutRead, cntrl.ModuleGET))
-	r.POST(prefix+"/hideout/module", auth(jwt.ScopeHideoutWrite, cntrl.ModulePOST))
-	r.PUT(prefix+"/hideout/module/:id", auth(jwt.ScopeHideoutWrite, cntrl.ModulePUT))
-	r.DELETE(prefix+"/hideout/module/:id", auth(jwt.ScopeHideoutWrite, cntrl.ModuleDELETE))
-
-	// Hideout production
-	r.GET(prefix+"/hideout/production", auth(jwt.ScopeHideoutRead, cntrl.ProductionsGET))
-	r.GET(prefix+"/hideout/production/:id", auth(jwt.ScopeHideoutRead, cntrl.ProductionGET))
-	r.POST(prefix+"/hideout/production", auth(jwt.ScopeHideoutWrite, cntrl.ProductionPOST))
-	r.PUT(prefix+"/hideout/production/:id", auth(jwt.ScopeHideoutWrite, cntrl.ProductionPUT))
-	r.DELETE(prefix+"/hideout/production/:id", auth(jwt.ScopeHideoutWrite, cntrl.ProductionDELETE))
-
-	// Location
-	r.GET(prefix+"/location", auth(jwt.ScopeLocationRead, cntrl.LocationsGET))
-	r.GET(prefix+"/location/:id", auth(jwt.ScopeLocationRead, cntrl.LocationGET))
-	r.POST(prefix+"/location", auth(jwt.ScopeLocationWrite, cntrl.LocationPOST))
-	r.PUT(prefix+"/location/:id", auth(jwt.ScopeLocationWrite, cntrl.LocationPUT))
-	r.DELETE(prefix+"/location/:id", auth(jwt.ScopeLocationWrite, cntrl.LocationDELETE))
-
-	// Location feature
-	r.GET(prefix+"/location/:id/feature", auth(jwt.ScopeLocationRead, cntrl.FeaturesGET))
-	r.GET(prefix+"/location/:id/feature/:fid", auth(jwt.ScopeLocationRead, cntrl.FeatureGET))
-	r.POST(prefix+"/location/:id/feature", auth(jwt.ScopeLocationWrite, cntrl.FeaturePOST))
-	r.PUT(prefix+"/location/:id/feature/:fid", auth(jwt.ScopeLocationWrite, cntrl.FeaturePUT))
-	r.DELETE(prefix+"/location/:id/feature/:fid", auth(jwt.ScopeLocationWrite, cntrl.FeatureDELETE))
-
-	// Location feature group
-	r.GET(prefix+"/location/:id/featuregroup", auth(jwt.ScopeLocationRead, cntrl.FeatureGroupsGET))
-	r.GET(prefix+"/location/:id/featuregroup/:gid", auth(jwt.ScopeLocationRead, cntrl.FeatureGroupGET))
-	r.POST(prefix+"/location/:id/featuregroup", auth(jwt.ScopeLocationWrite, cntrl.FeatureGroupPOST))
-	r.PUT(prefix+"/location/:id/featuregroup/:gid", auth(jwt.ScopeLocationWrite, cntrl.FeatureGroupPUT))
-	r.DELETE(prefix+"/location/:id/featuregroup/:gid", auth(jwt.ScopeLocationWrite, cntrl.FeatureGroupDELETE))
-
-	// Ammunition distance statistics
-	r.GET(prefix+"/statistic/ammunition/distance", auth(jwt.ScopeStatisticRead, cntrl.DistanceStatsGET))
-	r.GET(prefix+"/statistic/ammunition/distance/:id", auth(jwt.ScopeStatisticRead, cntrl.DistanceStatGET))
-	r.POST(prefix+"/statistic/ammunition/distance", auth(jwt.ScopeStatisticWrite, cntrl.DistanceStatPOST))
-	r.PUT(prefix+"/statistic/ammunition/distance/:id", auth(jwt.ScopeStatisticWrite, cntrl.DistanceStatPUT))
-	r.DELETE(prefix+"/statistic/ammunition/distance/:id", auth(jwt.ScopeStatisticWrite, cntrl.DistanceStatDELETE))
-
-	// Ammunition armor statistics
-	r.GET(prefix+"/statistic/ammunition/armor", auth(jwt.ScopeStatisticRead, cntrl.ArmorStatsGET))
-	r.GET(prefix+"/statistic/ammunition/armor/:id", auth(jwt.ScopeStatisticRead, cntrl.ArmorStatGET))
-	r.POST(prefix+"/statistic/ammunition/armor", auth(jwt.ScopeStatisticWrite, cntrl.ArmorStatPOST))
-	r.PUT(prefix+"/statistic/ammunition/armor/:id", auth(jwt.ScopeStatisticWrite, cntrl.ArmorStatPUT))
-	r.DELETE(prefix+"/statistic/ammunition/armor/:id", auth(jwt.ScopeStatisticWrite, cntrl.ArmorStatDELETE))
-
-	// User
-	r.GET(prefix+"/user", auth(jwt.ScopeUserRead, cntrl.UsersGET))
-	r.GET(prefix+"/user/:id", auth(jwt.ScopeUserRead, cntrl.UserGET))
-	r.POST(prefix+"/user", auth(jwt.ScopeUserWrite, cntrl.UserPOST))
-	r.PUT(prefix+"/user/:id", auth(jwt.ScopeUserWrite, cntrl.UserPUT))
-	r.DELETE(prefix+"/user/:id", auth(jwt.ScopeUserWrite, cntrl.UserDELETE))
-
-	// Token
-	r.GET(prefix+"/token", cntrl.TokenGET)
-	r.POST(prefix+"/token", cntrl.TokenPOST)
+	r.Handler("GET", "/", http.RedirectHandler(prefix, http.StatusMovedPermanently))
 
 	r.NotFound = cntrl.StatusNotFoundHandler()
 
@@ -4,13 +4,20 @@ import (
 	"encoding/json"
 	"net/http"
 
-	"github.com/google/logger"
+	"github.com/tarkov-database/rest-api/core/logger"
+	"github.com/tarkov-database/rest-api/middleware/requestid"
+	"github.com/tarkov-database/rest-api/model"
 )
 
 const contentTypeJSON = "application/json"
 
-// RenderJSON encodes the input data into JSON and sends it as response
+// RenderJSON encodes the input data into JSON and sends it as response.
+// Status responses include the ID of the request.
 func RenderJSON(data interface{}, status int, w http.ResponseWriter) {
+	if res, ok := data.(*model.Response); ok && res.RequestID == "" {
+		res.RequestID = w.Header().Get(requestid.Header)
+	}
+
 	w.Header().Set("Content-Type", contentTypeJSON)
 	w.WriteHeader(status)
 
@@ -0,0 +1,27 @@
+package view
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tarkov-database/rest-api/middleware/requestid"
+	"github.com/tarkov-database/rest-api/model"
+)
+
+func TestRenderJSONRequestID(t *testing.T) {
+	w := httptest.NewRecorder()
+	w.Header().Set(requestid.Header, "request-1")
+
+	RenderJSON(model.NewResponse("Item not found", http.StatusNotFound), http.StatusNotFound, w)
+
+	res := &model.Response{}
+	if err := json.NewDecoder(w.Body).Decode(res); err != nil {
+		t.Fatalf("Decoding response failed: %v", err)
+	}
+
+	if res.RequestID != "request-1" {
+		t.Errorf("Response request ID is %q, expected %q", res.RequestID, "request-1")
+	}
+}